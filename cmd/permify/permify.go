@@ -17,9 +17,30 @@ func main() {
 	validate := cmd.NewValidateCommand()
 	root.AddCommand(validate)
 
+	fmt := cmd.NewFmtCommand()
+	root.AddCommand(fmt)
+
+	lint := cmd.NewLintCommand()
+	root.AddCommand(lint)
+
+	lsp := cmd.NewLSPCommand()
+	root.AddCommand(lsp)
+
 	migrate := cmd.NewMigrateCommand()
 	root.AddCommand(migrate)
 
+	audit := cmd.NewAuditCommand()
+	root.AddCommand(audit)
+
+	relationships := cmd.NewRelationshipsCommand()
+	root.AddCommand(relationships)
+
+	schema := cmd.NewSchemaCommand()
+	root.AddCommand(schema)
+
+	gc := cmd.NewGarbageCollectCommand()
+	root.AddCommand(gc)
+
 	version := cmd.NewVersionCommand()
 	root.AddCommand(version)
 
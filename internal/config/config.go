@@ -100,6 +100,7 @@ type (
 		Schema         Schema       `mapstructure:"schema"`          // Schema service configuration
 		Permission     Permission   `mapstructure:"permission"`      // Permission service configuration
 		Relationship   Relationship `mapstructure:"relationship"`    // Relationship service configuration
+		SnapToken      SnapToken    `mapstructure:"snap_token"`      // Snap token configuration
 	}
 
 	// Schema contains configuration for the schema service.
@@ -109,13 +110,34 @@ type (
 
 	// Permission contains configuration for the permission service.
 	Permission struct {
-		BulkLimit        int   `mapstructure:"bulk_limit"`        // Limit for bulk operations
-		ConcurrencyLimit int   `mapstructure:"concurrency_limit"` // Limit for concurrent operations
-		Cache            Cache `mapstructure:"cache"`             // Cache configuration for the permission service
+		BulkLimit           int   `mapstructure:"bulk_limit"`           // Limit for bulk operations
+		ConcurrencyLimit    int   `mapstructure:"concurrency_limit"`    // Limit for concurrent operations
+		RecursiveEvaluation bool  `mapstructure:"recursive_evaluation"` // Resolve self-referential relations with a single recursive query where the database supports it
+		Cache               Cache `mapstructure:"cache"`                // Cache configuration for the permission service
 	}
 
-	// Relationship is a placeholder struct for the relationship service configuration.
-	Relationship struct{}
+	// Relationship contains configuration for the relationship service.
+	Relationship struct {
+		Stats      RelationshipStats      `mapstructure:"stats"`      // Statistics configuration for the relationship service
+		Coalescing RelationshipCoalescing `mapstructure:"coalescing"` // Coalescing configuration for relationship queries
+	}
+
+	// RelationshipStats contains configuration for relationship statistics.
+	RelationshipStats struct {
+		Cache     Cache         `mapstructure:"cache"`     // Cache configuration for relationship statistics
+		Staleness time.Duration `mapstructure:"staleness"` // How long computed statistics are served from the cache, caching is disabled if zero
+	}
+
+	// RelationshipCoalescing contains configuration for coalescing concurrent relationship queries.
+	RelationshipCoalescing struct {
+		Window       time.Duration `mapstructure:"window"`         // How long a query waits for others of the same shape while one is running, coalescing is disabled if zero
+		MaxBatchSize int           `mapstructure:"max_batch_size"` // Number of entity ids a coalesced query is sent at
+	}
+
+	// SnapToken contains configuration for snap tokens.
+	SnapToken struct {
+		SigningKeys []string `mapstructure:"signing_keys"` // Keys of the postgres and memory token signatures, the first one signs and every one verifies
+	}
 
 	// Cache contains configuration for caching.
 	Cache struct {
@@ -125,16 +147,27 @@ type (
 
 	// Database contains configuration for the database.
 	Database struct {
-		Engine                    string                    `mapstructure:"engine"`                  // Database engine type (e.g., "postgres" or "memory")
+		Engine                    string                    `mapstructure:"engine"`                  // Database engine type (e.g., "postgres", "mysql", "sqlite" or "memory")
 		URI                       string                    `mapstructure:"uri"`                     // Database connection URI
+		ReadReplicaURIs           []string                  `mapstructure:"read_replica_uris"`       // Connection URIs of the read replicas, only used by postgres
+		ReadReplicaMaxLag         time.Duration             `mapstructure:"read_replica_max_lag"`    // How far behind the primary a replica may be for the reads that are not bound to a snapshot
 		AutoMigrate               bool                      `mapstructure:"auto_migrate"`            // Whether to enable automatic migration
 		MaxOpenConnections        int                       `mapstructure:"max_open_connections"`    // Maximum number of open connections to the database
 		MaxIdleConnections        int                       `mapstructure:"max_idle_connections"`    // Maximum number of idle connections to the database
 		MaxConnectionLifetime     time.Duration             `mapstructure:"max_connection_lifetime"` // Maximum duration a connection can be reused
 		MaxConnectionIdleTime     time.Duration             `mapstructure:"max_connection_idle_time"`
+		Memory                    DatabaseMemory            `mapstructure:"memory"` // Durability configuration of the memory engine
 		DatabaseGarbageCollection DatabaseGarbageCollection `mapstructure:"garbage_collection"`
 	}
 
+	// DatabaseMemory contains the durability configuration of the memory engine.
+	DatabaseMemory struct {
+		Path               string        `mapstructure:"path"`                // Directory of the write-ahead log and snapshot files, data is lost on restart if empty
+		Sync               string        `mapstructure:"sync"`                // When the write-ahead log is synced to disk ("always", "interval" or "never")
+		SyncInterval       time.Duration `mapstructure:"sync_interval"`       // How often the write-ahead log is synced to disk with the "interval" policy
+		CompactionInterval time.Duration `mapstructure:"compaction_interval"` // How often the write-ahead log is compacted into the snapshot file
+	}
+
 	DatabaseGarbageCollection struct {
 		Enable          bool          `mapstructure:"enable"`
 		Interval        time.Duration `mapstructure:"interval"`
@@ -223,14 +256,27 @@ func DefaultConfig() *Config {
 				},
 			},
 			Permission: Permission{
-				BulkLimit:        100,
-				ConcurrencyLimit: 100,
+				BulkLimit:           100,
+				ConcurrencyLimit:    100,
+				RecursiveEvaluation: false,
 				Cache: Cache{
 					NumberOfCounters: 10_000,
 					MaxCost:          "10MiB",
 				},
 			},
-			Relationship: Relationship{},
+			Relationship: Relationship{
+				Stats: RelationshipStats{
+					Cache: Cache{
+						NumberOfCounters: 1_000,
+						MaxCost:          "10MiB",
+					},
+					Staleness: 0,
+				},
+				Coalescing: RelationshipCoalescing{
+					Window:       0,
+					MaxBatchSize: 100,
+				},
+			},
 		},
 		Authn: Authn{
 			Enabled:   false,
@@ -238,8 +284,14 @@ func DefaultConfig() *Config {
 			Oidc:      Oidc{},
 		},
 		Database: Database{
-			Engine:      "memory",
-			AutoMigrate: true,
+			Engine:            "memory",
+			AutoMigrate:       true,
+			ReadReplicaMaxLag: time.Second,
+			Memory: DatabaseMemory{
+				Sync:               "always",
+				SyncInterval:       time.Second,
+				CompactionInterval: 5 * time.Minute,
+			},
 			DatabaseGarbageCollection: DatabaseGarbageCollection{
 				Enable: false,
 			},
@@ -28,6 +28,8 @@ type CheckEngine struct {
 	engineKeyManager keys.EngineKeyManager
 	// concurrencyLimit is the maximum number of concurrent permission checks allowed
 	concurrencyLimit int
+	// recursiveReader resolves self-referential relations in a single query, if the repository supports it
+	recursiveReader repositories.RecursiveRelationshipReader
 }
 
 // NewCheckEngine creates a new CheckEngine instance for performing permission checks.
@@ -194,6 +196,8 @@ func (engine *CheckEngine) check(ctx context.Context, request *base.PermissionCh
 		} else {
 			fn = engine.checkLeaf(ctx, request, child.GetLeaf())
 		}
+	} else if engine.recursiveReader != nil && schema.IsSelfReferentialRelation(en, request.GetPermission()) {
+		fn = engine.checkRecursive(ctx, request)
 	} else {
 		fn = engine.checkDirect(ctx, request)
 	}
@@ -209,7 +213,7 @@ func (engine *CheckEngine) check(ctx context.Context, request *base.PermissionCh
 
 // checkRewrite is a function that takes a context, a PermissionCheckRequest,
 // and a Rewrite object. It returns a CheckFunction based on the Rewrite
-// operation type (union or intersection). The returned CheckFunction, when
+// operation type (union, intersection or exclusion). The returned CheckFunction, when
 // called with a context, executes the appropriate rewrite operation and
 // returns the resulting PermissionCheckResponse and error.
 func (engine *CheckEngine) checkRewrite(ctx context.Context, request *base.PermissionCheckRequest, rewrite *base.Rewrite) CheckFunction {
@@ -218,6 +222,8 @@ func (engine *CheckEngine) checkRewrite(ctx context.Context, request *base.Permi
 		return engine.setChild(ctx, request, rewrite.GetChildren(), checkUnion)
 	case *base.Rewrite_OPERATION_INTERSECTION.Enum():
 		return engine.setChild(ctx, request, rewrite.GetChildren(), checkIntersection)
+	case *base.Rewrite_OPERATION_EXCLUSION.Enum():
+		return engine.setChild(ctx, request, rewrite.GetChildren(), checkExclusion)
 	default:
 		return checkFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
 	}
@@ -322,12 +328,32 @@ func (engine *CheckEngine) checkDirect(ctx context.Context, request *base.Permis
 	}
 }
 
+// checkRecursive is a function that takes a context and a PermissionCheckRequest
+// for a self-referential relation, such as the members of nested groups. It returns
+// a CheckFunction that, when called with a context, resolves the relation and its
+// nested usersets with a single query of the recursive relationship reader instead
+// of a direct check per level. The levels are bounded by the depth of the request,
+// so the result is the same as the one of the direct checks.
+func (engine *CheckEngine) checkRecursive(ctx context.Context, request *base.PermissionCheckRequest) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		ok, err := engine.recursiveReader.CheckRecursiveMembership(ctx, request.GetTenantId(), request.GetEntity(), request.GetPermission(), request.GetSubject(), request.GetMetadata().GetSnapToken(), request.GetMetadata().GetDepth())
+		if err != nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		if ok {
+			return allowed(&base.PermissionCheckResponseMetadata{}), nil
+		}
+		return denied(&base.PermissionCheckResponseMetadata{}), nil
+	}
+}
+
 // checkTupleToUserSet is a function that takes a context, a PermissionCheckRequest,
 // a TupleToUserSet object, and an exclusion flag. It returns a CheckFunction that,
 // when called with a context, performs a permission check by querying relationships
 // based on the TupleToUserSet. For each tuple found, it adds a check function for
-// the computed user set to a list of CheckFunctions. The final result is determined
-// by combining the check results using the checkUnion function.
+// the computed user set, or for the next hops of a multi-hop path, to a list of
+// CheckFunctions. The final result is determined by combining the check results
+// using the checkUnion function.
 func (engine *CheckEngine) checkTupleToUserSet(ctx context.Context, request *base.PermissionCheckRequest, ttu *base.TupleToUserSet, exclusion bool) CheckFunction {
 	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
 		var err error
@@ -346,7 +372,7 @@ func (engine *CheckEngine) checkTupleToUserSet(ctx context.Context, request *bas
 		var checkFunctions []CheckFunction
 		for it.HasNext() {
 			subject := it.GetNext().GetSubject()
-			checkFunctions = append(checkFunctions, engine.checkComputedUserSet(ctx, &base.PermissionCheckRequest{
+			req := &base.PermissionCheckRequest{
 				TenantId: request.GetTenantId(),
 				Entity: &base.Entity{
 					Type: subject.GetType(),
@@ -355,7 +381,13 @@ func (engine *CheckEngine) checkTupleToUserSet(ctx context.Context, request *bas
 				Permission: subject.GetRelation(),
 				Subject:    request.GetSubject(),
 				Metadata:   request.GetMetadata(),
-			}, ttu.GetComputed(), exclusion))
+			}
+			// the next hops of a multi-hop path are evaluated on the subjects of the tuple set
+			if ttu.GetNext() != nil {
+				checkFunctions = append(checkFunctions, engine.checkTupleToUserSet(ctx, req, ttu.GetNext(), exclusion))
+				continue
+			}
+			checkFunctions = append(checkFunctions, engine.checkComputedUserSet(ctx, req, ttu.GetComputed(), exclusion))
 		}
 
 		return checkUnion(ctx, checkFunctions, engine.concurrencyLimit)
@@ -494,6 +526,48 @@ func checkIntersection(ctx context.Context, functions []CheckFunction, limit int
 	return allowed(responseMetadata), nil
 }
 
+// checkExclusion is a function that evaluates a set of CheckFunctions concurrently
+// to determine if access should be allowed or denied based on the difference of the functions' results.
+// The first CheckFunction is the base of the exclusion and the remaining ones are the excluded functions.
+// It takes a context, a slice of CheckFunctions, and a limit for concurrent execution as input parameters.
+// The function returns a PermissionCheckResponse and an error.
+//
+// The function works as follows:
+// 1. If there are no CheckFunctions provided, access is denied.
+// 2. The base CheckFunction and the union of the excluded CheckFunctions are run concurrently.
+// 3. After both are executed, the results are processed.
+//   - If an error is encountered, access is denied and the error is returned.
+//   - If the base denies access, or the union of the excluded functions allows it, access is denied and no error is returned.
+//
+// 4. If the context is done (e.g., due to a timeout), access is denied and a cancellation error is returned.
+// 5. If none of the above conditions are met, access is allowed and no error is returned.
+func checkExclusion(ctx context.Context, functions []CheckFunction, limit int) (*base.PermissionCheckResponse, error) {
+	responseMetadata := &base.PermissionCheckResponseMetadata{}
+
+	if len(functions) == 0 {
+		return denied(responseMetadata), nil
+	}
+
+	excluded := functions[1:]
+	// the base and the excluded functions are evaluated as two sides of the difference
+	sides := []CheckFunction{
+		functions[0],
+		func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+			resp, err := checkUnion(ctx, excluded, limit)
+			if err != nil {
+				return resp, err
+			}
+			// the excluded side is inverted, so that both sides have to be allowed
+			if resp.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED {
+				return denied(resp.GetMetadata()), nil
+			}
+			return allowed(resp.GetMetadata()), nil
+		},
+	}
+
+	return checkIntersection(ctx, sides, limit)
+}
+
 // run is a function that concurrently executes a set of CheckFunctions within a context,
 // with a specified concurrency limit, and writes their results to a decision channel.
 // The function returns a cleanup function that waits for all CheckFunctions to complete
@@ -2,6 +2,7 @@ package engines
 
 import (
 	"context"
+	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -1034,4 +1035,345 @@ entity doc {
 			Expect(base.PermissionCheckResponse_RESULT_ALLOWED).Should(Equal(response.GetCan()))
 		})
 	})
+
+	// MULTI-HOP SAMPLE
+
+	multiHopSchema := `
+entity user {}
+
+entity organization {
+	relation admin @user
+}
+
+entity folder {
+	relation org @organization
+}
+
+entity doc {
+	relation parent @folder
+	relation owner @user
+
+	permission delete = owner or parent.org.admin
+}
+`
+
+	Context("Multi-hop Sample: Check", func() {
+		It("Multi-hop Sample: Case 1", func() {
+			var err error
+
+			// SCHEMA
+
+			schemaReader := new(mocks.SchemaReader)
+
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, multiHopSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var doc *base.EntityDefinition
+			doc, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var organization *base.EntityDefinition
+			organization, err = schema.GetEntityByName(sch, "organization")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil).Times(2)
+			schemaReader.On("ReadSchemaDefinition", "t1", "organization", "noop").Return(organization, "noop", nil).Times(1)
+
+			// RELATIONSHIPS
+
+			relationshipReader := new(mocks.RelationshipReader)
+
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "doc",
+					Ids:  []string{"1"},
+				},
+				Relation: "owner",
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{}...), nil).Times(1)
+
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "doc",
+					Ids:  []string{"1"},
+				},
+				Relation: "parent",
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{
+				{
+					Entity: &base.Entity{
+						Type: "doc",
+						Id:   "1",
+					},
+					Relation: "parent",
+					Subject: &base.Subject{
+						Type:     "folder",
+						Id:       "1",
+						Relation: tuple.ELLIPSIS,
+					},
+				},
+			}...), nil).Times(1)
+
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "folder",
+					Ids:  []string{"1"},
+				},
+				Relation: "org",
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{
+				{
+					Entity: &base.Entity{
+						Type: "folder",
+						Id:   "1",
+					},
+					Relation: "org",
+					Subject: &base.Subject{
+						Type:     "organization",
+						Id:       "1",
+						Relation: tuple.ELLIPSIS,
+					},
+				},
+			}...), nil).Times(1)
+
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"1"},
+				},
+				Relation: "admin",
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{
+				{
+					Entity: &base.Entity{
+						Type: "organization",
+						Id:   "1",
+					},
+					Relation: "admin",
+					Subject: &base.Subject{
+						Type:     tuple.USER,
+						Id:       "1",
+						Relation: "",
+					},
+				},
+			}...), nil).Times(1)
+
+			checkEngine = NewCheckEngine(keys.NewNoopCheckEngineKeys(), schemaReader, relationshipReader)
+
+			req := &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+				Permission: "delete",
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Exclusion:     false,
+					Depth:         20,
+				},
+			}
+
+			var response *base.PermissionCheckResponse
+			response, err = checkEngine.Run(context.Background(), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(base.PermissionCheckResponse_RESULT_ALLOWED).Should(Equal(response.GetCan()))
+		})
+	})
+
+	butNotSchema := `
+entity user {}
+
+entity repository {
+	relation editor @user
+	relation suspended @user
+	relation banned @user
+
+	permission edit = editor but not (suspended or banned)
+}
+`
+
+	Context("But Not Sample: Check", func() {
+		It("But Not Sample: Case 1", func() {
+			var err error
+
+			// SCHEMA
+
+			schemaReader := new(mocks.SchemaReader)
+
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, butNotSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var repository *base.EntityDefinition
+			repository, err = schema.GetEntityByName(sch, "repository")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader.On("ReadSchemaDefinition", "t1", "repository", "noop").Return(repository, "noop", nil)
+
+			// RELATIONSHIPS
+
+			relationshipReader := new(mocks.RelationshipReader)
+
+			subjects := map[string][]string{
+				"editor":    {"1", "2"},
+				"suspended": {},
+				"banned":    {"2"},
+			}
+
+			for relation, ids := range subjects {
+				var tuples []*base.Tuple
+				for _, id := range ids {
+					tuples = append(tuples, &base.Tuple{
+						Entity: &base.Entity{
+							Type: "repository",
+							Id:   "1",
+						},
+						Relation: relation,
+						Subject: &base.Subject{
+							Type:     tuple.USER,
+							Id:       id,
+							Relation: "",
+						},
+					})
+				}
+
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "repository",
+						Ids:  []string{"1"},
+					},
+					Relation: relation,
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator(tuples...)
+				}, nil)
+			}
+
+			checkEngine = NewCheckEngine(keys.NewNoopCheckEngineKeys(), schemaReader, relationshipReader)
+
+			expected := map[string]base.PermissionCheckResponse_Result{
+				"1": base.PermissionCheckResponse_RESULT_ALLOWED,
+				"2": base.PermissionCheckResponse_RESULT_DENIED,
+				"3": base.PermissionCheckResponse_RESULT_DENIED,
+			}
+
+			for id, can := range expected {
+				req := &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "repository", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: id},
+					Permission: "edit",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Exclusion:     false,
+						Depth:         20,
+					},
+				}
+
+				var response *base.PermissionCheckResponse
+				response, err = checkEngine.Run(context.Background(), req)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(can))
+			}
+		})
+	})
+
+	nestedGroupsSchema := `
+entity user {}
+
+entity group {
+	relation member @user @group#member
+
+	permission view = member
+}
+`
+
+	Context("Nested Groups Sample: Check", func() {
+		It("Nested Groups Sample: Case 1", func() {
+			var err error
+
+			// SCHEMA
+
+			schemaReader := new(mocks.SchemaReader)
+
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, nestedGroupsSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var group *base.EntityDefinition
+			group, err = schema.GetEntityByName(sch, "group")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader.On("ReadSchemaDefinition", "t1", "group", "noop").Return(group, "noop", nil).Times(2)
+
+			// RELATIONSHIPS
+
+			// the nested groups are resolved by the recursive reader only
+			relationshipReader := new(mocks.RelationshipReader)
+
+			recursiveReader := new(mocks.RecursiveRelationshipReader)
+			recursiveReader.On("CheckRecursiveMembership", "t1", &base.Entity{Type: "group", Id: "1"}, "member", &base.Subject{Type: tuple.USER, Id: "1"}, token.NewNoopToken().Encode().String(), int32(19)).Return(true, nil).Times(1)
+
+			checkEngine = NewCheckEngine(keys.NewNoopCheckEngineKeys(), schemaReader, relationshipReader, CheckRecursiveReader(recursiveReader))
+
+			req := &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "group", Id: "1"},
+				Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+				Permission: "view",
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Exclusion:     false,
+					Depth:         20,
+				},
+			}
+
+			var response *base.PermissionCheckResponse
+			response, err = checkEngine.Run(context.Background(), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(base.PermissionCheckResponse_RESULT_ALLOWED).Should(Equal(response.GetCan()))
+			recursiveReader.AssertExpectations(GinkgoT())
+		})
+
+		It("Nested Groups Sample: Case 2", func() {
+			var err error
+
+			// SCHEMA
+
+			schemaReader := new(mocks.SchemaReader)
+
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, nestedGroupsSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var group *base.EntityDefinition
+			group, err = schema.GetEntityByName(sch, "group")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader.On("ReadSchemaDefinition", "t1", "group", "noop").Return(group, "noop", nil).Times(1)
+
+			// RELATIONSHIPS
+
+			relationshipReader := new(mocks.RelationshipReader)
+
+			recursiveReader := new(mocks.RecursiveRelationshipReader)
+			recursiveReader.On("CheckRecursiveMembership", "t1", &base.Entity{Type: "group", Id: "1"}, "member", &base.Subject{Type: tuple.USER, Id: "1"}, token.NewNoopToken().Encode().String(), int32(3)).Return(false, errors.New(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String())).Times(1)
+
+			checkEngine = NewCheckEngine(keys.NewNoopCheckEngineKeys(), schemaReader, relationshipReader, CheckRecursiveReader(recursiveReader))
+
+			req := &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "group", Id: "1"},
+				Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+				Permission: "member",
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Exclusion:     false,
+					Depth:         3,
+				},
+			}
+
+			_, err = checkEngine.Run(context.Background(), req)
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String()))
+		})
+	})
 })
@@ -134,6 +134,8 @@ func (command *ExpandEngine) expandRewrite(ctx context.Context, request *base.Pe
 		return command.setChild(ctx, request, rewrite.GetChildren(), expandUnion)
 	case *base.Rewrite_OPERATION_INTERSECTION.Enum():
 		return command.setChild(ctx, request, rewrite.GetChildren(), expandIntersection)
+	case *base.Rewrite_OPERATION_EXCLUSION.Enum():
+		return command.setChild(ctx, request, rewrite.GetChildren(), expandExclusion)
 	default:
 		return expandFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
 	}
@@ -271,8 +273,8 @@ func (command *ExpandEngine) expandDirect(ctx context.Context, request *base.Per
 
 // expandTupleToUserSet is an ExpandFunction that retrieves relationships matching the given entity and relation filter,
 // and expands each relationship into a set of users that have the corresponding tuple values. If the relationship subject
-// contains an ellipsis (i.e. "..."), the function will recursively expand the computed user set for that entity. The
-// exclusion parameter determines whether the resulting user set should be included or excluded from the final permission set.
+// contains an ellipsis (i.e. "..."), the function will recursively expand the computed user set for that entity, or the
+// next hops of a multi-hop path. The exclusion parameter determines whether the resulting user set should be included or excluded from the final permission set.
 // The function returns an ExpandFunction that sends the expanded user set to the provided channel.
 //
 // Parameters:
@@ -303,7 +305,7 @@ func (command *ExpandEngine) expandTupleToUserSet(ctx context.Context, request *
 		for it.HasNext() {
 			subject := it.GetNext().GetSubject()
 			if subject.GetRelation() == tuple.ELLIPSIS {
-				expandFunctions = append(expandFunctions, command.expandComputedUserSet(ctx, &base.PermissionExpandRequest{
+				req := &base.PermissionExpandRequest{
 					TenantId: request.GetTenantId(),
 					Entity: &base.Entity{
 						Type: subject.GetType(),
@@ -311,7 +313,13 @@ func (command *ExpandEngine) expandTupleToUserSet(ctx context.Context, request *
 					},
 					Permission: subject.GetRelation(),
 					Metadata:   request.GetMetadata(),
-				}, ttu.GetComputed(), exclusion))
+				}
+				// the next hops of a multi-hop path are expanded on the subjects of the tuple set
+				if ttu.GetNext() != nil {
+					expandFunctions = append(expandFunctions, command.expandTupleToUserSet(ctx, req, ttu.GetNext(), exclusion))
+					continue
+				}
+				expandFunctions = append(expandFunctions, command.expandComputedUserSet(ctx, req, ttu.GetComputed(), exclusion))
 			}
 		}
 
@@ -477,6 +485,21 @@ func expandIntersection(ctx context.Context, functions []ExpandFunction) ExpandR
 	return expandOperation(ctx, functions, base.ExpandTreeNode_OPERATION_INTERSECTION)
 }
 
+// expandExclusion is a helper function that executes multiple ExpandFunctions in parallel and returns an ExpandResponse
+// containing the difference of their expanded user sets. The first child of the resulting tree is the base of the
+// exclusion and the other children are excluded from it, so the order of the ExpandFunctions is kept. The function
+// delegates to expandOperation with the EXCLUSION operation.
+//
+// Parameters:
+//   - ctx: context.Context for the request
+//   - functions: slice of ExpandFunctions to execute in parallel
+//
+// Returns:
+//   - ExpandResponse containing the difference of the expanded user sets, or an error if any of the ExpandFunctions failed
+func expandExclusion(ctx context.Context, functions []ExpandFunction) ExpandResponse {
+	return expandOperation(ctx, functions, base.ExpandTreeNode_OPERATION_EXCLUSION)
+}
+
 // expandFail is a helper function that returns an ExpandFunction that immediately sends an ExpandResponse with the specified error
 // to the provided channel. The resulting ExpandResponse contains an empty ExpandTreeNode and the specified error.
 //
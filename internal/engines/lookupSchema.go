@@ -3,13 +3,13 @@ package engines
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"golang.org/x/exp/slices"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 
 	"permify/internal/repositories"
+	"permify/internal/schema"
 	base "permify/pkg/pb/base/v1"
 )
 
@@ -122,8 +122,8 @@ func (command *LookupSchemaEngine) l(ctx context.Context, request *base.Permissi
 }
 
 // lookupRewrite takes a PermissionLookupSchemaRequest, a Rewrite object and returns a SchemaLookupFunction that is used to determine
-// whether the specified schema is accessible or not by looking up its children. Depending on the rewrite operation (UNION or INTERSECTION)
-// it uses either the schemaLookupUnion or schemaLookupIntersection function. It returns a function that is used by schemaLookupCombiner.
+// whether the specified schema is accessible or not by looking up its children. Depending on the rewrite operation (UNION, INTERSECTION
+// or EXCLUSION) it uses the schemaLookupUnion, schemaLookupIntersection or schemaLookupExclusion function. It returns a function that is used by schemaLookupCombiner.
 // If the operation is not defined, it returns a schemaLookupFail function with an error.
 func (command *LookupSchemaEngine) lookupRewrite(ctx context.Context, request *base.PermissionLookupSchemaRequest, rewrite *base.Rewrite) SchemaLookupFunction {
 	switch rewrite.GetRewriteOperation() {
@@ -131,6 +131,8 @@ func (command *LookupSchemaEngine) lookupRewrite(ctx context.Context, request *b
 		return command.setChild(ctx, request, rewrite.GetChildren(), schemaLookupUnion)
 	case *base.Rewrite_OPERATION_INTERSECTION.Enum():
 		return command.setChild(ctx, request, rewrite.GetChildren(), schemaLookupIntersection)
+	case *base.Rewrite_OPERATION_EXCLUSION.Enum():
+		return command.setChild(ctx, request, rewrite.GetChildren(), schemaLookupExclusion)
 	default:
 		return schemaLookupFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
 	}
@@ -141,7 +143,7 @@ func (command *LookupSchemaEngine) lookupRewrite(ctx context.Context, request *b
 func (command *LookupSchemaEngine) lookupLeaf(ctx context.Context, request *base.PermissionLookupSchemaRequest, leaf *base.Leaf) SchemaLookupFunction {
 	switch leaf.GetType().(type) {
 	case *base.Leaf_TupleToUserSet:
-		return command.lookup(ctx, fmt.Sprintf("%s.%s", leaf.GetTupleToUserSet().GetTupleSet().GetRelation(), leaf.GetTupleToUserSet().GetComputed().GetRelation()), request, leaf.GetExclusion())
+		return command.lookup(ctx, schema.GetTupleToUserSetPath(leaf.GetTupleToUserSet()), request, leaf.GetExclusion())
 	case *base.Leaf_ComputedUserSet:
 		return command.lookup(ctx, leaf.GetComputedUserSet().GetRelation(), request, leaf.GetExclusion())
 	default:
@@ -260,6 +262,29 @@ func schemaLookupIntersection(ctx context.Context, functions []SchemaLookupFunct
 	return sendSchemaLookupDecision(true, nil)
 }
 
+// This is a function that takes in a context and a slice of SchemaLookupFunctions, and returns a SchemaLookupDecision
+// indicating whether the decision of the first input function is true while the union of the decisions of the other
+// input functions is false. If any of the input functions return an error, the output SchemaLookupDecision will also
+// contain that error. The first function and the union of the others are evaluated concurrently.
+func schemaLookupExclusion(ctx context.Context, functions []SchemaLookupFunction) SchemaLookupDecision {
+	if len(functions) == 0 {
+		return sendSchemaLookupDecision(true, nil)
+	}
+
+	excluded := functions[1:]
+	return schemaLookupIntersection(ctx, []SchemaLookupFunction{
+		functions[0],
+		func(ctx context.Context, lookupChan chan<- SchemaLookupDecision) {
+			if len(excluded) == 0 {
+				lookupChan <- sendSchemaLookupDecision(true, nil)
+				return
+			}
+			result := schemaLookupUnion(ctx, excluded)
+			lookupChan <- sendSchemaLookupDecision(!result.Can && result.Err == nil, result.Err)
+		},
+	})
+}
+
 // This is a function that creates a new SchemaLookupFunction that always returns a failure decision with the given error message.
 // It takes an error parameter and returns a SchemaLookupFunction. The SchemaLookupFunction takes a context.Context and
 // a chan<- SchemaLookupDecision parameter, and sends a failure SchemaLookupDecision to the provided channel with the given
@@ -2,6 +2,7 @@ package engines
 
 import (
 	"context"
+	"strings"
 
 	"golang.org/x/exp/slices"
 
@@ -212,4 +213,114 @@ var _ = Describe("lookup-schema-engine", func() {
 			}
 		})
 	})
+
+	// MULTI-HOP SAMPLE
+
+	multiHopSchema := `
+	entity user {}
+
+	entity organization {
+		relation admin @user
+	}
+
+	entity folder {
+		relation org @organization
+	}
+
+	entity doc {
+		relation parent @folder
+		relation owner @user
+
+		action read = owner or parent.org.admin
+		action delete = owner and parent.org.admin
+	}
+	`
+
+	Context("Multi-hop Sample: Lookup Schema", func() {
+		It("Multi-hop Sample: Case 1", func() {
+			var err error
+
+			// SCHEMA
+
+			schemaReader := new(mocks.SchemaReader)
+
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, multiHopSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var en *base.EntityDefinition
+			en, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(en, "noop", nil).Times(1)
+
+			lookupSchemaEngine = NewLookupSchemaEngine(schemaReader)
+
+			req := &base.PermissionLookupSchemaRequest{
+				TenantId:      "t1",
+				EntityType:    "doc",
+				RelationNames: []string{"parent.org.admin"},
+				Metadata: &base.PermissionLookupSchemaRequestMetadata{
+					SchemaVersion: "noop",
+				},
+			}
+
+			actualResult, err := lookupSchemaEngine.Run(context.Background(), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(actualResult.PermissionNames).Should(Equal([]string{"read"}))
+		})
+	})
+
+	butNotSchema := `
+	entity user {}
+
+	entity repository {
+		relation editor @user
+		relation banned @user
+
+		action edit = editor but not banned
+	}
+	`
+
+	Context("But Not Sample: Lookup Schema", func() {
+		It("But Not Sample: Case 1", func() {
+			var err error
+
+			// SCHEMA
+
+			schemaReader := new(mocks.SchemaReader)
+
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, butNotSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var en *base.EntityDefinition
+			en, err = schema.GetEntityByName(sch, "repository")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader.On("ReadSchemaDefinition", "t1", "repository", "noop").Return(en, "noop", nil)
+
+			lookupSchemaEngine = NewLookupSchemaEngine(schemaReader)
+
+			expected := map[string][]string{
+				"editor":        {"edit"},
+				"editor,banned": {},
+			}
+
+			for relations, permissions := range expected {
+				req := &base.PermissionLookupSchemaRequest{
+					TenantId:      "t1",
+					EntityType:    "repository",
+					RelationNames: strings.Split(relations, ","),
+					Metadata: &base.PermissionLookupSchemaRequestMetadata{
+						SchemaVersion: "noop",
+					},
+				}
+
+				actualResult, err := lookupSchemaEngine.Run(context.Background(), req)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(actualResult.PermissionNames).Should(ConsistOf(permissions))
+			}
+		})
+	})
 })
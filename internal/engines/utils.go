@@ -6,6 +6,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 
+	"permify/internal/repositories"
 	base "permify/pkg/pb/base/v1"
 	"permify/pkg/tuple"
 )
@@ -26,6 +27,14 @@ func CheckConcurrencyLimit(limit int) CheckOption {
 	}
 }
 
+// CheckRecursiveReader - a functional option that sets the reader that resolves self-referential relations, such as
+// nested groups, for the CheckEngine.
+func CheckRecursiveReader(reader repositories.RecursiveRelationshipReader) CheckOption {
+	return func(c *CheckEngine) {
+		c.recursiveReader = reader
+	}
+}
+
 // LookupEntityOption - a functional option type for configuring the LookupEntityEngine.
 type LookupEntityOption func(engine *LookupEntityEngine)
 
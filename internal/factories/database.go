@@ -7,21 +7,26 @@ import (
 	"permify/internal/repositories/memory/migrations"
 	"permify/pkg/database"
 	IMDatabase "permify/pkg/database/memory"
+	MYDatabase "permify/pkg/database/mysql"
 	PQDatabase "permify/pkg/database/postgres"
+	SQDatabase "permify/pkg/database/sqlite"
 )
 
 // DatabaseFactory is a factory function that creates a database instance according to the given configuration.
-// It supports different types of databases, such as PostgreSQL and in-memory databases.
+// It supports different types of databases, such as PostgreSQL, MySQL, SQLite and in-memory databases.
 //
 // conf: the configuration object containing the necessary information to create a database connection.
 //
 //	It should have the following properties:
-//	- Engine: the type of the database, e.g., POSTGRES or MEMORY
-//	- URI: the connection string for the database (only required for some database engines, e.g., POSTGRES)
+//	- Engine: the type of the database, e.g., POSTGRES, MYSQL, SQLITE or MEMORY
+//	- URI: the connection string for the database (only required for some database engines, e.g., POSTGRES, MYSQL or SQLITE)
+//	- ReadReplicaURIs: the connection strings of the read replicas (only used by POSTGRES)
+//	- ReadReplicaMaxLag: how far behind the primary a replica may be for the reads without a snapshot (only used by POSTGRES)
 //	- MaxOpenConnections: the maximum number of open connections to the database
 //	- MaxIdleConnections: the maximum number of idle connections in the connection pool
 //	- MaxConnectionIdleTime: the maximum amount of time a connection can be idle before being closed
 //	- MaxConnectionLifetime: the maximum amount of time a connection can be reused before being closed
+//	- Memory: the write-ahead log and snapshot options of the in-memory database, it is only kept in memory without a path
 //
 // Returns a database.Database instance if the database connection is successfully created, or an error if the
 // creation fails or the specified database engine is unsupported.
@@ -33,13 +38,42 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 			PQDatabase.MaxIdleConnections(conf.MaxIdleConnections),
 			PQDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
 			PQDatabase.MaxConnectionLifeTime(conf.MaxConnectionLifetime),
+			PQDatabase.ReadReplicas(conf.ReadReplicaURIs...),
+			PQDatabase.ReplicaMaxLag(conf.ReadReplicaMaxLag),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return
+	case database.MYSQL.String():
+		db, err = MYDatabase.New(conf.URI,
+			MYDatabase.MaxOpenConnections(conf.MaxOpenConnections),
+			MYDatabase.MaxIdleConnections(conf.MaxIdleConnections),
+			MYDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
+			MYDatabase.MaxConnectionLifeTime(conf.MaxConnectionLifetime),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return
+	case database.SQLITE.String():
+		db, err = SQDatabase.New(conf.URI,
+			SQDatabase.MaxOpenConnections(conf.MaxOpenConnections),
+			SQDatabase.MaxIdleConnections(conf.MaxIdleConnections),
+			SQDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
+			SQDatabase.MaxConnectionLifeTime(conf.MaxConnectionLifetime),
 		)
 		if err != nil {
 			return nil, err
 		}
 		return
 	case database.MEMORY.String():
-		db, err = IMDatabase.New(migrations.Schema)
+		db, err = IMDatabase.New(migrations.Schema,
+			IMDatabase.Path(conf.Memory.Path),
+			IMDatabase.Sync(conf.Memory.Sync),
+			IMDatabase.SyncEvery(conf.Memory.SyncInterval),
+			IMDatabase.CompactionInterval(conf.Memory.CompactionInterval),
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -1,55 +1,74 @@
 package factories
 
 import (
+	"context"
+
+	"permify/internal/config"
 	"permify/internal/repositories"
 	MMRepository "permify/internal/repositories/memory"
+	MYRepository "permify/internal/repositories/mysql"
 	PQRepository "permify/internal/repositories/postgres"
+	SQRepository "permify/internal/repositories/sqlite"
+	SQLRepository "permify/internal/repositories/sqlrepo"
 	"permify/pkg/database"
 	MMDatabase "permify/pkg/database/memory"
+	MYDatabase "permify/pkg/database/mysql"
 	PQDatabase "permify/pkg/database/postgres"
+	SQDatabase "permify/pkg/database/sqlite"
 	"permify/pkg/logger"
+	"permify/pkg/token"
 )
 
 // RelationshipReaderFactory is a factory function that returns a relationship reader instance according to the
-// given database interface. It supports different types of databases, such as PostgreSQL and in-memory databases.
+// given database interface. It supports different types of databases, such as PostgreSQL, MySQL, SQLite and in-memory databases.
 //
 // db: the database.Database instance for which the relationship reader should be created
+// keys: the token.SigningKeys instance that signs the snap tokens of the postgres and in-memory databases, the others ignore it
 // logger: the logger.Interface instance to be used by the relationship reader for logging purposes
 //
 // Returns a repositories.RelationshipReader instance that performs read operations on the relationships stored
 // in the given database. If the database engine type is not recognized, it defaults to an in-memory database.
-func RelationshipReaderFactory(db database.Database, logger logger.Interface) (repo repositories.RelationshipReader) {
+func RelationshipReaderFactory(db database.Database, keys *token.SigningKeys, logger logger.Interface) (repo repositories.RelationshipReader) {
 	switch db.GetEngineType() {
 	case "postgres":
-		return PQRepository.NewRelationshipReader(db.(*PQDatabase.Postgres), logger)
+		return PQRepository.NewRelationshipReader(db.(*PQDatabase.Postgres), keys, logger)
+	case "mysql":
+		return SQLRepository.NewRelationshipReader(MYRepository.NewDatabase(db.(*MYDatabase.MySQL)), logger)
+	case "sqlite":
+		return SQLRepository.NewRelationshipReader(SQRepository.NewDatabase(db.(*SQDatabase.SQLite)), logger)
 	case "memory":
-		return MMRepository.NewRelationshipReader(db.(*MMDatabase.Memory), logger)
+		return MMRepository.NewRelationshipReader(db.(*MMDatabase.Memory), keys, logger)
 	default:
-		return MMRepository.NewRelationshipReader(db.(*MMDatabase.Memory), logger)
+		return MMRepository.NewRelationshipReader(db.(*MMDatabase.Memory), keys, logger)
 	}
 }
 
 // RelationshipWriterFactory is a factory function that returns a relationship writer instance according to the
-// given database interface. It supports different types of databases, such as PostgreSQL and in-memory databases.
+// given database interface. It supports different types of databases, such as PostgreSQL, MySQL, SQLite and in-memory databases.
 //
 // db: the database.Database instance for which the relationship writer should be created
+// keys: the token.SigningKeys instance that signs the snap tokens of the postgres and in-memory databases, the others ignore it
 // logger: the logger.Interface instance to be used by the relationship writer for logging purposes
 //
 // Returns a repositories.RelationshipWriter instance that performs write operations on the relationships stored
 // in the given database. If the database engine type is not recognized, it defaults to an in-memory database.
-func RelationshipWriterFactory(db database.Database, logger logger.Interface) (repo repositories.RelationshipWriter) {
+func RelationshipWriterFactory(db database.Database, keys *token.SigningKeys, logger logger.Interface) (repo repositories.RelationshipWriter) {
 	switch db.GetEngineType() {
 	case "postgres":
-		return PQRepository.NewRelationshipWriter(db.(*PQDatabase.Postgres), logger)
+		return PQRepository.NewRelationshipWriter(db.(*PQDatabase.Postgres), keys, logger)
+	case "mysql":
+		return SQLRepository.NewRelationshipWriter(MYRepository.NewDatabase(db.(*MYDatabase.MySQL)), logger)
+	case "sqlite":
+		return SQLRepository.NewRelationshipWriter(SQRepository.NewDatabase(db.(*SQDatabase.SQLite)), logger)
 	case "memory":
-		return MMRepository.NewRelationshipWriter(db.(*MMDatabase.Memory), logger)
+		return MMRepository.NewRelationshipWriter(db.(*MMDatabase.Memory), keys, logger)
 	default:
-		return MMRepository.NewRelationshipWriter(db.(*MMDatabase.Memory), logger)
+		return MMRepository.NewRelationshipWriter(db.(*MMDatabase.Memory), keys, logger)
 	}
 }
 
 // SchemaReaderFactory is a factory function that returns a schema reader instance according to the
-// given database interface. It supports different types of databases, such as PostgreSQL and in-memory databases.
+// given database interface. It supports different types of databases, such as PostgreSQL, MySQL, SQLite and in-memory databases.
 //
 // db: the database.Database instance for which the schema reader should be created
 // logger: the logger.Interface instance to be used by the schema reader for logging purposes
@@ -60,6 +79,10 @@ func SchemaReaderFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewSchemaReader(db.(*PQDatabase.Postgres), logger)
+	case "mysql":
+		return SQLRepository.NewSchemaReader(MYRepository.NewDatabase(db.(*MYDatabase.MySQL)), logger)
+	case "sqlite":
+		return SQLRepository.NewSchemaReader(SQRepository.NewDatabase(db.(*SQDatabase.SQLite)), logger)
 	case "memory":
 		return MMRepository.NewSchemaReader(db.(*MMDatabase.Memory), logger)
 	default:
@@ -68,7 +91,7 @@ func SchemaReaderFactory(db database.Database, logger logger.Interface) (repo re
 }
 
 // SchemaWriterFactory is a factory function that returns a schema writer instance according to the
-// given database interface. It supports different types of databases, such as PostgreSQL and in-memory databases.
+// given database interface. It supports different types of databases, such as PostgreSQL, MySQL, SQLite and in-memory databases.
 //
 // db: the database.Database instance for which the schema writer should be created
 // logger: the logger.Interface instance to be used by the schema writer for logging purposes
@@ -79,6 +102,10 @@ func SchemaWriterFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewSchemaWriter(db.(*PQDatabase.Postgres), logger)
+	case "mysql":
+		return SQLRepository.NewSchemaWriter(MYRepository.NewDatabase(db.(*MYDatabase.MySQL)), logger)
+	case "sqlite":
+		return SQLRepository.NewSchemaWriter(SQRepository.NewDatabase(db.(*SQDatabase.SQLite)), logger)
 	case "memory":
 		return MMRepository.NewSchemaWriter(db.(*MMDatabase.Memory), logger)
 	default:
@@ -87,7 +114,7 @@ func SchemaWriterFactory(db database.Database, logger logger.Interface) (repo re
 }
 
 // TenantReaderFactory is a factory function that returns a tenant reader instance according to the
-// given database interface. It supports different types of databases, such as PostgreSQL and in-memory databases.
+// given database interface. It supports different types of databases, such as PostgreSQL, MySQL, SQLite and in-memory databases.
 //
 // db: the database.Database instance for which the tenant reader should be created
 // logger: the logger.Interface instance to be used by the tenant reader for logging purposes
@@ -98,6 +125,10 @@ func TenantReaderFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewTenantReader(db.(*PQDatabase.Postgres), logger)
+	case "mysql":
+		return SQLRepository.NewTenantReader(MYRepository.NewDatabase(db.(*MYDatabase.MySQL)), logger)
+	case "sqlite":
+		return SQLRepository.NewTenantReader(SQRepository.NewDatabase(db.(*SQDatabase.SQLite)), logger)
 	case "memory":
 		return MMRepository.NewTenantReader(db.(*MMDatabase.Memory), logger)
 	default:
@@ -106,7 +137,7 @@ func TenantReaderFactory(db database.Database, logger logger.Interface) (repo re
 }
 
 // TenantWriterFactory is a factory function that returns a tenant writer instance according to the
-// given database interface. It supports different types of databases, such as PostgreSQL and in-memory databases.
+// given database interface. It supports different types of databases, such as PostgreSQL, MySQL, SQLite and in-memory databases.
 //
 // db: the database.Database instance for which the tenant writer should be created
 // logger: the logger.Interface instance to be used by the tenant writer for logging purposes
@@ -117,9 +148,55 @@ func TenantWriterFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewTenantWriter(db.(*PQDatabase.Postgres), logger)
+	case "mysql":
+		return SQLRepository.NewTenantWriter(MYRepository.NewDatabase(db.(*MYDatabase.MySQL)), logger)
+	case "sqlite":
+		return SQLRepository.NewTenantWriter(SQRepository.NewDatabase(db.(*SQDatabase.SQLite)), logger)
 	case "memory":
 		return MMRepository.NewTenantWriter(db.(*MMDatabase.Memory), logger)
 	default:
 		return MMRepository.NewTenantWriter(db.(*MMDatabase.Memory), logger)
 	}
 }
+
+// GarbageCollectorFactory is a factory function that returns a garbage collector instance according to the
+// given database interface. It supports PostgreSQL, SQLite and in-memory databases.
+//
+// ctx: the context that stops the periodic collection when it is cancelled
+// db: the database.Database instance whose expired tuples and transactions should be collected
+// logger: the logger.Interface instance to be used by the garbage collector for logging purposes
+// cfg: the interval, timeout, window and number of threads of the collection
+//
+// Returns a repositories.GarbageCollector instance, or nil if the database engine does not keep the history of
+// the relationships.
+func GarbageCollectorFactory(ctx context.Context, db database.Database, logger logger.Interface, cfg config.DatabaseGarbageCollection) (gc repositories.GarbageCollector) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewGarbageCollector(ctx, db.(*PQDatabase.Postgres), logger, cfg)
+	case "sqlite":
+		return SQRepository.NewGarbageCollector(ctx, db.(*SQDatabase.SQLite), logger, cfg)
+	case "memory":
+		return MMRepository.NewGarbageCollector(ctx, db.(*MMDatabase.Memory), logger, cfg)
+	default:
+		return nil
+	}
+}
+
+// ChangeListenerFactory is a factory function that returns a change listener instance according to the
+// given database interface. It supports PostgreSQL and in-memory databases.
+//
+// db: the database.Database instance whose writers publish their changes
+// logger: the logger.Interface instance to be used by the change listener for logging purposes
+//
+// Returns a repositories.ChangeListener instance, or nil if the database engine does not publish the changes of
+// its writers.
+func ChangeListenerFactory(db database.Database, logger logger.Interface) (listener repositories.ChangeListener) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewChangeListener(db.(*PQDatabase.Postgres), logger)
+	case "memory":
+		return MMRepository.NewChangeListener(db.(*MMDatabase.Memory), logger)
+	default:
+		return nil
+	}
+}
@@ -13,15 +13,17 @@ import (
 
 // EngineKeys is a struct that holds an instance of a cache.Cache for managing engine keys.
 type EngineKeys struct {
-	cache cache.Cache
+	cache       cache.Cache
+	generations *cache.Generations
 }
 
 // NewCheckEngineKeys creates a new instance of EngineKeyManager by initializing an EngineKeys
 // struct with the provided cache.Cache instance.
-func NewCheckEngineKeys(cache cache.Cache) EngineKeyManager {
+func NewCheckEngineKeys(c cache.Cache) EngineKeyManager {
 	// Return a new instance of EngineKeys with the provided cache
 	return &EngineKeys{
-		cache: cache,
+		cache:       c,
+		generations: cache.NewGenerations(),
 	}
 }
 
@@ -34,7 +36,7 @@ func (c *EngineKeys) SetCheckKey(key *base.PermissionCheckRequest, value *base.P
 	}
 
 	// Generate a unique checkKey string based on the provided PermissionCheckRequest
-	checkKey := fmt.Sprintf("check_%s_%s:%s:%s@%s", key.GetTenantId(), key.GetMetadata().GetSchemaVersion(), key.GetMetadata().GetSnapToken(), tuple.EntityAndRelationToString(&base.EntityAndRelation{
+	checkKey := fmt.Sprintf("check_%s_%s_%s:%s:%s@%s", key.GetTenantId(), c.generations.Get(key.GetTenantId()), key.GetMetadata().GetSchemaVersion(), key.GetMetadata().GetSnapToken(), tuple.EntityAndRelationToString(&base.EntityAndRelation{
 		Entity:   key.GetEntity(),
 		Relation: key.GetPermission(),
 	}), tuple.SubjectToString(key.GetSubject()))
@@ -66,7 +68,7 @@ func (c *EngineKeys) GetCheckKey(key *base.PermissionCheckRequest) (*base.Permis
 	}
 
 	// Generate a unique checkKey string based on the provided PermissionCheckRequest
-	checkKey := fmt.Sprintf("check_%s_%s:%s:%s@%s", key.GetTenantId(), key.GetMetadata().GetSchemaVersion(), key.GetMetadata().GetSnapToken(), tuple.EntityAndRelationToString(&base.EntityAndRelation{
+	checkKey := fmt.Sprintf("check_%s_%s_%s:%s:%s@%s", key.GetTenantId(), c.generations.Get(key.GetTenantId()), key.GetMetadata().GetSchemaVersion(), key.GetMetadata().GetSnapToken(), tuple.EntityAndRelationToString(&base.EntityAndRelation{
 		Entity:   key.GetEntity(),
 		Relation: key.GetPermission(),
 	}), tuple.SubjectToString(key.GetSubject()))
@@ -102,6 +104,12 @@ func (c *EngineKeys) GetCheckKey(key *base.PermissionCheckRequest) (*base.Permis
 	return nil, false
 }
 
+// InvalidateTenant makes the cached check results of the tenant unreachable, or of every tenant if the
+// tenant id is empty. The entries are not removed, they are evicted by the cache over time.
+func (c *EngineKeys) InvalidateTenant(tenantID string) {
+	c.generations.Invalidate(tenantID)
+}
+
 // NoopEngineKeys is an empty struct that implements the EngineKeyManager interface
 // with no-op (no operation) methods, meaning they do not perform any real work or caching.
 type NoopEngineKeys struct{}
@@ -126,3 +134,7 @@ func (c *NoopEngineKeys) SetCheckKey(*base.PermissionCheckRequest, *base.Permiss
 func (c *NoopEngineKeys) GetCheckKey(*base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
 	return nil, false
 }
+
+// InvalidateTenant is a no-op method that implements the InvalidateTenant method for the
+// EngineKeyManager interface, there are no cached results to invalidate.
+func (c *NoopEngineKeys) InvalidateTenant(string) {}
@@ -259,3 +259,54 @@ func TestEngineKeys_SetAndGetMultipleKeys(t *testing.T) {
 	assert.True(t, found3)
 	assert.Equal(t, checkResp3, resp3)
 }
+
+func TestEngineKeys_InvalidateTenant(t *testing.T) {
+	cache, err := ristretto.New()
+	assert.Nil(t, err)
+
+	engineKeys := NewCheckEngineKeys(cache)
+
+	request := func(tenantID string) *base.PermissionCheckRequest {
+		return &base.PermissionCheckRequest{
+			TenantId: tenantID,
+			Metadata: &base.PermissionCheckRequestMetadata{
+				SchemaVersion: "test_version",
+				SnapToken:     "test_snap_token",
+				Depth:         20,
+			},
+			Entity:     &base.Entity{Type: "test-entity", Id: "e1"},
+			Permission: "test-permission",
+			Subject:    &base.Subject{Type: tuple.USER, Id: "u1"},
+		}
+	}
+
+	checkResp := &base.PermissionCheckResponse{
+		Can: base.PermissionCheckResponse_RESULT_ALLOWED,
+	}
+
+	assert.True(t, engineKeys.SetCheckKey(request("t1"), checkResp))
+	assert.True(t, engineKeys.SetCheckKey(request("t2"), checkResp))
+	cache.Wait()
+
+	// Invalidating a tenant only hides the results of that tenant
+	engineKeys.InvalidateTenant("t1")
+
+	_, found := engineKeys.GetCheckKey(request("t1"))
+	assert.False(t, found)
+	_, found = engineKeys.GetCheckKey(request("t2"))
+	assert.True(t, found)
+
+	// Results cached after the invalidation are found again
+	assert.True(t, engineKeys.SetCheckKey(request("t1"), checkResp))
+	cache.Wait()
+	_, found = engineKeys.GetCheckKey(request("t1"))
+	assert.True(t, found)
+
+	// An empty tenant id invalidates every tenant
+	engineKeys.InvalidateTenant("")
+
+	_, found = engineKeys.GetCheckKey(request("t1"))
+	assert.False(t, found)
+	_, found = engineKeys.GetCheckKey(request("t2"))
+	assert.False(t, found)
+}
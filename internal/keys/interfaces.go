@@ -16,4 +16,8 @@ type EngineKeyManager interface {
 	// PermissionCheckRequest as the key and returns the corresponding PermissionCheckResponse
 	// if the key is found, along with a boolean value indicating whether the key was found or not.
 	GetCheckKey(key *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool)
+
+	// InvalidateTenant makes the cached results of the given tenant unreachable, so they are computed again
+	// after the tenant was changed by another instance. An empty tenant id invalidates every tenant.
+	InvalidateTenant(tenantID string)
 }
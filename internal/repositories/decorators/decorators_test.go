@@ -0,0 +1,15 @@
+package decorators
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// This is the entry point for the test suite for the "decorators" package.
+// It registers a failure handler and runs the specifications (specs) for this package.
+func TestDecorators(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "decorators-suite")
+}
@@ -0,0 +1,46 @@
+package decorators
+
+import (
+	"context"
+	"errors"
+
+	"github.com/afex/hystrix-go/hystrix"
+
+	"permify/internal/repositories"
+	base "permify/pkg/pb/base/v1"
+)
+
+// RecursiveRelationshipReaderWithCircuitBreaker - Add circuit breaker behaviour to recursive relationship reader
+type RecursiveRelationshipReaderWithCircuitBreaker struct {
+	delegate repositories.RecursiveRelationshipReader
+}
+
+// NewRecursiveRelationshipReaderWithCircuitBreaker - Add circuit breaker behaviour to new recursive relationship reader
+func NewRecursiveRelationshipReaderWithCircuitBreaker(delegate repositories.RecursiveRelationshipReader) *RecursiveRelationshipReaderWithCircuitBreaker {
+	return &RecursiveRelationshipReaderWithCircuitBreaker{delegate: delegate}
+}
+
+// CheckRecursiveMembership - Checks whether the subject is reachable from the entity through the relation
+func (r *RecursiveRelationshipReaderWithCircuitBreaker) CheckRecursiveMembership(ctx context.Context, tenantID string, entity *base.Entity, relation string, subject *base.Subject, snap string, depth int32) (bool, error) {
+	type circuitBreakerResponse struct {
+		Allowed bool
+		Error   error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("recursiveRelationshipReader.checkRecursiveMembership", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("recursiveRelationshipReader.checkRecursiveMembership", func() error {
+		allowed, err := r.delegate.CheckRecursiveMembership(ctx, tenantID, entity, relation, subject, snap, depth)
+		output <- circuitBreakerResponse{Allowed: allowed, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Allowed, out.Error
+	case <-bErrors:
+		return false, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
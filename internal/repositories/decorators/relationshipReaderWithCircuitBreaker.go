@@ -97,3 +97,72 @@ func (r *RelationshipReaderWithCircuitBreaker) HeadSnapshot(ctx context.Context,
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
 	}
 }
+
+// CheckSnapshot - Checks whether a snap token sent by a client can be read at.
+func (r *RelationshipReaderWithCircuitBreaker) CheckSnapshot(ctx context.Context, tenantID, snap string) error {
+	output := make(chan error, 1)
+	hystrix.ConfigureCommand("relationshipReader.checkSnapshot", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("relationshipReader.checkSnapshot", func() error {
+		output <- r.delegate.CheckSnapshot(ctx, tenantID, snap)
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case err := <-output:
+		return err
+	case <-bErrors:
+		return errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// RelationshipStats - Counts the relation tuples of the repository.
+func (r *RelationshipReaderWithCircuitBreaker) RelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (*repositories.RelationshipStats, error) {
+	type circuitBreakerResponse struct {
+		Stats *repositories.RelationshipStats
+		Error error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("relationshipReader.relationshipStats", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("relationshipReader.relationshipStats", func() error {
+		stats, err := r.delegate.RelationshipStats(ctx, tenantID, snap, top)
+		output <- circuitBreakerResponse{Stats: stats, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Stats, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// CountRelationships - Counts the relation tuples matching the filter
+func (r *RelationshipReaderWithCircuitBreaker) CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (uint64, error) {
+	type circuitBreakerResponse struct {
+		Count uint64
+		Error error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("relationshipReader.countRelationships", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("relationshipReader.countRelationships", func() error {
+		count, err := r.delegate.CountRelationships(ctx, tenantID, filter, snap)
+		output <- circuitBreakerResponse{Count: count, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Count, out.Error
+	case <-bErrors:
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
@@ -0,0 +1,243 @@
+package decorators
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"permify/internal/repositories"
+	"permify/pkg/database"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+)
+
+// RelationshipReaderWithCoalescing - Add request coalescing behaviour to relationship reader, concurrent queries that
+// only differ in their entity ids are sent to the repository as a single query. A query is sent at once when no query
+// of its shape is running, only the queries that arrive while one is running wait to be batched.
+type RelationshipReaderWithCoalescing struct {
+	delegate repositories.RelationshipReader
+	// how long the first query of a batch waits for others
+	window time.Duration
+	// number of entity ids a batch is sent at
+	maxBatchSize int
+
+	mu      sync.Mutex
+	batches map[string]*batch
+	// number of queries and batches of each shape that are sent to the repository
+	running map[string]int
+}
+
+// batch - queries of the same shape waiting to be sent together
+type batch struct {
+	tenantID string
+	filter   *base.TupleFilter
+	snap     string
+	ids      map[string]struct{}
+	timer    *time.Timer
+	// closed when the query of the batch is done
+	done   chan struct{}
+	tuples []*base.Tuple
+	err    error
+}
+
+// NewRelationshipReaderWithCoalescing new instance of RelationshipReaderWithCoalescing, a batch is sent when the
+// window of its first query passes or when it has max batch size entity ids
+func NewRelationshipReaderWithCoalescing(delegate repositories.RelationshipReader, window time.Duration, maxBatchSize int) *RelationshipReaderWithCoalescing {
+	if maxBatchSize < 1 {
+		maxBatchSize = 1
+	}
+	return &RelationshipReaderWithCoalescing{
+		delegate:     delegate,
+		window:       window,
+		maxBatchSize: maxBatchSize,
+		batches:      map[string]*batch{},
+		running:      map[string]int{},
+	}
+}
+
+// QueryRelationships - Reads relation tuples from the repository. Queries of given entity ids are added to the batch
+// of the queries with the same tenant, snapshot and filter apart from the ids, and get the tuples of their own ids
+// from the result of the batch.
+func (r *RelationshipReaderWithCoalescing) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	if len(filter.GetEntity().GetIds()) == 0 || len(filter.GetEntity().GetIds()) >= r.maxBatchSize {
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+
+	key, err := shape(tenantID, filter, snap)
+	if err != nil {
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+
+	b := r.join(ctx, key, tenantID, filter, snap)
+	if b == nil {
+		defer r.finish(key)
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.done:
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	ids := make(map[string]struct{}, len(filter.GetEntity().GetIds()))
+	for _, id := range filter.GetEntity().GetIds() {
+		ids[id] = struct{}{}
+	}
+	tuples := make([]*base.Tuple, 0)
+	for _, t := range b.tuples {
+		if _, ok := ids[t.GetEntity().GetId()]; ok {
+			tuples = append(tuples, t)
+		}
+	}
+	return database.NewTupleIterator(tuples...), nil
+}
+
+// join - adds the ids of the filter to the open batch of its shape, a new batch is opened if there is none or the
+// open one can not take the ids anymore. No batch is returned when nothing of the shape is running or waiting, the
+// query is then counted as running and the caller sends it itself.
+func (r *RelationshipReaderWithCoalescing) join(ctx context.Context, key, tenantID string, filter *base.TupleFilter, snap string) *batch {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.batches[key]
+	if !ok && r.running[key] == 0 {
+		r.running[key]++
+		return nil
+	}
+	if ok && len(b.ids)+len(filter.GetEntity().GetIds()) > r.maxBatchSize {
+		r.flush(key, b)
+		ok = false
+	}
+	if !ok {
+		b = &batch{
+			tenantID: tenantID,
+			filter:   filter,
+			snap:     snap,
+			ids:      map[string]struct{}{},
+			done:     make(chan struct{}),
+		}
+		r.batches[key] = b
+		// the query of the batch outlives the callers that give up waiting for it
+		bctx := detached{ctx}
+		b.timer = time.AfterFunc(r.window, func() {
+			r.mu.Lock()
+			if r.batches[key] == b {
+				delete(r.batches, key)
+			}
+			r.running[key]++
+			r.mu.Unlock()
+			r.execute(bctx, key, b)
+		})
+	}
+
+	for _, id := range filter.GetEntity().GetIds() {
+		b.ids[id] = struct{}{}
+	}
+	if len(b.ids) >= r.maxBatchSize {
+		r.flush(key, b)
+	}
+	return b
+}
+
+// flush - sends the batch before its window passes, the lock is held by the caller
+func (r *RelationshipReaderWithCoalescing) flush(key string, b *batch) {
+	delete(r.batches, key)
+	if b.timer.Stop() {
+		r.running[key]++
+		go r.execute(detached{context.Background()}, key, b)
+	}
+}
+
+// finish - marks a query or batch of the shape as done
+func (r *RelationshipReaderWithCoalescing) finish(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.running[key]--
+	if r.running[key] == 0 {
+		delete(r.running, key)
+	}
+}
+
+// execute - sends the query of the batch with the ids of all of its queries
+func (r *RelationshipReaderWithCoalescing) execute(ctx context.Context, key string, b *batch) {
+	defer close(b.done)
+	defer r.finish(key)
+
+	ids := make([]string, 0, len(b.ids))
+	for id := range b.ids {
+		ids = append(ids, id)
+	}
+
+	filter := proto.Clone(b.filter).(*base.TupleFilter)
+	filter.Entity.Ids = ids
+
+	it, err := r.delegate.QueryRelationships(ctx, b.tenantID, filter, b.snap)
+	if err != nil {
+		b.err = err
+		return
+	}
+	for it.HasNext() {
+		b.tuples = append(b.tuples, it.GetNext())
+	}
+}
+
+// ReadRelationships reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithCoalescing) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithCoalescing) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// CheckSnapshot - Checks whether a snap token sent by a client can be read at.
+func (r *RelationshipReaderWithCoalescing) CheckSnapshot(ctx context.Context, tenantID, snap string) error {
+	return r.delegate.CheckSnapshot(ctx, tenantID, snap)
+}
+
+// RelationshipStats - Counts the relation tuples of the repository
+func (r *RelationshipReaderWithCoalescing) RelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (*repositories.RelationshipStats, error) {
+	return r.delegate.RelationshipStats(ctx, tenantID, snap, top)
+}
+
+// CountRelationships - Counts the relation tuples matching the filter
+func (r *RelationshipReaderWithCoalescing) CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (uint64, error) {
+	return r.delegate.CountRelationships(ctx, tenantID, filter, snap)
+}
+
+// shape - returns the key of the queries that can be sent together, the tenant, snapshot and filter without the ids
+func shape(tenantID string, filter *base.TupleFilter, snap string) (string, error) {
+	f := proto.Clone(filter).(*base.TupleFilter)
+	f.Entity.Ids = nil
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(f)
+	if err != nil {
+		return "", err
+	}
+	return tenantID + "|" + snap + "|" + string(b), nil
+}
+
+// detached - context that keeps the values of its parent, such as the span, without its deadline and cancellation
+type detached struct {
+	parent context.Context
+}
+
+// Deadline - detached contexts have no deadline
+func (d detached) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+// Done - detached contexts are never cancelled
+func (d detached) Done() <-chan struct{} { return nil }
+
+// Err - detached contexts are never cancelled
+func (d detached) Err() error { return nil }
+
+// Value - returns the value of the parent
+func (d detached) Value(key interface{}) interface{} { return d.parent.Value(key) }
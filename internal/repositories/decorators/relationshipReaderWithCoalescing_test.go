@@ -0,0 +1,168 @@
+package decorators
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"permify/internal/repositories/mocks"
+	"permify/pkg/database"
+	base "permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("RelationshipReaderWithCoalescing", func() {
+	ownerOf := func(id string) *base.Tuple {
+		return &base.Tuple{
+			Entity:   &base.Entity{Type: "doc", Id: id},
+			Relation: "owner",
+			Subject:  &base.Subject{Type: "user", Id: "u" + id},
+		}
+	}
+
+	filterOf := func(relation string, ids ...string) *base.TupleFilter {
+		return &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: "doc", Ids: ids},
+			Relation: relation,
+		}
+	}
+
+	hasIDs := func(ids ...string) interface{} {
+		return mock.MatchedBy(func(filter *base.TupleFilter) bool {
+			got := append([]string{}, filter.GetEntity().GetIds()...)
+			sort.Strings(got)
+			sort.Strings(ids)
+			if len(got) != len(ids) {
+				return false
+			}
+			for i := range got {
+				if got[i] != ids[i] {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	// query - runs the queries concurrently and returns the entity ids of the tuples of each of them
+	query := func(reader *RelationshipReaderWithCoalescing, filters ...*base.TupleFilter) [][]string {
+		results := make([][]string, len(filters))
+		var wg sync.WaitGroup
+		for i, filter := range filters {
+			wg.Add(1)
+			go func(i int, filter *base.TupleFilter) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				it, err := reader.QueryRelationships(context.Background(), "t1", filter, "snap")
+				Expect(err).ShouldNot(HaveOccurred())
+				results[i] = []string{}
+				for it.HasNext() {
+					results[i] = append(results[i], it.GetNext().GetEntity().GetId())
+				}
+			}(i, filter)
+		}
+		wg.Wait()
+		return results
+	}
+
+	// running - sends a query of the owner shape that runs until the returned function is called
+	running := func(delegate *mocks.RelationshipReader, reader *RelationshipReaderWithCoalescing) func() {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		delegate.On("QueryRelationships", "t1", filterOf("owner", "0"), "snap").
+			Run(func(mock.Arguments) {
+				close(started)
+				<-release
+			}).
+			Return(database.NewTupleIterator(ownerOf("0")), nil).Times(1)
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			_, err := reader.QueryRelationships(context.Background(), "t1", filterOf("owner", "0"), "snap")
+			Expect(err).ShouldNot(HaveOccurred())
+		}()
+		<-started
+
+		return func() {
+			close(release)
+			<-done
+		}
+	}
+
+	Context("QueryRelationships", func() {
+		It("should send a query at once when no query of its shape is running", func() {
+			delegate := new(mocks.RelationshipReader)
+			delegate.On("QueryRelationships", "t1", filterOf("owner", "1"), "snap").
+				Return(database.NewTupleIterator(ownerOf("1")), nil).Times(1)
+
+			// the window is longer than the test, the query is only answered because it is not held back
+			reader := NewRelationshipReaderWithCoalescing(delegate, time.Hour, 100)
+			results := query(reader, filterOf("owner", "1"))
+
+			Expect(results).Should(Equal([][]string{{"1"}}))
+			delegate.AssertExpectations(GinkgoT())
+		})
+
+		It("should send concurrent queries of the same shape as one query", func() {
+			delegate := new(mocks.RelationshipReader)
+			delegate.On("QueryRelationships", "t1", hasIDs("1", "2", "3"), "snap").
+				Return(database.NewTupleIterator(ownerOf("1"), ownerOf("3")), nil).Times(1)
+
+			reader := NewRelationshipReaderWithCoalescing(delegate, 50*time.Millisecond, 100)
+			release := running(delegate, reader)
+			defer release()
+
+			results := query(reader, filterOf("owner", "1"), filterOf("owner", "2"), filterOf("owner", "3"))
+
+			Expect(results).Should(Equal([][]string{{"1"}, {}, {"3"}}))
+		})
+
+		It("should send queries of different shapes separately", func() {
+			delegate := new(mocks.RelationshipReader)
+			delegate.On("QueryRelationships", "t1", filterOf("owner", "1"), "snap").
+				Return(database.NewTupleIterator(ownerOf("1")), nil).Times(1)
+			delegate.On("QueryRelationships", "t1", filterOf("parent", "1"), "snap").
+				Return(database.NewTupleIterator(), nil).Times(1)
+
+			reader := NewRelationshipReaderWithCoalescing(delegate, 10*time.Millisecond, 100)
+			results := query(reader, filterOf("owner", "1"), filterOf("parent", "1"))
+
+			Expect(results).Should(Equal([][]string{{"1"}, {}}))
+			delegate.AssertExpectations(GinkgoT())
+		})
+
+		It("should send a batch once it reaches the max batch size", func() {
+			delegate := new(mocks.RelationshipReader)
+			delegate.On("QueryRelationships", "t1", hasIDs("1", "2"), "snap").
+				Return(database.NewTupleIterator(ownerOf("1"), ownerOf("2")), nil).Times(1)
+
+			// the window is longer than the test, the batch is only sent because it is full
+			reader := NewRelationshipReaderWithCoalescing(delegate, time.Hour, 2)
+			release := running(delegate, reader)
+			defer release()
+
+			results := query(reader, filterOf("owner", "1"), filterOf("owner", "2"))
+
+			Expect(results).Should(Equal([][]string{{"1"}, {"2"}}))
+		})
+
+		It("should return when the context of the caller is cancelled", func() {
+			delegate := new(mocks.RelationshipReader)
+			reader := NewRelationshipReaderWithCoalescing(delegate, time.Hour, 100)
+			release := running(delegate, reader)
+			defer release()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err := reader.QueryRelationships(ctx, "t1", filterOf("owner", "1"), "snap")
+			Expect(err).Should(Equal(context.DeadlineExceeded))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package decorators
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"permify/internal/repositories"
+	"permify/pkg/cache"
+	"permify/pkg/database"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+)
+
+// RelationshipReaderWithStatsCache - Add cache behaviour to the relationship statistics of relationship reader
+type RelationshipReaderWithStatsCache struct {
+	delegate  repositories.RelationshipReader
+	cache     cache.Cache
+	staleness time.Duration
+}
+
+// cachedRelationshipStats - relationship statistics along with the time they are computed at
+type cachedRelationshipStats struct {
+	stats      *repositories.RelationshipStats
+	computedAt time.Time
+}
+
+// NewRelationshipReaderWithStatsCache new instance of RelationshipReaderWithStatsCache, statistics are served from the
+// cache until they are older than staleness
+func NewRelationshipReaderWithStatsCache(delegate repositories.RelationshipReader, cache cache.Cache, staleness time.Duration) *RelationshipReaderWithStatsCache {
+	return &RelationshipReaderWithStatsCache{
+		delegate:  delegate,
+		cache:     cache,
+		staleness: staleness,
+	}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *RelationshipReaderWithStatsCache) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+}
+
+// ReadRelationships reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithStatsCache) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithStatsCache) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// CheckSnapshot - Checks whether a snap token sent by a client can be read at.
+func (r *RelationshipReaderWithStatsCache) CheckSnapshot(ctx context.Context, tenantID, snap string) error {
+	return r.delegate.CheckSnapshot(ctx, tenantID, snap)
+}
+
+// RelationshipStats - Counts the relation tuples of the repository. Statistics of the head snapshot are cached under
+// the tenant, so they may miss the writes of the last staleness period.
+func (r *RelationshipReaderWithStatsCache) RelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (*repositories.RelationshipStats, error) {
+	key := fmt.Sprintf("%s|%s|%v", tenantID, snap, top)
+	if v, found := r.cache.Get(key); found {
+		if cached, ok := v.(cachedRelationshipStats); ok && time.Since(cached.computedAt) < r.staleness {
+			return cached.stats, nil
+		}
+	}
+
+	stats, err := r.delegate.RelationshipStats(ctx, tenantID, snap, top)
+	if err != nil {
+		return nil, err
+	}
+
+	size := reflect.TypeOf(stats).Size() * uintptr(len(stats.Counts)+len(stats.TopEntities)+1)
+	r.cache.Set(key, cachedRelationshipStats{stats: stats, computedAt: time.Now()}, int64(size))
+	return stats, nil
+}
+
+// CountRelationships - Counts the relation tuples matching the filter, counts are never cached
+func (r *RelationshipReaderWithStatsCache) CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (uint64, error) {
+	return r.delegate.CountRelationships(ctx, tenantID, filter, snap)
+}
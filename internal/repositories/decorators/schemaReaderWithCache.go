@@ -8,20 +8,23 @@ import (
 
 	"permify/internal/repositories"
 	"permify/pkg/cache"
+	"permify/pkg/database"
 	base "permify/pkg/pb/base/v1"
 )
 
 // SchemaReaderWithCache - Add cache behaviour to schema reader
 type SchemaReaderWithCache struct {
-	delegate repositories.SchemaReader
-	cache    cache.Cache
+	delegate    repositories.SchemaReader
+	cache       cache.Cache
+	generations *cache.Generations
 }
 
 // NewSchemaReaderWithCache new instance of SchemaReaderWithCache
-func NewSchemaReaderWithCache(delegate repositories.SchemaReader, cache cache.Cache) *SchemaReaderWithCache {
+func NewSchemaReaderWithCache(delegate repositories.SchemaReader, c cache.Cache) *SchemaReaderWithCache {
 	return &SchemaReaderWithCache{
-		delegate: delegate,
-		cache:    cache,
+		delegate:    delegate,
+		cache:       c,
+		generations: cache.NewGenerations(),
 	}
 }
 
@@ -35,7 +38,7 @@ func (r *SchemaReaderWithCache) ReadSchemaDefinition(ctx context.Context, tenant
 	var s interface{}
 	found := false
 	if version != "" {
-		s, found = r.cache.Get(fmt.Sprintf("%s|%s|%s", tenantID, entityType, version))
+		s, found = r.cache.Get(fmt.Sprintf("%s|%s|%s|%s", tenantID, r.generations.Get(tenantID), entityType, version))
 	}
 	if !found {
 		definition, version, err = r.delegate.ReadSchemaDefinition(ctx, tenantID, entityType, version)
@@ -43,7 +46,7 @@ func (r *SchemaReaderWithCache) ReadSchemaDefinition(ctx context.Context, tenant
 			return nil, "", err
 		}
 		size := reflect.TypeOf(definition).Size()
-		r.cache.Set(fmt.Sprintf("%s|%s|%s", tenantID, entityType, version), definition, int64(size))
+		r.cache.Set(fmt.Sprintf("%s|%s|%s|%s", tenantID, r.generations.Get(tenantID), entityType, version), definition, int64(size))
 		return definition, version, nil
 	}
 	def, ok := s.(*base.EntityDefinition)
@@ -57,3 +60,13 @@ func (r *SchemaReaderWithCache) ReadSchemaDefinition(ctx context.Context, tenant
 func (r *SchemaReaderWithCache) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	return r.delegate.HeadVersion(ctx, tenantID)
 }
+
+// ListSchemas - Lists the schema versions of the tenant.
+func (r *SchemaReaderWithCache) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
+	return r.delegate.ListSchemas(ctx, tenantID, pagination)
+}
+
+// Invalidate - Makes the cached definitions of the tenant unreachable, every tenant if the tenant id is empty
+func (r *SchemaReaderWithCache) Invalidate(tenantID string) {
+	r.generations.Invalidate(tenantID)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/afex/hystrix-go/hystrix"
 
 	"permify/internal/repositories"
+	"permify/pkg/database"
 	base "permify/pkg/pb/base/v1"
 )
 
@@ -98,3 +99,30 @@ func (r *SchemaReaderWithCircuitBreaker) HeadVersion(ctx context.Context, tenant
 		return "", errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
 	}
 }
+
+// ListSchemas - Lists the schema versions of the tenant.
+func (r *SchemaReaderWithCircuitBreaker) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
+	type circuitBreakerResponse struct {
+		Schemas []*base.SchemaList
+		Ct      database.EncodedContinuousToken
+		Error   error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+
+	hystrix.ConfigureCommand("schemaReader.listSchemas", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("schemaReader.listSchemas", func() error {
+		sch, c, err := r.delegate.ListSchemas(ctx, tenantID, pagination)
+		output <- circuitBreakerResponse{Schemas: sch, Ct: c, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Schemas, out.Ct, out.Error
+	case <-bErrors:
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
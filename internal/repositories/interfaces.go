@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"permify/pkg/database"
 	base "permify/pkg/pb/base/v1"
@@ -16,6 +17,20 @@ type RelationshipReader interface {
 	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error)
 	// HeadSnapshot reads the latest version of the snapshot from the repository.
 	HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error)
+	// CheckSnapshot fails with ERROR_CODE_INVALID_SNAP_TOKEN if a snap token sent by a client can not be read at.
+	CheckSnapshot(ctx context.Context, tenantID string, snap string) (err error)
+	// RelationshipStats counts the relation tuples of the repository, at the head snapshot if snap is empty.
+	RelationshipStats(ctx context.Context, tenantID string, snap string, top uint32) (stats *RelationshipStats, err error)
+	// CountRelationships counts the relation tuples matching the filter, at the head snapshot if snap is empty.
+	CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (count uint64, err error)
+}
+
+// RecursiveRelationshipReader - Resolves self-referential relations, such as nested groups, in the repository
+type RecursiveRelationshipReader interface {
+	// CheckRecursiveMembership checks whether the subject is reachable from the entity through the relation and the
+	// same relation of the entities of its type, descending at most depth levels. It fails with
+	// ERROR_CODE_DEPTH_NOT_ENOUGH if the subject is not found and the membership continues below depth.
+	CheckRecursiveMembership(ctx context.Context, tenantID string, entity *base.Entity, relation string, subject *base.Subject, snap string, depth int32) (allowed bool, err error)
 }
 
 // RelationshipWriter -
@@ -34,6 +49,8 @@ type SchemaReader interface {
 	ReadSchemaDefinition(ctx context.Context, tenantID string, entityType, version string) (definition *base.EntityDefinition, v string, err error)
 	// HeadVersion reads the latest version of the schema from the repository.
 	HeadVersion(ctx context.Context, tenantID string) (version string, err error)
+	// ListSchemas lists the schema versions of the tenant from the repository, the newest first.
+	ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error)
 }
 
 // SchemaWriter -
@@ -55,3 +72,25 @@ type TenantWriter interface {
 	// DeleteTenant deletes tenant from the repository.
 	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
 }
+
+// GarbageCollector -
+type GarbageCollector interface {
+	// Start runs the garbage collection periodically until the collector is stopped.
+	Start() error
+	// Stop stops the periodic garbage collection.
+	Stop()
+	// Collect runs a single garbage collection pass and returns the rows removed per tenant.
+	Collect(ctx context.Context, options GarbageCollectionOptions) (results []*base.GarbageCollectResult, err error)
+}
+
+// ErrGarbageCollectionInProgress - Returned by a collection pass that finds another one running. Its message is
+// the error code, so it is reported to the clients as ERROR_CODE_GARBAGE_COLLECTION_IN_PROGRESS.
+var ErrGarbageCollectionInProgress = errors.New(base.ErrorCode_ERROR_CODE_GARBAGE_COLLECTION_IN_PROGRESS.String())
+
+// ChangeListener -
+type ChangeListener interface {
+	// Listen calls the handler with the changes committed by the writers of every instance sharing the database
+	// until the context is cancelled. Changes may be missed while the listener reconnects, the handler is called
+	// with a change of every tenant afterwards.
+	Listen(ctx context.Context, handler func(change Change)) error
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+
+	"permify/internal/repositories"
+	db "permify/pkg/database/memory"
+	"permify/pkg/logger"
+)
+
+// ChangeListener - Structure for Change Listener
+type ChangeListener struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewChangeListener - Creates a new ChangeListener
+func NewChangeListener(database *db.Memory, logger logger.Interface) *ChangeListener {
+	return &ChangeListener{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// Listen - Calls the handler with the changes committed by the writers of the database until the context is cancelled
+func (l *ChangeListener) Listen(ctx context.Context, handler func(change repositories.Change)) error {
+	for payload := range l.database.Listen(ctx, repositories.ChangesChannel) {
+		change, err := repositories.DecodeChange(payload)
+		if err != nil {
+			l.logger.Error("change listener received an invalid payload: " + payload)
+			continue
+		}
+		handler(change)
+	}
+	return nil
+}
+
+// notify - publishes a committed change to the listeners of the database
+func notify(database *db.Memory, change repositories.Change) {
+	payload, err := change.Encode()
+	if err != nil {
+		return
+	}
+	database.Notify(repositories.ChangesChannel, payload)
+}
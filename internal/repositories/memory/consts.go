@@ -4,4 +4,5 @@ const (
 	RelationTuplesTable    = "relation_tuples"
 	SchemaDefinitionsTable = "schema_definitions"
 	TenantsTable           = "tenants"
+	TransactionsTable      = "transactions"
 )
@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"golang.org/x/sync/errgroup"
+
+	"permify/internal/config"
+	"permify/internal/repositories"
+	db "permify/pkg/database/memory"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+)
+
+// GarbageCollector - Structure for GarbageCollector
+type GarbageCollector struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+	// metrics of the collection passes
+	metrics *repositories.GarbageCollectionMetrics
+	// context to manage the collection loop and its cancellation
+	ctx    context.Context
+	cancel context.CancelFunc
+	// errgroup for the collection loop
+	g *errgroup.Group
+	// interval for garbage collection
+	interval time.Duration
+	// timeout for garbage collection
+	timeout time.Duration
+	// window for garbage collection
+	window time.Duration
+}
+
+// NewGarbageCollector creates a new GarbageCollector instance. Write transactions of the memory database are
+// serialized, so all tenants are collected in a single transaction and the number of threads is not used.
+func NewGarbageCollector(ctx context.Context, db *db.Memory, logger logger.Interface, cfg config.DatabaseGarbageCollection) *GarbageCollector {
+	ctx, cancel := context.WithCancel(ctx)
+	return &GarbageCollector{
+		g:        &errgroup.Group{},
+		interval: cfg.Interval,
+		timeout:  cfg.Timeout,
+		window:   cfg.Window,
+		database: db,
+		logger:   logger,
+		metrics:  repositories.NewGarbageCollectionMetrics(db.GetEngineType()),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Start runs the garbage collection every interval until the collector is stopped.
+func (c *GarbageCollector) Start() error {
+	c.g.Go(func() error {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				c.logger.Info("garbage collector stopped")
+				return nil
+			case <-ticker.C:
+				c.logger.Info("garbage collector started")
+				ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+				if _, err := c.Collect(ctx, repositories.GarbageCollectionOptions{}); err != nil {
+					c.logger.Error("garbage collector failed with error: " + err.Error())
+				}
+				cancel()
+			}
+		}
+	})
+
+	return nil
+}
+
+// Stop stops the collection loop.
+func (c *GarbageCollector) Stop() {
+	c.cancel()
+}
+
+// Wait waits for the collection loop to finish.
+// Returns an error if it encountered an error.
+func (c *GarbageCollector) Wait() error {
+	return c.g.Wait()
+}
+
+// Collect deletes the tuples that were expired before the window and the transactions that were recorded
+// before it, except the latest transaction of every tenant, and returns the rows removed per tenant. Snapshots
+// older than the window can not be read consistently afterwards. With dry run the rows are only counted.
+func (c *GarbageCollector) Collect(ctx context.Context, options repositories.GarbageCollectionOptions) (results []*base.GarbageCollectResult, err error) {
+	start := time.Now()
+	defer func() {
+		c.metrics.Record(ctx, options, results, time.Since(start), err)
+	}()
+
+	txn := c.database.Txn(true)
+	defer txn.Abort()
+
+	window := options.Window
+	if window == 0 {
+		window = c.window
+	}
+	cutoff := time.Now().Add(-window)
+
+	var it memdb.ResultIterator
+	it, err = txn.Get(TransactionsTable, "id")
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	// transactions are iterated in id order, so the last one seen for a tenant is its head
+	transactions := map[uint64]repositories.Transaction{}
+	heads := map[string]uint64{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		t, ok := obj.(repositories.Transaction)
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if options.TenantID != "" && t.TenantID != options.TenantID {
+			continue
+		}
+		transactions[t.ID] = t
+		heads[t.TenantID] = t.ID
+	}
+
+	collected := map[string]*base.GarbageCollectResult{}
+	result := func(tenantID string) *base.GarbageCollectResult {
+		r, ok := collected[tenantID]
+		if !ok {
+			r = &base.GarbageCollectResult{TenantId: tenantID}
+			collected[tenantID] = r
+		}
+		return r
+	}
+	for tenantID := range heads {
+		result(tenantID)
+	}
+	if options.TenantID != "" {
+		result(options.TenantID)
+	}
+
+	if options.TenantID != "" {
+		it, err = txn.Get(RelationTuplesTable, "tenant-index", options.TenantID)
+	} else {
+		it, err = txn.Get(RelationTuplesTable, "id")
+	}
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	// a tuple whose expiring transaction is already collected was expired before the window as well
+	var tuples []repositories.RelationTuple
+	fit := memdb.NewFilterIterator(it, func(raw interface{}) bool {
+		t, ok := raw.(repositories.RelationTuple)
+		if !ok || t.ExpiredTxID == 0 {
+			return true
+		}
+		transaction, ok := transactions[t.ExpiredTxID]
+		return ok && !transaction.Timestamp.Before(cutoff)
+	})
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		tuples = append(tuples, obj.(repositories.RelationTuple))
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range tuples {
+		result(t.TenantID).RelationTuples++
+		if options.DryRun {
+			continue
+		}
+		if err = txn.Delete(RelationTuplesTable, t); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+
+	for id, t := range transactions {
+		if heads[t.TenantID] == id || !t.Timestamp.Before(cutoff) {
+			continue
+		}
+		result(t.TenantID).Transactions++
+		if options.DryRun {
+			continue
+		}
+		if _, err = txn.DeleteAll(TransactionsTable, "id", id); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+
+	if !options.DryRun {
+		if err = c.database.Commit(txn); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+
+	results = make([]*base.GarbageCollectResult, 0, len(collected))
+	for _, r := range collected {
+		results = append(results, r)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].GetTenantId() < results[j].GetTenantId()
+	})
+
+	c.logger.Info("garbage collector finished")
+	return results, nil
+}
@@ -0,0 +1,338 @@
+package memory_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/config"
+	"permify/internal/repositories"
+	"permify/internal/repositories/memory"
+	"permify/internal/repositories/memory/migrations"
+	"permify/internal/repositories/memory/snapshot"
+	"permify/internal/repositories/repositorytest"
+	"permify/pkg/database"
+	db "permify/pkg/database/memory"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+	"permify/pkg/tuple"
+)
+
+// signingKeys - signs the snap tokens of the repositories under test
+var signingKeys, _ = token.NewSigningKeys("secret")
+
+func TestMemory(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "memory-suite")
+}
+
+var _ = repositorytest.DescribeRepositories("memory", func() repositorytest.Repositories {
+	database, err := db.New(migrations.Schema)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	l := logger.New("debug")
+
+	return repositorytest.Repositories{
+		RelationshipReader: memory.NewRelationshipReader(database, signingKeys, l),
+		RelationshipWriter: memory.NewRelationshipWriter(database, signingKeys, l),
+		SchemaReader:       memory.NewSchemaReader(database, l),
+		SchemaWriter:       memory.NewSchemaWriter(database, l),
+		TenantReader:       memory.NewTenantReader(database, l),
+		TenantWriter:       memory.NewTenantWriter(database, l),
+	}
+}, repositorytest.Options{Snapshots: true})
+
+var _ = repositorytest.DescribeRepositories("durable memory", func() repositorytest.Repositories {
+	database, err := db.New(migrations.Schema, db.Path(GinkgoT().TempDir()))
+	Expect(err).ShouldNot(HaveOccurred())
+	DeferCleanup(database.Close)
+
+	l := logger.New("debug")
+
+	return repositorytest.Repositories{
+		RelationshipReader: memory.NewRelationshipReader(database, signingKeys, l),
+		RelationshipWriter: memory.NewRelationshipWriter(database, signingKeys, l),
+		SchemaReader:       memory.NewSchemaReader(database, l),
+		SchemaWriter:       memory.NewSchemaWriter(database, l),
+		TenantReader:       memory.NewTenantReader(database, l),
+		TenantWriter:       memory.NewTenantWriter(database, l),
+	}
+}, repositorytest.Options{Snapshots: true})
+
+var _ = Describe("Durability", func() {
+	ctx := context.Background()
+	l := logger.New("debug")
+
+	var path string
+
+	BeforeEach(func() {
+		path = GinkgoT().TempDir()
+	})
+
+	open := func() *db.Memory {
+		instance, err := db.New(migrations.Schema, db.Path(path))
+		Expect(err).ShouldNot(HaveOccurred())
+		return instance
+	}
+
+	write := func(instance *db.Memory, tuples ...string) {
+		collection := database.NewTupleCollection()
+		for _, t := range tuples {
+			tup, err := tuple.Tuple(t)
+			Expect(err).ShouldNot(HaveOccurred())
+			collection.Add(tup)
+		}
+		_, err := memory.NewRelationshipWriter(instance, signingKeys, l).WriteRelationships(ctx, "t1", collection)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	query := func(instance *db.Memory, snap string) (result []string) {
+		it, err := memory.NewRelationshipReader(instance, signingKeys, l).QueryRelationships(ctx, "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}}, snap)
+		Expect(err).ShouldNot(HaveOccurred())
+		for it.HasNext() {
+			result = append(result, tuple.ToString(it.GetNext()))
+		}
+		return result
+	}
+
+	It("should restore the writes from the write-ahead log", func() {
+		instance := open()
+		write(instance, "organization:1#admin@user:1", "organization:1#member@user:2")
+		_, err := memory.NewRelationshipWriter(instance, signingKeys, l).DeleteRelationships(ctx, "t1", &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+			Relation: "member",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = memory.NewTenantWriter(instance, l).CreateTenant(ctx, "t1", "tenant 1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(instance.Close()).Should(Succeed())
+
+		instance = open()
+		defer instance.Close()
+
+		Expect(query(instance, "")).Should(Equal([]string{"organization:1#admin@user:1"}))
+		Expect(query(instance, snapshot.NewToken(signingKeys, "t1", 1).Encode().String())).Should(Equal([]string{"organization:1#admin@user:1", "organization:1#member@user:2"}))
+
+		head, err := memory.NewRelationshipReader(instance, signingKeys, l).HeadSnapshot(ctx, "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(head.Eg(snapshot.NewToken(signingKeys, "t1", 2))).Should(BeTrue())
+
+		tenants, _, err := memory.NewTenantReader(instance, l).ListTenants(ctx, database.NewPagination(database.Size(10)))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(tenants).Should(HaveLen(1))
+
+		// ids keep increasing after the restore
+		write(instance, "organization:2#admin@user:1")
+		Expect(query(instance, "")).Should(Equal([]string{"organization:1#admin@user:1", "organization:2#admin@user:1"}))
+	})
+
+	It("should restore the writes from the snapshot file after compaction", func() {
+		instance := open()
+		write(instance, "organization:1#admin@user:1")
+		Expect(instance.Compact()).Should(Succeed())
+		write(instance, "organization:2#admin@user:1")
+		Expect(instance.Close()).Should(Succeed())
+
+		info, err := os.Stat(filepath.Join(path, "snapshot"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(info.Size()).Should(BeNumerically(">", 0))
+
+		instance = open()
+		defer instance.Close()
+		Expect(query(instance, "")).Should(Equal([]string{"organization:1#admin@user:1", "organization:2#admin@user:1"}))
+	})
+
+	It("should ignore a partially written frame at the end of the write-ahead log", func() {
+		instance := open()
+		write(instance, "organization:1#admin@user:1")
+		Expect(instance.Close()).Should(Succeed())
+
+		wal := filepath.Join(path, "wal")
+		f, err := os.OpenFile(wal, os.O_WRONLY|os.O_APPEND, 0o644)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = f.Write([]byte{0xff, 0x00, 0x00, 0x00, 0x01, 0x02})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(f.Close()).Should(Succeed())
+
+		instance = open()
+		write(instance, "organization:2#admin@user:1")
+		Expect(instance.Close()).Should(Succeed())
+
+		instance = open()
+		defer instance.Close()
+		Expect(query(instance, "")).Should(Equal([]string{"organization:1#admin@user:1", "organization:2#admin@user:1"}))
+	})
+})
+
+var _ = Describe("GarbageCollector", func() {
+	ctx := context.Background()
+	l := logger.New("debug")
+
+	var instance *db.Memory
+
+	BeforeEach(func() {
+		var err error
+		instance, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	rows := func(table, index, tenantID string) (count int) {
+		txn := instance.DB.Txn(false)
+		defer txn.Abort()
+		it, err := txn.Get(table, index, tenantID)
+		Expect(err).ShouldNot(HaveOccurred())
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			count++
+		}
+		return count
+	}
+
+	It("should delete the tuples expired before the window", func() {
+		writer := memory.NewRelationshipWriter(instance, signingKeys, l)
+		reader := memory.NewRelationshipReader(instance, signingKeys, l)
+
+		for _, t := range []string{"organization:1#admin@user:1", "organization:1#member@user:2"} {
+			tup, err := tuple.Tuple(t)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = writer.WriteRelationships(ctx, "gc", database.NewTupleCollection(tup))
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		_, err := writer.DeleteRelationships(ctx, "gc", &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+			Relation: "admin",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		head, err := reader.HeadSnapshot(ctx, "gc")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// move the transactions an hour back
+		txn := instance.DB.Txn(true)
+		it, err := txn.Get(memory.TransactionsTable, "tenant", "gc")
+		Expect(err).ShouldNot(HaveOccurred())
+		var transactions []repositories.Transaction
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			transactions = append(transactions, obj.(repositories.Transaction))
+		}
+		for _, t := range transactions {
+			t.Timestamp = t.Timestamp.Add(-time.Hour)
+			Expect(txn.Insert(memory.TransactionsTable, t)).Should(Succeed())
+		}
+		txn.Commit()
+
+		Expect(rows(memory.RelationTuplesTable, "tenant-index", "gc")).Should(Equal(2))
+		Expect(rows(memory.TransactionsTable, "tenant", "gc")).Should(Equal(3))
+
+		gc := memory.NewGarbageCollector(ctx, instance, l, config.DatabaseGarbageCollection{Window: time.Minute})
+
+		results, err := gc.Collect(ctx, repositories.GarbageCollectionOptions{TenantID: "gc", DryRun: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).Should(HaveLen(1))
+		Expect(results[0].GetRelationTuples()).Should(Equal(uint64(1)))
+		Expect(results[0].GetTransactions()).Should(Equal(uint64(2)))
+
+		Expect(rows(memory.RelationTuplesTable, "tenant-index", "gc")).Should(Equal(2))
+		Expect(rows(memory.TransactionsTable, "tenant", "gc")).Should(Equal(3))
+
+		results, err = gc.Collect(ctx, repositories.GarbageCollectionOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, result := range results {
+			if result.GetTenantId() == "gc" {
+				Expect(result.GetRelationTuples()).Should(Equal(uint64(1)))
+				Expect(result.GetTransactions()).Should(Equal(uint64(2)))
+			}
+		}
+
+		Expect(rows(memory.RelationTuplesTable, "tenant-index", "gc")).Should(Equal(1))
+		Expect(rows(memory.TransactionsTable, "tenant", "gc")).Should(Equal(1))
+
+		h, err := reader.HeadSnapshot(ctx, "gc")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(h.Eg(head)).Should(BeTrue())
+
+		count, err := reader.CountRelationships(ctx, "gc", &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}}, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(count).Should(Equal(uint64(1)))
+	})
+
+	It("should keep the tuples expired in the window", func() {
+		writer := memory.NewRelationshipWriter(instance, signingKeys, l)
+
+		tup, err := tuple.Tuple("organization:1#admin@user:1")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = writer.WriteRelationships(ctx, "gc-window", database.NewTupleCollection(tup))
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = writer.DeleteRelationships(ctx, "gc-window", &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		gc := memory.NewGarbageCollector(ctx, instance, l, config.DatabaseGarbageCollection{Window: time.Minute})
+		results, err := gc.Collect(ctx, repositories.GarbageCollectionOptions{TenantID: "gc-window"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).Should(HaveLen(1))
+		Expect(results[0].GetRelationTuples()).Should(BeZero())
+		Expect(results[0].GetTransactions()).Should(BeZero())
+
+		Expect(rows(memory.RelationTuplesTable, "tenant-index", "gc-window")).Should(Equal(1))
+		Expect(rows(memory.TransactionsTable, "tenant", "gc-window")).Should(Equal(2))
+	})
+})
+
+var _ = Describe("ChangeListener", func() {
+	var instance *db.Memory
+	l := logger.New("debug")
+
+	BeforeEach(func() {
+		var err error
+		instance, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(instance.Close()).Should(Succeed())
+	})
+
+	It("should receive the changes of the writers", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		changes := make(chan repositories.Change, 10)
+		done := make(chan error)
+		go func() {
+			done <- memory.NewChangeListener(instance, l).Listen(ctx, func(change repositories.Change) {
+				changes <- change
+			})
+		}()
+
+		// the listener subscribes asynchronously, write until its first change arrives
+		writer := memory.NewRelationshipWriter(instance, signingKeys, l)
+		tup, err := tuple.Tuple("organization:1#admin@user:1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(func() int {
+			_, err = writer.WriteRelationships(ctx, "t1", database.NewTupleCollection(tup))
+			Expect(err).ShouldNot(HaveOccurred())
+			return len(changes)
+		}).Should(BeNumerically(">", 0))
+		Expect(<-changes).Should(Equal(repositories.Change{TenantID: "t1", Kind: repositories.RelationshipChange}))
+		for len(changes) > 0 {
+			<-changes
+		}
+
+		_, err = writer.DeleteRelationships(ctx, "t2", &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(changes).Should(Receive(Equal(repositories.Change{TenantID: "t2", Kind: repositories.RelationshipChange})))
+
+		err = memory.NewSchemaWriter(instance, l).WriteSchema(ctx, []repositories.SchemaDefinition{
+			{TenantID: "t3", EntityType: "user", SerializedDefinition: []byte("entity user {}"), Version: "v1"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(changes).Should(Receive(Equal(repositories.Change{TenantID: "t3", Kind: repositories.SchemaChange})))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
@@ -1,11 +1,22 @@
 package migrations
 
 import (
+	"encoding/gob"
+
 	"github.com/hashicorp/go-memdb"
 
+	"permify/internal/repositories"
 	"permify/internal/repositories/memory"
 )
 
+// the objects of the tables are gob encoded to the write-ahead log and snapshot files of a durable memory database
+func init() {
+	gob.Register(repositories.RelationTuple{})
+	gob.Register(repositories.SchemaDefinition{})
+	gob.Register(repositories.Tenant{})
+	gob.Register(repositories.Transaction{})
+}
+
 // Schema - Database schema for memory db
 var Schema = &memdb.DBSchema{
 	Tables: map[string]*memdb.TableSchema{
@@ -48,20 +59,9 @@ var Schema = &memdb.DBSchema{
 			Name: memory.RelationTuplesTable,
 			Indexes: map[string]*memdb.IndexSchema{
 				"id": {
-					Name:   "id",
-					Unique: true,
-					Indexer: &memdb.CompoundIndex{
-						Indexes: []memdb.Indexer{
-							&memdb.StringFieldIndex{Field: "TenantID"},
-							&memdb.StringFieldIndex{Field: "EntityType"},
-							&memdb.StringFieldIndex{Field: "EntityID"},
-							&memdb.StringFieldIndex{Field: "Relation"},
-							&memdb.StringFieldIndex{Field: "SubjectType"},
-							&memdb.StringFieldIndex{Field: "SubjectID"},
-							&memdb.StringFieldIndex{Field: "SubjectRelation"},
-						},
-						AllowMissing: true,
-					},
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.UintFieldIndex{Field: "ID"},
 				},
 				"entity-index": {
 					Name:   "entity-index",
@@ -87,6 +87,15 @@ var Schema = &memdb.DBSchema{
 						},
 					},
 				},
+				"tenant-index": {
+					Name:   "tenant-index",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+						},
+					},
+				},
 				"entity-type-index": {
 					Name:   "entity-type-index",
 					Unique: false,
@@ -110,6 +119,21 @@ var Schema = &memdb.DBSchema{
 				},
 			},
 		},
+		memory.TransactionsTable: {
+			Name: memory.TransactionsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.UintFieldIndex{Field: "ID"},
+				},
+				"tenant": {
+					Name:    "tenant",
+					Unique:  false,
+					Indexer: &memdb.StringFieldIndex{Field: "TenantID"},
+				},
+			},
+		},
 		memory.TenantsTable: {
 			Name: memory.TenantsTable,
 			Indexes: map[string]*memdb.IndexSchema{
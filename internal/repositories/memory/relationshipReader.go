@@ -5,7 +5,6 @@ import (
 	"errors"
 	"sort"
 	"strconv"
-	"time"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -22,23 +21,32 @@ import (
 // RelationshipReader - Structure for Relationship Reader
 type RelationshipReader struct {
 	database *db.Memory
+	// keys sign and verify the snap tokens of the reader
+	keys *token.SigningKeys
 	// logger
 	logger logger.Interface
 }
 
 // NewRelationshipReader - Creates a new RelationshipReader
-func NewRelationshipReader(database *db.Memory, logger logger.Interface) *RelationshipReader {
+func NewRelationshipReader(database *db.Memory, keys *token.SigningKeys, logger logger.Interface) *RelationshipReader {
 	return &RelationshipReader{
 		database: database,
+		keys:     keys,
 		logger:   logger,
 	}
 }
 
 // QueryRelationships - Reads relation tuples from the repository.
-func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, _ string) (it *database.TupleIterator, err error) {
+func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 
+	var value uint64
+	value, err = snapshotValue(txn, r.keys, tenantID, snap)
+	if err != nil {
+		return nil, err
+	}
+
 	collection := database.NewTupleCollection()
 
 	index, args := utils.GetIndexNameAndArgsByFilters(tenantID, filter)
@@ -49,7 +57,7 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 
-	fit := memdb.NewFilterIterator(result, utils.FilterQuery(filter))
+	fit := memdb.NewFilterIterator(memdb.NewFilterIterator(result, utils.FilterQuery(filter)), utils.SnapshotQuery(value))
 	for obj := fit.Next(); obj != nil; obj = fit.Next() {
 		t, ok := obj.(repositories.RelationTuple)
 		if !ok {
@@ -62,10 +70,16 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 }
 
 // ReadRelationships - Gets all relationships for a given filter
-func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, _ string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 
+	var value uint64
+	value, err = snapshotValue(txn, r.keys, tenantID, snap)
+	if err != nil {
+		return nil, utils.NewNoopContinuousToken().Encode(), err
+	}
+
 	var lowerBound uint64
 	if pagination.Token() != "" {
 		var t database.ContinuousToken
@@ -88,7 +102,7 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 	}
 
 	tup := make([]repositories.RelationTuple, 0, 10)
-	fit := memdb.NewFilterIterator(result, utils.FilterQuery(filter))
+	fit := memdb.NewFilterIterator(memdb.NewFilterIterator(result, utils.FilterQuery(filter)), utils.SnapshotQuery(value))
 	for obj := fit.Next(); obj != nil; obj = fit.Next() {
 		t, ok := obj.(repositories.RelationTuple)
 		if !ok {
@@ -115,7 +129,130 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 	return database.NewTupleCollection(tuples...), utils.NewNoopContinuousToken().Encode(), nil
 }
 
-// HeadSnapshot - Reads the latest version of the snapshot from the repository.
-func (r *RelationshipReader) HeadSnapshot(ctx context.Context, _ string) (token.SnapToken, error) {
-	return snapshot.NewToken(time.Now()), nil
+// HeadSnapshot - Reads the latest transaction of the tenant, zero if the tenant has not written any relationships
+func (r *RelationshipReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	id, err := headTransaction(txn, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.NewToken(r.keys, tenantID, id), nil
+}
+
+// CheckSnapshot - Decodes the snap token sent by a client
+func (r *RelationshipReader) CheckSnapshot(ctx context.Context, tenantID, snap string) error {
+	_, err := snapshot.EncodedToken{Keys: r.keys, TenantID: tenantID, Value: snap}.Decode()
+	return err
+}
+
+// RelationshipStats - Counts the relation tuples of the tenant by scanning its index
+func (r *RelationshipReader) RelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (stats *repositories.RelationshipStats, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var value uint64
+	value, err = snapshotValue(txn, r.keys, tenantID, snap)
+	if err != nil {
+		return nil, err
+	}
+
+	var result memdb.ResultIterator
+	result, err = txn.Get(RelationTuplesTable, "tenant-index", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	counts := map[[3]string]uint64{}
+	fanOuts := map[[3]string]uint64{}
+	fit := memdb.NewFilterIterator(result, utils.SnapshotQuery(value))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		t, ok := obj.(repositories.RelationTuple)
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		counts[[3]string{t.EntityType, t.Relation, t.SubjectType}]++
+		fanOuts[[3]string{t.EntityType, t.EntityID, t.Relation}]++
+	}
+
+	stats = &repositories.RelationshipStats{
+		Counts:      make([]*base.RelationshipCount, 0, len(counts)),
+		TopEntities: make([]*base.RelationshipFanOut, 0, len(fanOuts)),
+		SnapToken:   snapshot.NewToken(r.keys, tenantID, value).Encode().String(),
+	}
+
+	for k, c := range counts {
+		stats.Counts = append(stats.Counts, &base.RelationshipCount{
+			EntityType:  k[0],
+			Relation:    k[1],
+			SubjectType: k[2],
+			Count:       c,
+		})
+	}
+	sort.Slice(stats.Counts, func(i, j int) bool {
+		a, b := stats.Counts[i], stats.Counts[j]
+		if a.GetEntityType() != b.GetEntityType() {
+			return a.GetEntityType() < b.GetEntityType()
+		}
+		if a.GetRelation() != b.GetRelation() {
+			return a.GetRelation() < b.GetRelation()
+		}
+		return a.GetSubjectType() < b.GetSubjectType()
+	})
+
+	for k, c := range fanOuts {
+		stats.TopEntities = append(stats.TopEntities, &base.RelationshipFanOut{
+			Entity: &base.Entity{
+				Type: k[0],
+				Id:   k[1],
+			},
+			Relation: k[2],
+			Count:    c,
+		})
+	}
+	sort.Slice(stats.TopEntities, func(i, j int) bool {
+		a, b := stats.TopEntities[i], stats.TopEntities[j]
+		if a.GetCount() != b.GetCount() {
+			return a.GetCount() > b.GetCount()
+		}
+		if a.GetEntity().GetType() != b.GetEntity().GetType() {
+			return a.GetEntity().GetType() < b.GetEntity().GetType()
+		}
+		if a.GetEntity().GetId() != b.GetEntity().GetId() {
+			return a.GetEntity().GetId() < b.GetEntity().GetId()
+		}
+		return a.GetRelation() < b.GetRelation()
+	})
+	if len(stats.TopEntities) > int(top) {
+		stats.TopEntities = stats.TopEntities[:top]
+	}
+
+	return stats, nil
+}
+
+// CountRelationships - Counts the relation tuples of the tenant matching the filter
+func (r *RelationshipReader) CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (count uint64, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var value uint64
+	value, err = snapshotValue(txn, r.keys, tenantID, snap)
+	if err != nil {
+		return 0, err
+	}
+
+	index, args := utils.GetIndexNameAndArgsByFilters(tenantID, filter)
+	var result memdb.ResultIterator
+	result, err = txn.Get(RelationTuplesTable, index, args...)
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	fit := memdb.NewFilterIterator(memdb.NewFilterIterator(result, utils.FilterQuery(filter)), utils.SnapshotQuery(value))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		count++
+	}
+
+	return count, nil
 }
@@ -3,7 +3,6 @@ package memory
 import (
 	"context"
 	"errors"
-	"time"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -19,19 +18,22 @@ import (
 
 type RelationshipWriter struct {
 	database *db.Memory
+	// keys sign the snap tokens returned by the writer
+	keys *token.SigningKeys
 	// logger
 	logger logger.Interface
 }
 
 // NewRelationshipWriter - Creates a new RelationshipReader
-func NewRelationshipWriter(database *db.Memory, logger logger.Interface) *RelationshipWriter {
+func NewRelationshipWriter(database *db.Memory, keys *token.SigningKeys, logger logger.Interface) *RelationshipWriter {
 	return &RelationshipWriter{
 		database: database,
+		keys:     keys,
 		logger:   logger,
 	}
 }
 
-// WriteRelationships - Write a Relation to repository
+// WriteRelationships - Write a Relation to repository, tuples that are already alive are not written again
 func (r *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
 	var err error
 
@@ -40,13 +42,25 @@ func (r *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 		return token.NewNoopToken().Encode(), nil
 	}
 
-	txn := r.database.DB.Txn(true)
+	txn := r.database.Txn(true)
 	defer txn.Abort()
 
+	var id uint64
+	id, err = newTransaction(txn, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var next uint64
+	next, err = nextRelationTupleID(txn)
+	if err != nil {
+		return nil, err
+	}
+
 	for iterator.HasNext() {
 		bt := iterator.GetNext()
 		t := repositories.RelationTuple{
-			ID:              utils.RelationTuplesID.ID(),
+			ID:              next,
 			TenantID:        tenantID,
 			EntityType:      bt.GetEntity().GetType(),
 			EntityID:        bt.GetEntity().GetId(),
@@ -54,22 +68,44 @@ func (r *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 			SubjectType:     bt.GetSubject().GetType(),
 			SubjectID:       bt.GetSubject().GetId(),
 			SubjectRelation: bt.GetSubject().GetRelation(),
+			CreatedTxID:     id,
+		}
+
+		var alive bool
+		alive, err = isAlive(txn, t)
+		if err != nil {
+			return nil, err
+		}
+		if alive {
+			continue
 		}
+
 		if err = txn.Insert(RelationTuplesTable, t); err != nil {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
+		next++
 	}
 
-	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	if err = r.database.Commit(txn); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	notify(r.database, repositories.Change{TenantID: tenantID, Kind: repositories.RelationshipChange})
+	return snapshot.NewToken(r.keys, tenantID, id).Encode(), nil
 }
 
-// DeleteRelationships - Delete relationship from repository
+// DeleteRelationships - Expires the alive relationships matching the filter, they stay readable at older snapshots
+// until the garbage collector removes them
 func (r *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
 	var err error
-	txn := r.database.DB.Txn(true)
+	txn := r.database.Txn(true)
 	defer txn.Abort()
 
+	var id uint64
+	id, err = newTransaction(txn, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
 	index, args := utils.GetIndexNameAndArgsByFilters(tenantID, filter)
 	var it memdb.ResultIterator
 	it, err = txn.Get(RelationTuplesTable, index, args...)
@@ -77,18 +113,65 @@ func (r *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID s
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 
+	// collect the tuples first, the table can not be modified while iterating over it
+	var expired []repositories.RelationTuple
 	fit := memdb.NewFilterIterator(it, utils.FilterQuery(filter))
 	for obj := fit.Next(); obj != nil; obj = fit.Next() {
 		t, ok := obj.(repositories.RelationTuple)
 		if !ok {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
-		err = txn.Delete(RelationTuplesTable, t)
-		if err != nil {
+		if t.ExpiredTxID != 0 {
+			continue
+		}
+		t.ExpiredTxID = id
+		expired = append(expired, t)
+	}
+
+	for _, t := range expired {
+		if err = txn.Insert(RelationTuplesTable, t); err != nil {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 	}
 
-	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	if err = r.database.Commit(txn); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	notify(r.database, repositories.Change{TenantID: tenantID, Kind: repositories.RelationshipChange})
+	return snapshot.NewToken(r.keys, tenantID, id).Encode(), nil
+}
+
+// isAlive - Checks whether the tuple is already written and not expired
+func isAlive(txn *memdb.Txn, tuple repositories.RelationTuple) (bool, error) {
+	it, err := txn.Get(RelationTuplesTable, "entity-index", tuple.TenantID, tuple.EntityType, tuple.EntityID, tuple.Relation)
+	if err != nil {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		t, ok := obj.(repositories.RelationTuple)
+		if !ok {
+			return false, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if t.ExpiredTxID == 0 && t.SubjectType == tuple.SubjectType && t.SubjectID == tuple.SubjectID && t.SubjectRelation == tuple.SubjectRelation {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// nextRelationTupleID - Gets the id following the largest tuple id, ids are derived from the table so they
+// keep increasing when a durable database is restored
+func nextRelationTupleID(txn *memdb.Txn) (uint64, error) {
+	raw, err := txn.Last(RelationTuplesTable, "id")
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return 1, nil
+	}
+	t, ok := raw.(repositories.RelationTuple)
+	if !ok {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return t.ID + 1, nil
 }
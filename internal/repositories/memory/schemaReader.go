@@ -3,11 +3,14 @@ package memory
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"github.com/hashicorp/go-memdb"
 
 	"permify/internal/repositories"
+	"permify/internal/repositories/memory/utils"
 	"permify/internal/schema"
+	"permify/pkg/database"
 	db "permify/pkg/database/memory"
 	"permify/pkg/logger"
 	base "permify/pkg/pb/base/v1"
@@ -43,6 +46,10 @@ func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string)
 		definitions = append(definitions, obj.(repositories.SchemaDefinition).Serialized())
 	}
 
+	if len(definitions) == 0 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+	}
+
 	sch, err = schema.NewSchemaFromStringDefinitions(true, definitions...)
 	if err != nil {
 		return nil, err
@@ -93,3 +100,53 @@ func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (string
 	}
 	return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
 }
+
+// ListSchemas - Lists the schema versions of the tenant, the newest first.
+func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var upperBound string
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			return nil, nil, err
+		}
+		upperBound = t.(utils.ContinuousToken).Value
+	}
+
+	var it memdb.ResultIterator
+	it, err = txn.Get(SchemaDefinitionsTable, "tenant", tenantID)
+	if err != nil {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	// the tenant index is not ordered by version, so distinct versions are collected and sorted first
+	seen := map[string]struct{}{}
+	var versions []string
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		def, ok := obj.(repositories.SchemaDefinition)
+		if !ok {
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if upperBound != "" && def.Version > upperBound {
+			continue
+		}
+		if _, ok = seen[def.Version]; !ok {
+			seen[def.Version] = struct{}{}
+			versions = append(versions, def.Version)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(versions)))
+
+	schemas = make([]*base.SchemaList, 0, pagination.PageSize()+1)
+	for _, v := range versions {
+		schemas = append(schemas, repositories.SchemaVersion{Version: v}.ToSchemaList())
+		if len(schemas) > int(pagination.PageSize()) {
+			return schemas[:pagination.PageSize()], utils.NewContinuousToken(v).Encode(), nil
+		}
+	}
+
+	return schemas, utils.NewNoopContinuousToken().Encode(), nil
+}
@@ -28,13 +28,22 @@ func NewSchemaWriter(database *db.Memory, logger logger.Interface) *SchemaWriter
 // WriteSchema - Write Schema to repository
 func (w *SchemaWriter) WriteSchema(ctx context.Context, definitions []repositories.SchemaDefinition) error {
 	var err error
-	txn := w.database.DB.Txn(true)
+	txn := w.database.Txn(true)
 	defer txn.Abort()
 	for _, definition := range definitions {
 		if err = txn.Insert(SchemaDefinitionsTable, definition); err != nil {
 			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 	}
-	txn.Commit()
+	if err = w.database.Commit(txn); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	notified := map[string]bool{}
+	for _, definition := range definitions {
+		if !notified[definition.TenantID] {
+			notified[definition.TenantID] = true
+			notify(w.database, repositories.Change{TenantID: definition.TenantID, Kind: repositories.SchemaChange})
+		}
+	}
 	return nil
 }
@@ -1,44 +1,52 @@
 package snapshot
 
 import (
-	"encoding/base64"
 	"encoding/binary"
-	"time"
+	"errors"
 
+	base "permify/pkg/pb/base/v1"
 	"permify/pkg/token"
 )
 
 type (
 	// Token - Structure for Token
 	Token struct {
-		Value uint64
+		Keys     *token.SigningKeys
+		TenantID string
+		Value    uint64
 	}
-	// EncodedToken - Structure for EncodedToken
+	// EncodedToken - Structure for EncodedToken, the tenant the token is decoded for
 	EncodedToken struct {
-		Value string
+		Keys     *token.SigningKeys
+		TenantID string
+		Value    string
 	}
 )
 
-// NewToken - Creates a new snapshot token
-func NewToken(value time.Time) token.SnapToken {
+// NewToken - Creates a new snapshot token of the tenant, signed with the keys from a transaction id
+func NewToken(keys *token.SigningKeys, tenantID string, value uint64) token.SnapToken {
 	return Token{
-		Value: uint64(value.UnixNano()),
+		Keys:     keys,
+		TenantID: tenantID,
+		Value:    value,
 	}
 }
 
-// Encode - Encodes the token to a string
+// Encode - Encodes the token to a string, the transaction id is sealed in a signed envelope of the tenant
 func (t Token) Encode() token.EncodedSnapToken {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, t.Value)
 	return EncodedToken{
-		Value: base64.StdEncoding.EncodeToString(b),
+		Keys:     t.Keys,
+		TenantID: t.TenantID,
+		Value:    t.Keys.Seal(t.TenantID, b),
 	}
 }
 
 // Eg token is equal to given token
 func (t Token) Eg(token token.SnapToken) bool {
 	ct, ok := token.(Token)
-	return ok && t.Value == ct.Value
+	return ok && t.TenantID == ct.TenantID && t.Value == ct.Value
 }
 
 // Gt snapshot is greater than given snapshot
@@ -53,14 +61,19 @@ func (t Token) Lt(token token.SnapToken) bool {
 	return ok && t.Value < ct.Value
 }
 
-// Decode decodes the token from a string
+// Decode decodes the token from a string, tokens of other tenants and forged tokens are rejected
 func (t EncodedToken) Decode() (token.SnapToken, error) {
-	b, err := base64.StdEncoding.DecodeString(t.Value)
+	b, err := t.Keys.Open(t.TenantID, t.Value)
 	if err != nil {
 		return nil, err
 	}
+	if len(b) != 8 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String())
+	}
 	return Token{
-		Value: binary.LittleEndian.Uint64(b),
+		Keys:     t.Keys,
+		TenantID: t.TenantID,
+		Value:    binary.LittleEndian.Uint64(b),
 	}, nil
 }
 
@@ -33,18 +33,20 @@ func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (resul
 		Name:      name,
 		CreatedAt: time.Now(),
 	}
-	txn := w.database.DB.Txn(true)
+	txn := w.database.Txn(true)
 	defer txn.Abort()
 	if err = txn.Insert(TenantsTable, tenant); err != nil {
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
-	txn.Commit()
+	if err = w.database.Commit(txn); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
 	return tenant.ToTenant(), nil
 }
 
 // DeleteTenant -
 func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
-	txn := w.database.DB.Txn(true)
+	txn := w.database.Txn(true)
 	defer txn.Abort()
 	var raw interface{}
 	raw, err = txn.First(TenantsTable, "id", tenantID)
@@ -54,6 +56,8 @@ func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (resul
 	if _, err = txn.DeleteAll(TenantsTable, "id", tenantID); err != nil {
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
-	txn.Commit()
+	if err = w.database.Commit(txn); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
 	return raw.(repositories.Tenant).ToTenant(), nil
 }
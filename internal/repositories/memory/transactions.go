@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"permify/internal/repositories"
+	"permify/internal/repositories/memory/snapshot"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+)
+
+// newTransaction - Records a new transaction for the tenant, ids are increasing across tenants and are never reused
+// because the garbage collector keeps the latest transaction of every tenant
+func newTransaction(txn *memdb.Txn, tenantID string) (uint64, error) {
+	var id uint64 = 1
+	raw, err := txn.Last(TransactionsTable, "id")
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw != nil {
+		last, ok := raw.(repositories.Transaction)
+		if !ok {
+			return 0, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		id = last.ID + 1
+	}
+
+	if err = txn.Insert(TransactionsTable, repositories.Transaction{
+		ID:        id,
+		TenantID:  tenantID,
+		Timestamp: time.Now(),
+	}); err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	return id, nil
+}
+
+// headTransaction - Gets the id of the latest transaction of the tenant, zero if the tenant has no transactions
+func headTransaction(txn *memdb.Txn, tenantID string) (uint64, error) {
+	raw, err := txn.Last(TransactionsTable, "tenant", tenantID)
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return 0, nil
+	}
+	t, ok := raw.(repositories.Transaction)
+	if !ok {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return t.ID, nil
+}
+
+// snapshotValue - Decodes the snap token, an empty token reads the head of the tenant in the given transaction
+func snapshotValue(txn *memdb.Txn, keys *token.SigningKeys, tenantID, snap string) (uint64, error) {
+	if snap == "" {
+		return headTransaction(txn, tenantID)
+	}
+	st, err := snapshot.EncodedToken{Keys: keys, TenantID: tenantID, Value: snap}.Decode()
+	if err != nil {
+		return 0, err
+	}
+	return st.(snapshot.Token).Value, nil
+}
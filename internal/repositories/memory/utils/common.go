@@ -1,30 +1,9 @@
 package utils
 
 import (
-	"sync"
-
 	base "permify/pkg/pb/base/v1"
 )
 
-var RelationTuplesID AutoIncForRelationTuples
-
-type AutoIncForRelationTuples struct {
-	sync.Mutex
-	id uint64
-}
-
-// ID -
-func (a *AutoIncForRelationTuples) ID() (id uint64) {
-	a.Lock()
-	defer a.Unlock()
-	if a.id == 0 {
-		a.id++
-	}
-	id = a.id
-	a.id++
-	return
-}
-
 // GetIndexNameAndArgsByFilters - Get index name and arguments by filters
 func GetIndexNameAndArgsByFilters(tenantID string, filter *base.TupleFilter) (string, []any) {
 	if filter.GetEntity().GetType() != "" && filter.GetRelation() != "" {
@@ -33,5 +12,5 @@ func GetIndexNameAndArgsByFilters(tenantID string, filter *base.TupleFilter) (st
 	if filter.GetEntity().GetType() != "" {
 		return "entity-type-index", []any{tenantID, filter.GetEntity().GetType()}
 	}
-	return "id", nil
+	return "tenant-index", []any{tenantID}
 }
@@ -32,3 +32,14 @@ func FilterQuery(filter *base.TupleFilter) memdb.FilterFunc {
 		return false
 	}
 }
+
+// SnapshotQuery - Filter relation tuples that are not visible at the given transaction id
+func SnapshotQuery(value uint64) memdb.FilterFunc {
+	return func(tupleRaw interface{}) bool {
+		tuple, ok := tupleRaw.(repositories.RelationTuple)
+		if !ok {
+			return true
+		}
+		return tuple.CreatedTxID > value || (tuple.ExpiredTxID != 0 && tuple.ExpiredTxID <= value)
+	}
+}
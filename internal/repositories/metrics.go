@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+// GarbageCollectionMetrics - Structure for the instruments recording garbage collection passes
+type GarbageCollectionMetrics struct {
+	engine   string
+	runs     instrument.Int64Counter
+	removed  instrument.Int64Counter
+	duration instrument.Float64Histogram
+}
+
+// NewGarbageCollectionMetrics - Creates the instruments on the global meter provider, they do not record anything
+// until the meter is enabled
+func NewGarbageCollectionMetrics(engine string) *GarbageCollectionMetrics {
+	meter := global.Meter("permify")
+
+	runs, _ := meter.Int64Counter("garbage_collection_runs",
+		instrument.WithDescription("number of garbage collection passes by status"))
+	removed, _ := meter.Int64Counter("garbage_collection_removed_rows",
+		instrument.WithDescription("number of rows removed by garbage collection by table"))
+	duration, _ := meter.Float64Histogram("garbage_collection_duration",
+		instrument.WithDescription("duration of garbage collection passes"),
+		instrument.WithUnit("s"))
+
+	return &GarbageCollectionMetrics{
+		engine:   engine,
+		runs:     runs,
+		removed:  removed,
+		duration: duration,
+	}
+}
+
+// Record - Records a garbage collection pass, the rows of dry runs are not counted as removed
+func (m *GarbageCollectionMetrics) Record(ctx context.Context, options GarbageCollectionOptions, results []*base.GarbageCollectResult, elapsed time.Duration, err error) {
+	status := "success"
+	switch {
+	case errors.Is(err, ErrGarbageCollectionInProgress):
+		status = "skipped"
+	case err != nil:
+		status = "failure"
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("engine", m.engine),
+		attribute.Bool("dry_run", options.DryRun),
+	}
+
+	m.runs.Add(ctx, 1, append(attrs, attribute.String("status", status))...)
+	m.duration.Record(ctx, elapsed.Seconds(), attrs...)
+
+	if options.DryRun {
+		return
+	}
+
+	var tuples, transactions uint64
+	for _, result := range results {
+		tuples += result.GetRelationTuples()
+		transactions += result.GetTransactions()
+	}
+	m.removed.Add(ctx, int64(tuples), attribute.String("engine", m.engine), attribute.String("table", "relation_tuples"))
+	m.removed.Add(ctx, int64(transactions), attribute.String("engine", m.engine), attribute.String("table", "transactions"))
+}
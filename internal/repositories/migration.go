@@ -9,49 +9,63 @@ import (
 
 	"permify/internal/config"
 	"permify/pkg/database"
+	SQDatabase "permify/pkg/database/sqlite"
 	"permify/pkg/logger"
 )
 
 const (
 	postgresMigrationDir = "postgres/migrations"
+	mysqlMigrationDir    = "mysql/migrations"
+	sqliteMigrationDir   = "sqlite/migrations"
 )
 
 //go:embed postgres/migrations/*.sql
 var postgresMigrations embed.FS
 
+//go:embed mysql/migrations/*.sql
+var mysqlMigrations embed.FS
+
+//go:embed sqlite/migrations/*.sql
+var sqliteMigrations embed.FS
+
 // Migrate - migrate the database
 func Migrate(conf config.Database, l logger.Interface) (err error) {
 	switch conf.Engine {
 	case database.POSTGRES.String():
+		return migrate("pgx", conf.URI, "postgres", postgresMigrations, postgresMigrationDir, l)
+	case database.MYSQL.String():
+		return migrate("mysql", conf.URI, "mysql", mysqlMigrations, mysqlMigrationDir, l)
+	case database.SQLITE.String():
+		return migrate("sqlite", SQDatabase.DSN(conf.URI), "sqlite3", sqliteMigrations, sqliteMigrationDir, l)
+	case database.MEMORY.String():
+		return nil
+	default:
+		return fmt.Errorf("%s connection is unsupported", conf.Engine)
+	}
+}
 
-		var db *sql.DB
-		db, err = sql.Open("pgx", conf.URI)
-		if err != nil {
-			return err
-		}
-
-		defer func() {
-			if err = db.Close(); err != nil {
-				l.Fatal("failed to close the db", err)
-			}
-		}()
-
-		goose.SetTableName("migrations")
+// migrate - applies the migrations in the dir of the file system to the database of the driver, with the goose
+// dialect of the engine
+func migrate(driver, dsn, dialect string, migrations embed.FS, dir string, l logger.Interface) (err error) {
+	var db *sql.DB
+	db, err = sql.Open(driver, dsn)
+	if err != nil {
+		return err
+	}
 
-		if err = goose.SetDialect("postgres"); err != nil {
-			l.Fatal("failed to initialize the migrate command", err)
+	defer func() {
+		if cerr := db.Close(); cerr != nil {
+			l.Fatal("failed to close the db", cerr)
 		}
+	}()
 
-		goose.SetBaseFS(postgresMigrations)
-
-		if err = goose.Up(db, postgresMigrationDir); err != nil {
-			return err
-		}
+	goose.SetTableName("migrations")
 
-		return nil
-	case database.MEMORY.String():
-		return nil
-	default:
-		return fmt.Errorf("%s connection is unsupported", conf.Engine)
+	if err = goose.SetDialect(dialect); err != nil {
+		l.Fatal("failed to initialize the migrate command", err)
 	}
+
+	goose.SetBaseFS(migrations)
+
+	return goose.Up(db, dir)
 }
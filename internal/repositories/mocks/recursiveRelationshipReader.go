@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+// RecursiveRelationshipReader is an autogenerated mock type for the RecursiveRelationshipReader type
+type RecursiveRelationshipReader struct {
+	mock.Mock
+}
+
+// CheckRecursiveMembership - Checks whether the subject is reachable through a self-referential relation
+func (_m *RecursiveRelationshipReader) CheckRecursiveMembership(ctx context.Context, tenantID string, entity *base.Entity, relation string, subject *base.Subject, snap string, depth int32) (bool, error) {
+	ret := _m.Called(tenantID, entity, relation, subject, snap, depth)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, *base.Entity, string, *base.Subject, string, int32) bool); ok {
+		r0 = rf(ctx, tenantID, entity, relation, subject, snap, depth)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *base.Entity, string, *base.Subject, string, int32) error); ok {
+		r1 = rf(ctx, tenantID, entity, relation, subject, snap, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"permify/internal/repositories"
 	"permify/pkg/database"
 	base "permify/pkg/pb/base/v1"
 	"permify/pkg/token"
@@ -121,3 +122,67 @@ func (_m *RelationshipReader) HeadSnapshot(ctx context.Context, tenantID string)
 
 	return r0, r1
 }
+
+// CheckSnapshot - Checks whether a snap token sent by a client can be read at.
+func (_m *RelationshipReader) CheckSnapshot(ctx context.Context, tenantID, snap string) error {
+	ret := _m.Called(tenantID, snap)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenantID, snap)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RelationshipStats - Counts the relation tuples of the repository.
+func (_m *RelationshipReader) RelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (*repositories.RelationshipStats, error) {
+	ret := _m.Called(tenantID, snap, top)
+
+	var r0 *repositories.RelationshipStats
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint32) *repositories.RelationshipStats); ok {
+		r0 = rf(ctx, tenantID, snap, top)
+	} else {
+		r0 = ret.Get(0).(*repositories.RelationshipStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint32) error); ok {
+		r1 = rf(ctx, tenantID, snap, top)
+	} else {
+		if e, ok := ret.Get(1).(error); ok {
+			r1 = e
+		} else {
+			r1 = nil
+		}
+	}
+
+	return r0, r1
+}
+
+// CountRelationships - Counts the relation tuples matching the filter.
+func (_m *RelationshipReader) CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (uint64, error) {
+	ret := _m.Called(tenantID, filter, snap)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, string, *base.TupleFilter, string) uint64); ok {
+		r0 = rf(ctx, tenantID, filter, snap)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *base.TupleFilter, string) error); ok {
+		r1 = rf(ctx, tenantID, filter, snap)
+	} else {
+		if e, ok := ret.Get(1).(error); ok {
+			r1 = e
+		} else {
+			r1 = nil
+		}
+	}
+
+	return r0, r1
+}
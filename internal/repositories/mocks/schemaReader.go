@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
 
+	"permify/pkg/database"
 	base "permify/pkg/pb/base/v1"
 )
 
@@ -93,3 +94,37 @@ func (_m *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (versi
 
 	return r0, r1
 }
+
+// ListSchemas - Lists the schema versions of the tenant.
+func (_m *SchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
+	ret := _m.Called(tenantID, pagination)
+
+	var r0 []*base.SchemaList
+	if rf, ok := ret.Get(0).(func(context.Context, string, database.Pagination) []*base.SchemaList); ok {
+		r0 = rf(ctx, tenantID, pagination)
+	} else {
+		r0 = ret.Get(0).([]*base.SchemaList)
+	}
+
+	var r1 database.EncodedContinuousToken
+	if rf, ok := ret.Get(1).(func(context.Context, string, database.Pagination) database.EncodedContinuousToken); ok {
+		r1 = rf(ctx, tenantID, pagination)
+	} else {
+		if e, ok := ret.Get(1).(database.EncodedContinuousToken); ok {
+			r1 = e
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, database.Pagination) error); ok {
+		r2 = rf(ctx, tenantID, pagination)
+	} else {
+		if e, ok := ret.Get(2).(error); ok {
+			r2 = e
+		} else {
+			r2 = nil
+		}
+	}
+
+	return r0, r1, r2
+}
@@ -1,8 +1,10 @@
 package repositories
 
 import (
+	"encoding/json"
 	"time"
 
+	"github.com/rs/xid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	base "permify/pkg/pb/base/v1"
@@ -18,6 +20,9 @@ type RelationTuple struct {
 	SubjectType     string
 	SubjectID       string
 	SubjectRelation string
+	// CreatedTxID and ExpiredTxID are the transactions that wrote and deleted the tuple, zero expired means alive
+	CreatedTxID uint64
+	ExpiredTxID uint64
 }
 
 // ToTuple - Convert database relation tuple to base relation tuple
@@ -36,6 +41,34 @@ func (r RelationTuple) ToTuple() *base.Tuple {
 	}
 }
 
+// RelationshipStats - Structure for the relation tuple counts of a tenant at a snapshot
+type RelationshipStats struct {
+	// Counts of tuples grouped by entity type, relation and subject type
+	Counts []*base.RelationshipCount
+	// Entity relations with the most subjects, in descending order
+	TopEntities []*base.RelationshipFanOut
+	// Snapshot the tuples are counted at
+	SnapToken string
+}
+
+// Total - returns the number of tuples counted
+func (s RelationshipStats) Total() (total uint64) {
+	for _, c := range s.Counts {
+		total += c.GetCount()
+	}
+	return
+}
+
+// GarbageCollectionOptions - Structure for the options of a garbage collection pass
+type GarbageCollectionOptions struct {
+	// Tenant to collect, every tenant is collected if empty
+	TenantID string
+	// Tuples expired and transactions recorded before the window are removed, the configured window is used if zero
+	Window time.Duration
+	// Counts the rows that would be removed without removing them
+	DryRun bool
+}
+
 // SchemaDefinition - Structure for Schema Definition
 type SchemaDefinition struct {
 	TenantID             string
@@ -49,6 +82,58 @@ func (e SchemaDefinition) Serialized() string {
 	return string(e.SerializedDefinition)
 }
 
+// SchemaVersion - Structure for a schema version
+type SchemaVersion struct {
+	Version string
+}
+
+// ToSchemaList - Convert database schema version to base schema list, versions are xids so they carry their creation time
+func (v SchemaVersion) ToSchemaList() *base.SchemaList {
+	sl := &base.SchemaList{
+		Version: v.Version,
+	}
+	if id, err := xid.FromString(v.Version); err == nil {
+		sl.CreatedAt = timestamppb.New(id.Time())
+	}
+	return sl
+}
+
+// Transaction - Structure for a relationship write or delete transaction of a tenant
+type Transaction struct {
+	ID        uint64
+	TenantID  string
+	Timestamp time.Time
+}
+
+// ChangesChannel - Channel the writers publish their changes on
+const ChangesChannel = "permify_changes"
+
+// ChangeKind - What a change was written to
+type ChangeKind string
+
+const (
+	SchemaChange       ChangeKind = "schema"
+	RelationshipChange ChangeKind = "relationship"
+)
+
+// Change - Structure for a change committed by a writer, an empty tenant id stands for every tenant
+type Change struct {
+	TenantID string     `json:"tenant_id"`
+	Kind     ChangeKind `json:"kind"`
+}
+
+// Encode - Encodes the change as a notification payload
+func (c Change) Encode() (string, error) {
+	b, err := json.Marshal(c)
+	return string(b), err
+}
+
+// DecodeChange - Decodes a change from a notification payload
+func DecodeChange(payload string) (change Change, err error) {
+	err = json.Unmarshal([]byte(payload), &change)
+	return change, err
+}
+
 // Tenant - Structure for tenant
 type Tenant struct {
 	ID        string
@@ -0,0 +1,53 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"permify/internal/repositories/sqlrepo"
+	db "permify/pkg/database/mysql"
+)
+
+const (
+	// errDuplicateEntry - a row violates a unique constraint
+	errDuplicateEntry = 1062
+	// errLockWaitTimeout - a lock could not be acquired in time
+	errLockWaitTimeout = 1205
+	// errDeadlock - the transaction was chosen as the victim of a deadlock
+	errDeadlock = 1213
+)
+
+// Dialect - MySQL dialect of the SQL repositories. Writes lock the rows they depend on, and the timestamp
+// columns keep microseconds.
+var Dialect = sqlrepo.Dialect{
+	WriteIsolation:         sql.LevelRepeatableRead,
+	TenantIsolation:        sql.LevelReadCommitted,
+	LockSuffix:             "FOR UPDATE",
+	TimePrecision:          time.Microsecond,
+	IsSerializationFailure: IsSerializationFailure,
+	IsDuplicateEntry:       IsDuplicateEntry,
+}
+
+// NewDatabase - Creates the database of the SQL repositories from a MySQL instance
+func NewDatabase(database *db.MySQL) *sqlrepo.Database {
+	return &sqlrepo.Database{
+		DB:      database.DB,
+		Builder: database.Builder,
+		Dialect: Dialect,
+	}
+}
+
+// IsSerializationFailure - Checks if a transaction failed because of a concurrent transaction and can be retried
+func IsSerializationFailure(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && (e.Number == errDeadlock || e.Number == errLockWaitTimeout)
+}
+
+// IsDuplicateEntry - Checks if a statement failed because of a unique constraint
+func IsDuplicateEntry(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && e.Number == errDuplicateEntry
+}
@@ -0,0 +1,48 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS relation_tuples (
+    id               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    tenant_id        VARCHAR(128)    NOT NULL,
+    entity_type      VARCHAR(64)     NOT NULL,
+    entity_id        VARCHAR(128)    NOT NULL,
+    relation         VARCHAR(64)     NOT NULL,
+    subject_type     VARCHAR(64)     NOT NULL,
+    subject_id       VARCHAR(128)    NOT NULL,
+    subject_relation VARCHAR(64)     NOT NULL DEFAULT '',
+    created_tx_id    BIGINT UNSIGNED NOT NULL,
+    expired_tx_id    BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    CONSTRAINT pk_relation_tuple PRIMARY KEY (id),
+    CONSTRAINT uq_relation_tuple_not_expired UNIQUE (tenant_id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation, expired_tx_id),
+    INDEX idx_tuples_subject (tenant_id, subject_type, subject_id, subject_relation, entity_type, relation),
+    INDEX idx_tuples_entity (tenant_id, entity_type, entity_id, relation)
+);
+
+CREATE TABLE IF NOT EXISTS schema_definitions (
+    tenant_id             VARCHAR(128) NOT NULL,
+    entity_type           VARCHAR(64)  NOT NULL,
+    serialized_definition LONGBLOB     NOT NULL,
+    version               CHAR(20)     NOT NULL,
+    CONSTRAINT pk_schema_definition PRIMARY KEY (tenant_id, entity_type, version)
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id        BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128)    NOT NULL,
+    timestamp TIMESTAMP(6)    NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    CONSTRAINT pk_transaction PRIMARY KEY (id),
+    INDEX idx_transactions_tenant (tenant_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS tenants (
+    id         VARCHAR(128) NOT NULL,
+    name       VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    CONSTRAINT pk_tenants PRIMARY KEY (id)
+);
+
+INSERT INTO tenants (id, name) VALUES ('t1', 'example tenant');
+
+-- +goose Down
+DROP TABLE IF EXISTS relation_tuples;
+DROP TABLE IF EXISTS schema_definitions;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS tenants;
@@ -0,0 +1,52 @@
+package mysql
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/config"
+	"permify/internal/repositories"
+	"permify/internal/repositories/repositorytest"
+	"permify/internal/repositories/sqlrepo"
+	db "permify/pkg/database/mysql"
+	"permify/pkg/logger"
+)
+
+func TestMySQL(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "mysql-suite")
+}
+
+// instance is shared by the specs of the repository suite, it is migrated when the first spec runs
+var instance *db.MySQL
+
+// The repository suite runs against the server PERMIFY_TEST_MYSQL_URI points to,
+// e.g. "root:secret@tcp(localhost:3306)/permify", and is skipped without it.
+var _ = repositorytest.DescribeRepositories("mysql", func() repositorytest.Repositories {
+	uri := os.Getenv("PERMIFY_TEST_MYSQL_URI")
+	if uri == "" {
+		Skip("PERMIFY_TEST_MYSQL_URI is not set")
+	}
+
+	l := logger.New("debug")
+
+	if instance == nil {
+		Expect(repositories.Migrate(config.Database{Engine: "mysql", URI: uri}, l)).Should(Succeed())
+
+		var err error
+		instance, err = db.New(uri)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	return repositorytest.Repositories{
+		RelationshipReader: sqlrepo.NewRelationshipReader(NewDatabase(instance), l),
+		RelationshipWriter: sqlrepo.NewRelationshipWriter(NewDatabase(instance), l),
+		SchemaReader:       sqlrepo.NewSchemaReader(NewDatabase(instance), l),
+		SchemaWriter:       sqlrepo.NewSchemaWriter(NewDatabase(instance), l),
+		TenantReader:       sqlrepo.NewTenantReader(NewDatabase(instance), l),
+		TenantWriter:       sqlrepo.NewTenantWriter(NewDatabase(instance), l),
+	}
+}, repositorytest.Options{Snapshots: true})
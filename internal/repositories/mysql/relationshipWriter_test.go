@@ -0,0 +1,137 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/go-sql-driver/mysql"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/repositories/sqlrepo"
+	"permify/internal/repositories/sqlrepo/snapshot"
+	"permify/pkg/database"
+	db "permify/pkg/database/mysql"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("RelationshipWriter", func() {
+	var relationshipWriter *sqlrepo.RelationshipWriter
+	var mock sqlmock.Sqlmock
+
+	BeforeEach(func() {
+		l := logger.New("debug")
+
+		var sqlDB *sql.DB
+		var err error
+
+		sqlDB, mock, err = sqlmock.New()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		my := &db.MySQL{
+			DB:      sqlDB,
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question),
+		}
+
+		relationshipWriter = sqlrepo.NewRelationshipWriter(NewDatabase(my), l)
+	})
+
+	AfterEach(func() {
+		err := mock.ExpectationsWereMet()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	collection := func() *database.TupleCollection {
+		return database.NewTupleCollection(&base.Tuple{
+			Entity:   &base.Entity{Type: "organization", Id: "abc"},
+			Relation: "admin",
+			Subject:  &base.Subject{Type: "user", Id: "1"},
+		})
+	}
+
+	Context("WriteRelationships", func() {
+		It("should insert the tuples with the id of a new transaction", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = ? ORDER BY id DESC LIMIT 1 FOR UPDATE`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions (tenant_id) VALUES (?)`)).
+				WithArgs("t1").
+				WillReturnResult(sqlmock.NewResult(7, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO relation_tuples (entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id, created_tx_id) VALUES (?,?,?,?,?,?,?,?)`)).
+				WithArgs("organization", "abc", "admin", "user", "1", "", "t1", uint64(7)).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			token, err := relationshipWriter.WriteRelationships(context.Background(), "t1", collection())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token).Should(Equal(snapshot.NewToken(7).Encode()))
+		})
+
+		It("should retry when the transaction is chosen as a deadlock victim", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = ? ORDER BY id DESC LIMIT 1 FOR UPDATE`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions (tenant_id) VALUES (?)`)).
+				WithArgs("t1").
+				WillReturnError(&mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"})
+			mock.ExpectRollback()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = ? ORDER BY id DESC LIMIT 1 FOR UPDATE`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions (tenant_id) VALUES (?)`)).
+				WithArgs("t1").
+				WillReturnResult(sqlmock.NewResult(2, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO relation_tuples`)).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			token, err := relationshipWriter.WriteRelationships(context.Background(), "t1", collection())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token).Should(Equal(snapshot.NewToken(2).Encode()))
+		})
+
+		It("should fail on a duplicate tuple", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions`)).
+				WillReturnResult(sqlmock.NewResult(2, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO relation_tuples`)).
+				WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+			mock.ExpectRollback()
+
+			_, err := relationshipWriter.WriteRelationships(context.Background(), "t1", collection())
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String()))
+		})
+	})
+
+	Context("DeleteRelationships", func() {
+		It("should expire the tuples at the id of a new transaction", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = ? ORDER BY id DESC LIMIT 1 FOR UPDATE`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions (tenant_id) VALUES (?)`)).
+				WithArgs("t1").
+				WillReturnResult(sqlmock.NewResult(8, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE relation_tuples SET expired_tx_id = ? WHERE expired_tx_id = ? AND tenant_id = ? AND entity_id IN (?) AND entity_type = ?`)).
+				WithArgs(uint64(8), 0, "t1", "abc", "organization").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			token, err := relationshipWriter.DeleteRelationships(context.Background(), "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization", Ids: []string{"abc"}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token).Should(Equal(snapshot.NewToken(8).Encode()))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"permify/internal/repositories"
+	db "permify/pkg/database/postgres"
+	"permify/pkg/logger"
+)
+
+// ChangeListener - Structure for Change Listener
+type ChangeListener struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewChangeListener - Creates a new ChangeListener
+func NewChangeListener(database *db.Postgres, logger logger.Interface) *ChangeListener {
+	return &ChangeListener{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// Listen - Listens on the changes channel with a dedicated connection of the primary and calls the handler with the
+// notifications of the writers. The connection is opened again with a backoff when it is lost, notifications sent in
+// the meantime are missed, so every tenant is handled as changed after reconnecting.
+func (l *ChangeListener) Listen(ctx context.Context, handler func(change repositories.Change)) error {
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = 0
+	b.MaxInterval = 30 * time.Second
+
+	connected := false
+	for {
+		err := l.listen(ctx, func() {
+			if connected {
+				handler(repositories.Change{})
+			}
+			connected = true
+			b.Reset()
+		}, handler)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		wait := b.NextBackOff()
+		l.logger.Error("change listener disconnected, reconnecting in " + wait.String() + ": " + err.Error())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// listen - listens on a single connection until it fails or the context is cancelled
+func (l *ChangeListener) listen(ctx context.Context, connected func(), handler func(change repositories.Change)) error {
+	conn, err := l.database.DB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("unsupported driver connection")
+		}
+		// the connection is closed rather than returned to the pool subscribed to the channel,
+		// the pool discards closed connections
+		defer c.Close()
+
+		if _, err := c.Conn().Exec(ctx, "LISTEN "+pgx.Identifier{repositories.ChangesChannel}.Sanitize()); err != nil {
+			return err
+		}
+		connected()
+
+		for {
+			notification, err := c.Conn().WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+
+			change, err := repositories.DecodeChange(notification.Payload)
+			if err != nil {
+				l.logger.Error("change listener received an invalid payload: " + notification.Payload)
+				continue
+			}
+			handler(change)
+		}
+	})
+}
@@ -10,4 +10,6 @@ const (
 const (
 	_defaultMaxTuplesPerWrite = 100
 	_defaultMaxRetries        = 10
+	// key of the advisory lock held while collecting garbage
+	_garbageCollectionLock = "permify-garbage-collection"
 )
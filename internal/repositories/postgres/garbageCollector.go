@@ -3,17 +3,19 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/sync/errgroup"
+
 	"permify/internal/config"
 	"permify/internal/repositories"
 	"permify/internal/repositories/postgres/utils"
 	db "permify/pkg/database/postgres"
 	"permify/pkg/logger"
 	base "permify/pkg/pb/base/v1"
-	"time"
-
-	"go.opentelemetry.io/otel/codes"
-	"golang.org/x/sync/errgroup"
-	"golang.org/x/sync/semaphore"
 )
 
 // GarbageCollector - Structure for GarbageCollector
@@ -23,11 +25,14 @@ type GarbageCollector struct {
 	txOptions sql.TxOptions
 	// logger
 	logger logger.Interface
-	// context to manage goroutines and cancellation
-	ctx context.Context
-	// errgroup for managing multiple goroutines
+	// metrics of the collection passes
+	metrics *repositories.GarbageCollectionMetrics
+	// context to manage the collection loop and its cancellation
+	ctx    context.Context
+	cancel context.CancelFunc
+	// errgroup for the collection loop
 	g *errgroup.Group
-	// limit for concurrent permission checks
+	// number of tenants collected concurrently
 	concurrencyLimit int
 	// interval for garbage collection
 	interval time.Duration
@@ -38,107 +43,151 @@ type GarbageCollector struct {
 }
 
 // NewGarbageCollector creates a new GarbageCollector instance.
-// ctx: context for managing goroutines and cancellation
-// concurrencyLimit: the maximum number of concurrent garbage collection
+// ctx: context for managing the collection loop and cancellation
+// cfg: the interval, timeout and window of the periodic collection and the number of tenants collected concurrently
 func NewGarbageCollector(ctx context.Context, db *db.Postgres, logger logger.Interface, cfg config.DatabaseGarbageCollection) *GarbageCollector {
+	ctx, cancel := context.WithCancel(ctx)
+	concurrencyLimit := cfg.NumberOfThreads
+	if concurrencyLimit < 1 {
+		concurrencyLimit = 1
+	}
 	return &GarbageCollector{
 		g:                &errgroup.Group{},
-		concurrencyLimit: cfg.NumberOfThreads,
+		concurrencyLimit: concurrencyLimit,
 		interval:         cfg.Interval,
 		timeout:          cfg.Timeout,
 		window:           cfg.Window,
 		txOptions:        sql.TxOptions{Isolation: sql.LevelReadCommitted, ReadOnly: false},
 		database:         db,
 		logger:           logger,
+		metrics:          repositories.NewGarbageCollectionMetrics(db.GetEngineType()),
 		ctx:              ctx,
+		cancel:           cancel,
 	}
 }
 
-// Start begins processing permission check requests from the RequestChan.
-// It starts an errgroup that manages multiple goroutines for garbage collector check.
+// Start runs the garbage collection every interval until the collector is stopped. Passes that find another
+// instance collecting are skipped.
 func (c *GarbageCollector) Start() error {
 	c.g.Go(func() error {
-		sem := semaphore.NewWeighted(int64(c.concurrencyLimit))
-		// for loop time ticker
-
-		// tracer start
-		ctx, span := tracer.Start(c.ctx, "garbage-collector.start")
-		defer span.End()
-
 		ticker := time.NewTicker(c.interval)
-		for _ = range ticker.C {
+		defer ticker.Stop()
+
+		for {
 			select {
-			case <-ctx.Done():
-				c.logger.Info("garbage collector stopped due to timeout")
+			case <-c.ctx.Done():
+				c.logger.Info("garbage collector stopped")
 				return nil
-			default:
+			case <-ticker.C:
 				c.logger.Info("garbage collector started")
-				// acquire a semaphore before processing a request
-				if err := sem.Acquire(c.ctx, 1); err != nil {
-					return err
-				}
-
-				tenants, err := c.getTenants(ctx)
+				ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+				_, err := c.Collect(ctx, repositories.GarbageCollectionOptions{})
+				cancel()
 				if err != nil {
-					span.RecordError(err)
-					span.SetStatus(codes.Error, err.Error())
-					return err
-				}
-
-				// run the permission check in a separate goroutine
-				c.g.Go(func() error {
-					defer sem.Release(1)
-
-					for i := range tenants {
-						err := c.executeCollector(ctx, tenants[i].Id)
-						if err != nil {
-							span.RecordError(err)
-							span.SetStatus(codes.Error, err.Error())
-							c.logger.Error("garbage collector failed for tenant: " + tenants[i].Id + " with error: " + err.Error())
-
-							return err
-						}
-						c.logger.Info("garbage collector finished for tenant: " + tenants[i].Id)
+					if errors.Is(err, repositories.ErrGarbageCollectionInProgress) {
+						c.logger.Info("garbage collector skipped, another instance is collecting")
+						continue
 					}
-
-					time.Sleep(c.timeout)
-
-					return nil
-				})
+					c.logger.Error("garbage collector failed with error: " + err.Error())
+				}
 			}
 		}
-		// wait for all remaining semaphore resources to be released
-		if err := sem.Acquire(c.ctx, int64(c.concurrencyLimit)); err != nil {
-			return err
-
-		}
-		return nil
 	})
 
 	return nil
 }
 
-// Stop stops input by closing the GarbageCollector.
+// Stop stops the collection loop.
 func (c *GarbageCollector) Stop() {
-	c.ctx.Done()
+	c.cancel()
 }
 
-// Wait waits for all goroutines in the errgroup to finish.
-// Returns an error if any of the goroutines encounter an error.
+// Wait waits for the collection loop to finish.
+// Returns an error if it encountered an error.
 func (c *GarbageCollector) Wait() error {
-	if err := c.g.Wait(); err != nil {
-		return err
-	}
-	return nil
+	return c.g.Wait()
 }
 
-func (c *GarbageCollector) getTenants(ctx context.Context) ([]*base.Tenant, error) {
-	// get all tenants
-	builder := c.database.Builder.Select("id, name, created_at").From(TenantsTable)
+// Collect deletes the tuples that were expired before the window and the transactions that were recorded before
+// it, except the latest transaction of every tenant, and returns the rows removed per tenant. With dry run the rows
+// are only counted. A session level advisory lock keeps the instances sharing the database from collecting at the
+// same time, the pass fails with ERROR_CODE_GARBAGE_COLLECTION_IN_PROGRESS if it is held by another one.
+func (c *GarbageCollector) Collect(ctx context.Context, options repositories.GarbageCollectionOptions) (results []*base.GarbageCollectResult, err error) {
+	ctx, span := tracer.Start(ctx, "garbage-collector.collect")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		c.metrics.Record(ctx, options, results, time.Since(start), err)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}()
+
+	window := options.Window
+	if window == 0 {
+		window = c.window
+	}
+	cutoff := time.Now().Add(-window)
 
-	query, args, err := builder.ToSql()
+	// the lock belongs to the session, so it is taken and released on the same connection
+	var conn *sql.Conn
+	conn, err = c.database.DB.Conn(ctx)
 	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
 
+	var locked bool
+	if err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", _garbageCollectionLock).Scan(&locked); err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, repositories.ErrGarbageCollectionInProgress
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", _garbageCollectionLock); err != nil {
+			c.logger.Error("failed to release the garbage collection lock", err)
+		}
+	}()
+
+	tenants := []string{options.TenantID}
+	if options.TenantID == "" {
+		tenants, err = c.getTenants(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results = make([]*base.GarbageCollectResult, len(tenants))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(c.concurrencyLimit)
+	for i, tenantID := range tenants {
+		i, tenantID := i, tenantID
+		g.Go(func() error {
+			result, err := c.executeCollector(gctx, tenantID, cutoff, options.DryRun)
+			if err != nil {
+				c.logger.Error("garbage collector failed for tenant: " + tenantID + " with error: " + err.Error())
+				return err
+			}
+			c.logger.Info("garbage collector finished for tenant: " + tenantID)
+			results[i] = result
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// getTenants - returns the ids of the tenants that have transactions
+func (c *GarbageCollector) getTenants(ctx context.Context) ([]string, error) {
+	query, args, err := c.database.Builder.Select("tenant_id").Distinct().From(TransactionsTable).OrderBy("tenant_id").ToSql()
+	if err != nil {
 		return nil, err
 	}
 
@@ -147,20 +196,15 @@ func (c *GarbageCollector) getTenants(ctx context.Context) ([]*base.Tenant, erro
 	if err != nil {
 		return nil, err
 	}
-
-	// close rows
 	defer rows.Close()
 
-	// iterate over rows and convert to tenant
-	tenants := make([]*base.Tenant, 0)
-
+	var tenants []string
 	for rows.Next() {
-		sd := repositories.Tenant{}
-		err = rows.Scan(&sd.ID, &sd.Name, &sd.CreatedAt)
-		if err != nil {
+		var tenantID string
+		if err = rows.Scan(&tenantID); err != nil {
 			return nil, err
 		}
-		tenants = append(tenants, sd.ToTenant())
+		tenants = append(tenants, tenantID)
 	}
 	if err = rows.Err(); err != nil {
 		return nil, err
@@ -168,19 +212,60 @@ func (c *GarbageCollector) getTenants(ctx context.Context) ([]*base.Tenant, erro
 	return tenants, nil
 }
 
-func (c *GarbageCollector) executeCollector(ctx context.Context, tenantID string) error {
-	garbageQuery := utils.GarbageCollectQuery(c.window, tenantID)
-
-	garbageSQL, garbageQueryArgs, err := garbageQuery.ToSql()
+// executeCollector - collects the garbage of a tenant in a transaction, or counts it with dry run
+func (c *GarbageCollector) executeCollector(ctx context.Context, tenantID string, cutoff time.Time, dryRun bool) (*base.GarbageCollectResult, error) {
+	tx, err := c.database.DB.BeginTx(ctx, &c.txOptions)
 	if err != nil {
-
-		return err
+		return nil, err
 	}
+	defer utils.Rollback(tx, c.logger)
 
-	_, err = c.database.DB.QueryContext(ctx, garbageSQL, garbageQueryArgs...)
-	if err != nil {
-		return err
+	result := &base.GarbageCollectResult{TenantId: tenantID}
+
+	// the tuples go first, their condition refers to the transactions that are removed afterwards
+	for _, table := range []struct {
+		name      string
+		condition squirrel.Sqlizer
+		rows      *uint64
+	}{
+		{RelationTuplesTable, utils.GarbageCollectCondition(cutoff, tenantID), &result.RelationTuples},
+		{TransactionsTable, utils.TransactionsGarbageCollectCondition(cutoff, tenantID), &result.Transactions},
+	} {
+		var query string
+		var args []interface{}
+
+		if dryRun {
+			query, args, err = c.database.Builder.Select("COUNT(*)").From(table.name).Where(table.condition).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			if err = tx.QueryRowContext(ctx, query, args...).Scan(table.rows); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		query, args, err = c.database.Builder.Delete(table.name).Where(table.condition).ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		var res sql.Result
+		res, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		var affected int64
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		*table.rows = uint64(affected)
 	}
 
-	return nil
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/config"
+	"permify/internal/repositories"
+	"permify/pkg/database/postgres"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("GarbageCollector", func() {
+	var gc *GarbageCollector
+	var mock sqlmock.Sqlmock
+
+	BeforeEach(func() {
+		l := logger.New("debug")
+
+		var db *sql.DB
+		var err error
+
+		db, mock, err = sqlmock.New()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		pg := &postgres.Postgres{
+			DB:      db,
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}
+
+		gc = NewGarbageCollector(context.Background(), pg, l, config.DatabaseGarbageCollection{Window: time.Hour})
+	})
+
+	AfterEach(func() {
+		err := mock.ExpectationsWereMet()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Collect", func() {
+		It("should skip the pass when another instance holds the lock", func() {
+			mock.ExpectQuery(`SELECT pg_try_advisory_lock\(hashtext\(\$1\)\)`).
+				WithArgs(_garbageCollectionLock).
+				WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+			_, err := gc.Collect(context.Background(), repositories.GarbageCollectionOptions{})
+			Expect(err).Should(MatchError(repositories.ErrGarbageCollectionInProgress))
+		})
+
+		It("should count the rows of every tenant with dry run", func() {
+			mock.ExpectQuery(`SELECT pg_try_advisory_lock\(hashtext\(\$1\)\)`).
+				WithArgs(_garbageCollectionLock).
+				WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+			mock.ExpectQuery(`SELECT DISTINCT tenant_id FROM transactions ORDER BY tenant_id`).
+				WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}).AddRow("t1"))
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT COUNT\(\*\) FROM relation_tuples WHERE \(tenant_id = \$1 AND expired_tx_id <> '0'::xid8 AND expired_tx_id IN`).
+				WithArgs("t1", "t1", sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			mock.ExpectQuery(`SELECT COUNT\(\*\) FROM transactions WHERE \(tenant_id = \$1 AND timestamp < \$2 AND id <>`).
+				WithArgs("t1", sqlmock.AnyArg(), "t1").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			mock.ExpectCommit()
+			mock.ExpectExec(`SELECT pg_advisory_unlock\(hashtext\(\$1\)\)`).
+				WithArgs(_garbageCollectionLock).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			results, err := gc.Collect(context.Background(), repositories.GarbageCollectionOptions{DryRun: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(results).Should(Equal([]*base.GarbageCollectResult{
+				{TenantId: "t1", RelationTuples: 3, Transactions: 2},
+			}))
+		})
+
+		It("should delete the rows of the given tenant", func() {
+			mock.ExpectQuery(`SELECT pg_try_advisory_lock\(hashtext\(\$1\)\)`).
+				WithArgs(_garbageCollectionLock).
+				WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+			mock.ExpectBegin()
+			mock.ExpectExec(`DELETE FROM relation_tuples WHERE \(tenant_id = \$1 AND expired_tx_id <> '0'::xid8 AND expired_tx_id IN`).
+				WithArgs("t2", "t2", sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 4))
+			mock.ExpectExec(`DELETE FROM transactions WHERE \(tenant_id = \$1 AND timestamp < \$2 AND id <>`).
+				WithArgs("t2", sqlmock.AnyArg(), "t2").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+			mock.ExpectExec(`SELECT pg_advisory_unlock\(hashtext\(\$1\)\)`).
+				WithArgs(_garbageCollectionLock).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			results, err := gc.Collect(context.Background(), repositories.GarbageCollectionOptions{TenantID: "t2", Window: time.Minute})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(results).Should(Equal([]*base.GarbageCollectResult{
+				{TenantId: "t2", RelationTuples: 4, Transactions: 1},
+			}))
+		})
+	})
+})
@@ -5,8 +5,13 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"permify/pkg/token"
 )
 
+// signingKeys - signs the snap tokens of the repositories under test
+var signingKeys, _ = token.NewSigningKeys("secret")
+
 func TestPostgres(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "postgres-suite")
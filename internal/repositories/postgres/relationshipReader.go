@@ -28,6 +28,10 @@ type RelationshipReader struct {
 	// to perform operations on the relationship data.
 	database *db.Postgres
 
+	// keys sign and verify the snap tokens handed out and accepted by the
+	// RelationshipReader.
+	keys *token.SigningKeys
+
 	// txOptions holds the configuration for database transactions, such as
 	// isolation level and read-only mode, to be applied when performing
 	// operations on the relationship data.
@@ -46,6 +50,7 @@ type RelationshipReader struct {
 // Parameters:
 //   - database: A pointer to a Postgres database instance, which will be used
 //     to perform operations on the relationship data.
+//   - keys:     The keys that sign and verify snap tokens.
 //   - logger:   An instance of a logger that implements the logger.Interface, which
 //     will be used to log messages related to the operations performed by
 //     the RelationshipReader.
@@ -53,9 +58,10 @@ type RelationshipReader struct {
 // Returns:
 //   - A pointer to a new RelationshipReader instance, initialized with the given
 //     database and logger instances, and the default transaction options.
-func NewRelationshipReader(database *db.Postgres, logger logger.Interface) *RelationshipReader {
+func NewRelationshipReader(database *db.Postgres, keys *token.SigningKeys, logger logger.Interface) *RelationshipReader {
 	return &RelationshipReader{
 		database:  database,
+		keys:      keys,
 		txOptions: sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true},
 		logger:    logger,
 	}
@@ -81,7 +87,7 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 
 	// Decode the snapshot value.
 	var st token.SnapToken
-	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	st, err = snapshot.EncodedToken{Keys: r.keys, TenantID: tenantID, Value: snap}.Decode()
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -89,8 +95,9 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 	}
 
 	// Begin a new read-only transaction with the specified isolation level.
+	// A replica that has already replayed the snapshot is preferred over the primary.
 	var tx *sql.Tx
-	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	tx, err = utils.SnapshotReadDB(r.database, st.(snapshot.Token).Value.Uint).BeginTx(ctx, &r.txOptions)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -179,7 +186,7 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 
 	// Decode the snapshot value.
 	var st token.SnapToken
-	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	st, err = snapshot.EncodedToken{Keys: r.keys, TenantID: tenantID, Value: snap}.Decode()
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -187,8 +194,9 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 	}
 
 	// Begin a new read-only transaction with the specified isolation level.
+	// A replica that has already replayed the snapshot is preferred over the primary.
 	var tx *sql.Tx
-	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	tx, err = utils.SnapshotReadDB(r.database, st.(snapshot.Token).Value.Uint).BeginTx(ctx, &r.txOptions)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -316,11 +324,323 @@ func (r *RelationshipReader) HeadSnapshot(ctx context.Context, tenantID string)
 		span.SetStatus(codes.Error, err.Error())
 		// If no rows are found, return a snapshot token with a value of 0.
 		if errors.Is(err, sql.ErrNoRows) {
-			return snapshot.Token{Value: types.XID8{Uint: 0}}, nil
+			return snapshot.Token{Keys: r.keys, TenantID: tenantID, Value: types.XID8{Uint: 0}}, nil
 		}
 		return nil, err
 	}
 
 	// Return the latest snapshot token associated with the tenant.
-	return snapshot.Token{Value: xid}, nil
+	return snapshot.Token{Keys: r.keys, TenantID: tenantID, Value: xid}, nil
+}
+
+// CheckSnapshot verifies that a snap token sent by a client can still be read at. The garbage collector removes
+// the transactions recorded before its window together with the tuples that were expired before it, so a read at
+// such a snapshot would silently miss tuples instead of seeing the snapshot.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID the snap token was issued for.
+// - snap:     The snap token sent by the client.
+//
+// Returns:
+// - error: ERROR_CODE_INVALID_SNAP_TOKEN if the token is invalid or its transaction was removed, nil otherwise.
+func (r *RelationshipReader) CheckSnapshot(ctx context.Context, tenantID, snap string) error {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.check-snapshot")
+	defer span.End()
+
+	// Decode the snapshot value.
+	st, err := snapshot.EncodedToken{Keys: r.keys, TenantID: tenantID, Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	// The head snapshot of a tenant without transactions is not recorded, and there is nothing to collect.
+	revision := st.(snapshot.Token).Value.Uint
+	if revision == 0 {
+		return nil
+	}
+
+	// Build the query that checks whether the transaction of the snapshot is still recorded.
+	builder := r.database.Builder.Select("1").From(TransactionsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.Expr("id = ?::xid8", strconv.FormatUint(revision, 10))).
+		Prefix("SELECT EXISTS (").Suffix(")")
+	query, args, err := builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	// Execute the query, a replica that has already replayed the snapshot is preferred over the primary.
+	var exists bool
+	row := utils.SnapshotReadDB(r.database, revision).QueryRowContext(ctx, query, args...)
+	if err = row.Scan(&exists); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if !exists {
+		err = errors.New(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String())
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RelationshipStats counts the live relation tuples of a tenant at a snapshot with aggregate queries,
+// grouped by entity type, relation and subject type, along with the entity relations that have the
+// most subjects.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID for which the relationships should be counted.
+// - snap:     A string representing the snapshot value to be used, the head snapshot if empty.
+// - top:      The number of entity relations with the largest fan-out to return.
+//
+// Returns:
+// - stats:    A pointer to a RelationshipStats struct containing the counts.
+// - err:      An error, if any occurred during the execution of the queries.
+func (r *RelationshipReader) RelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (stats *repositories.RelationshipStats, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.relationship-stats")
+	defer span.End()
+
+	// Resolve the head snapshot if no snapshot value is given.
+	var st token.SnapToken
+	if snap == "" {
+		st, err = r.HeadSnapshot(ctx, tenantID)
+	} else {
+		st, err = snapshot.EncodedToken{Keys: r.keys, TenantID: tenantID, Value: snap}.Decode()
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Begin a new read-only transaction so both queries see the same data.
+	// A replica that has already replayed the snapshot is preferred over the primary.
+	var tx *sql.Tx
+	tx, err = utils.SnapshotReadDB(r.database, st.(snapshot.Token).Value.Uint).BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Rollback the transaction in case of any error.
+	defer utils.Rollback(tx, r.logger)
+
+	stats = &repositories.RelationshipStats{
+		Counts:      []*base.RelationshipCount{},
+		TopEntities: []*base.RelationshipFanOut{},
+		SnapToken:   st.Encode().String(),
+	}
+
+	// Count the tuples grouped by entity type, relation and subject type.
+	builder := r.database.Builder.Select("entity_type, relation, subject_type, COUNT(*)").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint)
+	builder = builder.GroupBy("entity_type", "relation", "subject_type").OrderBy("entity_type", "relation", "subject_type")
+
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c := &base.RelationshipCount{}
+		err = rows.Scan(&c.EntityType, &c.Relation, &c.SubjectType, &c.Count)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		stats.Counts = append(stats.Counts, c)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Find the entity relations with the most subjects.
+	if top > 0 {
+		builder = r.database.Builder.Select("entity_type, entity_id, relation, COUNT(*) AS fan_out").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+		builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint)
+		builder = builder.GroupBy("entity_type", "entity_id", "relation").OrderBy("fan_out DESC", "entity_type", "entity_id", "relation").Limit(uint64(top))
+
+		query, args, err = builder.ToSql()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+
+		var fanOutRows *sql.Rows
+		fanOutRows, err = tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		defer fanOutRows.Close()
+
+		for fanOutRows.Next() {
+			f := &base.RelationshipFanOut{Entity: &base.Entity{}}
+			err = fanOutRows.Scan(&f.Entity.Type, &f.Entity.Id, &f.Relation, &f.Count)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return nil, err
+			}
+			stats.TopEntities = append(stats.TopEntities, f)
+		}
+		if err = fanOutRows.Err(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	// Commit the transaction.
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// CountRelationships counts the live relation tuples of a tenant that match a filter at a snapshot
+// with a single aggregate query.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID for which the relationships should be counted.
+// - filter:   A pointer to a TupleFilter struct specifying the filter criteria for the query.
+// - snap:     A string representing the snapshot value to be used, the head snapshot if empty.
+//
+// Returns:
+// - count:    The number of matching relation tuples.
+// - err:      An error, if any occurred during the execution of the query.
+func (r *RelationshipReader) CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (count uint64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.count-relationships")
+	defer span.End()
+
+	// Resolve the head snapshot if no snapshot value is given.
+	var st token.SnapToken
+	if snap == "" {
+		st, err = r.HeadSnapshot(ctx, tenantID)
+	} else {
+		st, err = snapshot.EncodedToken{Keys: r.keys, TenantID: tenantID, Value: snap}.Decode()
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+
+	// Build the count query based on the provided filter and snapshot value.
+	builder := r.database.Builder.Select("COUNT(*)").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint)
+
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	// Execute the query and scan the count.
+	// A replica that has already replayed the snapshot is preferred over the primary.
+	row := utils.SnapshotReadDB(r.database, st.(snapshot.Token).Value.Uint).QueryRowContext(ctx, query, args...)
+	if err = row.Scan(&count); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+	}
+
+	return count, nil
+}
+
+// CheckRecursiveMembership resolves a self-referential relation, such as the members of nested groups, with a single
+// recursive query instead of a query per level. The relation tuples are read at the given snapshot, and the levels
+// are bounded by depth the same way the check engine bounds them.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID for which the membership should be checked.
+// - entity:   The entity the walk starts from.
+// - relation: The self-referential relation of the entity type.
+// - subject:  The subject to look for.
+// - snap:     A string representing the snapshot value to be used for the query.
+// - depth:    The number of levels that can be evaluated.
+//
+// Returns:
+// - allowed:  Whether the subject is reachable from the entity.
+// - err:      ERROR_CODE_DEPTH_NOT_ENOUGH if the subject is not found above depth and there are members below it,
+// or any error that occurred during the execution of the query.
+func (r *RelationshipReader) CheckRecursiveMembership(ctx context.Context, tenantID string, entity *base.Entity, relation string, subject *base.Subject, snap string, depth int32) (allowed bool, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.check-recursive-membership")
+	defer span.End()
+
+	// Decode the snapshot value.
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Keys: r.keys, TenantID: tenantID, Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, err
+	}
+
+	var query string
+	var args []interface{}
+	query, args, err = utils.RecursiveMembershipQuery(tenantID, entity, relation, subject, st.(snapshot.Token).Value.Uint, depth)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	// Execute the query and scan whether the subject was found and whether the walk reached the depth.
+	// A replica that has already replayed the snapshot is preferred over the primary.
+	var exhausted bool
+	row := utils.SnapshotReadDB(r.database, st.(snapshot.Token).Value.Uint).QueryRowContext(ctx, query, args...)
+	if err = row.Scan(&allowed, &exhausted); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+	}
+
+	if !allowed && exhausted {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String())
+	}
+	return allowed, nil
 }
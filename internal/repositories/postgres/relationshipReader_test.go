@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"regexp"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Masterminds/squirrel"
@@ -11,8 +12,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"permify/internal/engines"
+	"permify/internal/keys"
+	"permify/internal/repositories/mocks"
 	"permify/internal/repositories/postgres/snapshot"
 	"permify/internal/repositories/postgres/types"
+	"permify/internal/schema"
+	"permify/internal/services"
 	"permify/pkg/database"
 	"permify/pkg/database/postgres"
 	"permify/pkg/logger"
@@ -38,7 +44,7 @@ var _ = Describe("RelationshipReader", func() {
 			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 		}
 
-		relationshipReader = NewRelationshipReader(pg, l)
+		relationshipReader = NewRelationshipReader(pg, signingKeys, l)
 	})
 
 	AfterEach(func() {
@@ -46,6 +52,13 @@ var _ = Describe("RelationshipReader", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
+	// expectSnapshot - expects the check that the transaction of the snapshot is still recorded
+	expectSnapshot := func(m sqlmock.Sqlmock, exists bool) {
+		m.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS ( SELECT 1 FROM transactions WHERE tenant_id = $1 AND id = $2::xid8 )`)).
+			WithArgs("noop", "4").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(exists))
+	}
+
 	Context("QueryRelationships", func() {
 		columns := []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation"}
 
@@ -69,7 +82,7 @@ var _ = Describe("RelationshipReader", func() {
 					Ids:  []string{"abc"},
 				},
 				Relation: "admin",
-			}, snapshot.NewToken(types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String())
+			}, snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String())
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(value).Should(Equal(database.NewTupleIterator([]*base.Tuple{
@@ -100,4 +113,271 @@ var _ = Describe("RelationshipReader", func() {
 			}...)))
 		})
 	})
+
+	Context("RelationshipStats", func() {
+		It("should be same queries", func() {
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_type, relation, subject_type, COUNT(*) FROM relation_tuples WHERE tenant_id = $1 AND (pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = '4'::xid8)) = true OR created_tx_id = '4'::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = '4'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '4'::xid8) GROUP BY entity_type, relation, subject_type ORDER BY entity_type, relation, subject_type`)).
+				WithArgs("noop").
+				WillReturnRows(sqlmock.NewRows([]string{"entity_type", "relation", "subject_type", "count"}).
+					AddRow("organization", "admin", "user", 3).
+					AddRow("repository", "parent", "organization", 2))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_type, entity_id, relation, COUNT(*) AS fan_out FROM relation_tuples WHERE tenant_id = $1 AND (pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = '4'::xid8)) = true OR created_tx_id = '4'::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = '4'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '4'::xid8) GROUP BY entity_type, entity_id, relation ORDER BY fan_out DESC, entity_type, entity_id, relation LIMIT 1`)).
+				WithArgs("noop").
+				WillReturnRows(sqlmock.NewRows([]string{"entity_type", "entity_id", "relation", "fan_out"}).
+					AddRow("organization", "abc", "admin", 3))
+			mock.ExpectCommit()
+
+			stats, err := relationshipReader.RelationshipStats(context.Background(), "noop", snap, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stats.Total()).Should(Equal(uint64(5)))
+			Expect(stats.SnapToken).Should(Equal(snap))
+			Expect(stats.Counts).Should(Equal([]*base.RelationshipCount{
+				{EntityType: "organization", Relation: "admin", SubjectType: "user", Count: 3},
+				{EntityType: "repository", Relation: "parent", SubjectType: "organization", Count: 2},
+			}))
+			Expect(stats.TopEntities).Should(Equal([]*base.RelationshipFanOut{
+				{Entity: &base.Entity{Type: "organization", Id: "abc"}, Relation: "admin", Count: 3},
+			}))
+		})
+	})
+
+	Context("CountRelationships", func() {
+		It("should be same queries", func() {
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM relation_tuples WHERE tenant_id = $1 AND entity_type = $2 AND relation = $3 AND subject_relation = $4 AND subject_type = $5 AND (pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = '4'::xid8)) = true OR created_tx_id = '4'::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = '4'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '4'::xid8)`)).
+				WithArgs("noop", "organization", "member", "member", "team").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+			count, err := relationshipReader.CountRelationships(context.Background(), "noop", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "organization"},
+				Relation: "member",
+				Subject:  &base.SubjectFilter{Type: "team", Relation: "member"},
+			}, snap)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(uint64(7)))
+		})
+	})
+
+	Context("CheckSnapshot", func() {
+		It("should accept a snapshot whose transaction is recorded", func() {
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+
+			expectSnapshot(mock, true)
+
+			err := relationshipReader.CheckSnapshot(context.Background(), "noop", snap)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should reject a snapshot whose transaction was removed by the garbage collector", func() {
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+
+			expectSnapshot(mock, false)
+
+			err := relationshipReader.CheckSnapshot(context.Background(), "noop", snap)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
+		})
+
+		It("should accept the head snapshot of a tenant without transactions without a query", func() {
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 0, Status: pgtype.Present}).Encode().String()
+
+			err := relationshipReader.CheckSnapshot(context.Background(), "noop", snap)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("Tenants without relationships", func() {
+		It("should deny the checks of a tenant with a schema and no tuples", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				action view = admin
+			}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			organization, err := schema.GetEntityByName(sch, "organization")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "noop", "organization", "v1").Return(organization, "v1", nil)
+
+			checkEngine := engines.NewCheckEngine(keys.NewNoopCheckEngineKeys(), schemaReader, relationshipReader)
+			permissionService := services.NewPermissionService(relationshipReader, checkEngine, nil, nil, nil)
+
+			// expectCheck - expects the head snapshot and the relationship query of a check at it
+			expectCheck := func(head bool) {
+				if head {
+					mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = $1 ORDER BY id DESC LIMIT 1`)).
+						WithArgs("noop").
+						WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				}
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_type, entity_id, relation, subject_type, subject_id, subject_relation FROM relation_tuples WHERE tenant_id = $1`)).
+					WithArgs("noop", "1", "organization", "admin").
+					WillReturnRows(sqlmock.NewRows([]string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation"}))
+				mock.ExpectCommit()
+			}
+
+			request := func(snap string) *base.PermissionCheckRequest {
+				return &base.PermissionCheckRequest{
+					TenantId:   "noop",
+					Entity:     &base.Entity{Type: "organization", Id: "1"},
+					Permission: "view",
+					Subject:    &base.Subject{Type: tuple.USER, Id: "u1"},
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     snap,
+						SchemaVersion: "v1",
+						Depth:         20,
+					},
+				}
+			}
+
+			// without a snap token the check reads at the head snapshot of the tenant
+			expectCheck(true)
+			response, err := permissionService.CheckPermissions(context.Background(), request(""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+
+			// the head snap token of the tenant is accepted when the client sends it back
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 0, Status: pgtype.Present}).Encode().String()
+			expectCheck(false)
+			response, err = permissionService.CheckPermissions(context.Background(), request(snap))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+
+	Context("CheckRecursiveMembership", func() {
+		It("should be same queries", func() {
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE visible AS NOT MATERIALIZED (SELECT entity_id, subject_type, subject_id, subject_relation FROM relation_tuples WHERE entity_type = $1 AND relation = $2 AND tenant_id = $3 AND (pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = '4'::xid8)) = true OR created_tx_id = '4'::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = '4'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '4'::xid8)),`)).
+				WithArgs("group", "member", "noop", "1", "group", "member", int32(20), int32(20), "user", "u1", "", int32(20)).
+				WillReturnRows(sqlmock.NewRows([]string{"allowed", "exhausted"}).AddRow(true, false))
+
+			allowed, err := relationshipReader.CheckRecursiveMembership(context.Background(), "noop", &base.Entity{Type: "group", Id: "1"}, "member", &base.Subject{Type: "user", Id: "u1"}, snap, 20)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(allowed).Should(BeTrue())
+		})
+
+		It("should fail when the members continue below the depth", func() {
+			snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE visible AS NOT MATERIALIZED`)).
+				WillReturnRows(sqlmock.NewRows([]string{"allowed", "exhausted"}).AddRow(false, true))
+
+			_, err := relationshipReader.CheckRecursiveMembership(context.Background(), "noop", &base.Entity{Type: "group", Id: "1"}, "member", &base.Subject{Type: "user", Id: "u1"}, snap, 2)
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String()))
+		})
+	})
+
+	Context("Read replicas", func() {
+		var replicaMock sqlmock.Sqlmock
+		var primary *postgres.Postgres
+
+		BeforeEach(func() {
+			primaryDB, primaryMock, err := sqlmock.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			mock = primaryMock
+
+			var replica *sql.DB
+			replica, replicaMock, err = sqlmock.New()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			primary = &postgres.Postgres{
+				DB:       primaryDB,
+				Replicas: []*sql.DB{replica},
+				Builder:  squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			}
+			postgres.ReplicaMaxLag(time.Minute)(primary)
+			relationshipReader = NewRelationshipReader(primary, signingKeys, logger.New("debug"))
+		})
+
+		AfterEach(func() {
+			Expect(replicaMock.ExpectationsWereMet()).ShouldNot(HaveOccurred())
+		})
+
+		snap := snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+		filter := &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}}
+
+		// sync - lets the primary and the replica report their positions, as the background tracker reads them
+		sync := func(xmin uint64, caughtUp bool) {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT pg_current_wal_lsn()::text`)).
+				WillReturnRows(sqlmock.NewRows([]string{"lsn"}).AddRow("0/16B3748"))
+			replicaMock.ExpectQuery(regexp.QuoteMeta(`SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint, COALESCE(pg_last_wal_replay_lsn() >= $1::pg_lsn, false)`)).
+				WithArgs("0/16B3748").
+				WillReturnRows(sqlmock.NewRows([]string{"xmin", "caught_up"}).AddRow(xmin, caughtUp))
+			primary.SyncReplicas(context.Background())
+		}
+
+		It("should read from the replica when the snapshot is replayed there", func() {
+			sync(5, false)
+			replicaMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM relation_tuples WHERE tenant_id = $1 AND entity_type = $2`)).
+				WithArgs("noop", "organization").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+			count, err := relationshipReader.CountRelationships(context.Background(), "noop", filter, snap)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(uint64(3)))
+		})
+
+		It("should fall back to the primary when the replica is behind", func() {
+			sync(4, true)
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM relation_tuples WHERE tenant_id = $1 AND entity_type = $2`)).
+				WithArgs("noop", "organization").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+			count, err := relationshipReader.CountRelationships(context.Background(), "noop", filter, snap)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(uint64(5)))
+		})
+
+		It("should fall back to the primary when the position of the replica is unknown", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM relation_tuples WHERE tenant_id = $1 AND entity_type = $2`)).
+				WithArgs("noop", "organization").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+			count, err := relationshipReader.CountRelationships(context.Background(), "noop", filter, snap)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(uint64(5)))
+		})
+
+		It("should list tenants from the replica only while it is in the lag bound", func() {
+			tenantReader := NewTenantReader(primary, logger.New("debug"))
+
+			sync(5, false)
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, created_at FROM tenants`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at"}).AddRow("t1", "t1", time.Now()))
+			tenants, _, err := tenantReader.ListTenants(context.Background(), database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenants).Should(HaveLen(1))
+
+			sync(5, true)
+			replicaMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, created_at FROM tenants`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at"}).AddRow("t1", "t1", time.Now()))
+			tenants, _, err = tenantReader.ListTenants(context.Background(), database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenants).Should(HaveLen(1))
+		})
+
+		It("should reject the snapshot of another tenant", func() {
+			_, err := relationshipReader.CountRelationships(context.Background(), "other", filter, snap)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
+		})
+
+		It("should read the head snapshot from the primary", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = $1 ORDER BY id DESC LIMIT 1`)).
+				WithArgs("noop").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(types.XID8{Uint: 9, Status: pgtype.Present}))
+
+			head, err := relationshipReader.HeadSnapshot(context.Background(), "noop")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head.Eg(snapshot.NewToken(signingKeys, "noop", types.XID8{Uint: 9, Status: pgtype.Present}))).Should(BeTrue())
+		})
+	})
 })
@@ -9,6 +9,7 @@ import (
 	"github.com/Masterminds/squirrel"
 	otelCodes "go.opentelemetry.io/otel/codes"
 
+	"permify/internal/repositories"
 	"permify/internal/repositories/postgres/snapshot"
 	"permify/internal/repositories/postgres/types"
 	"permify/internal/repositories/postgres/utils"
@@ -22,6 +23,8 @@ import (
 // RelationshipWriter - Structure for Relationship Writer
 type RelationshipWriter struct {
 	database *db.Postgres
+	// keys sign the snap tokens returned by the writer
+	keys *token.SigningKeys
 	// options
 	txOptions         sql.TxOptions
 	maxTuplesPerWrite int
@@ -31,9 +34,10 @@ type RelationshipWriter struct {
 }
 
 // NewRelationshipWriter - Creates a new RelationTupleReader
-func NewRelationshipWriter(database *db.Postgres, logger logger.Interface) *RelationshipWriter {
+func NewRelationshipWriter(database *db.Postgres, keys *token.SigningKeys, logger logger.Interface) *RelationshipWriter {
 	return &RelationshipWriter{
 		database:          database,
+		keys:              keys,
 		txOptions:         sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false},
 		maxTuplesPerWrite: _defaultMaxTuplesPerWrite,
 		maxRetries:        _defaultMaxRetries,
@@ -112,6 +116,13 @@ func (w *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 
+		if err = utils.Notify(ctx, tx, repositories.Change{TenantID: tenantID, Kind: repositories.RelationshipChange}); err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
 		if err = tx.Commit(); err != nil {
 			utils.Rollback(tx, w.logger)
 			span.RecordError(err)
@@ -119,7 +130,7 @@ func (w *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 
-		return snapshot.NewToken(xid).Encode(), nil
+		return snapshot.NewToken(w.keys, tenantID, xid).Encode(), nil
 	}
 
 	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
@@ -185,6 +196,13 @@ func (w *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID s
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 
+		if err = utils.Notify(ctx, tx, repositories.Change{TenantID: tenantID, Kind: repositories.RelationshipChange}); err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
 		if err = tx.Commit(); err != nil {
 			utils.Rollback(tx, w.logger)
 			span.RecordError(err)
@@ -192,7 +210,7 @@ func (w *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID s
 			return nil, err
 		}
 
-		return snapshot.NewToken(xid).Encode(), nil
+		return snapshot.NewToken(w.keys, tenantID, xid).Encode(), nil
 	}
 
 	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
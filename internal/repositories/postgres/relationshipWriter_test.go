@@ -34,7 +34,7 @@ var _ = Describe("RelationshipWriter", func() {
 			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 		}
 
-		relationshipWriter = NewRelationshipWriter(pg, l)
+		relationshipWriter = NewRelationshipWriter(pg, signingKeys, l)
 	})
 
 	AfterEach(func() {
@@ -9,7 +9,9 @@ import (
 	"go.opentelemetry.io/otel/codes"
 
 	"permify/internal/repositories"
+	"permify/internal/repositories/postgres/utils"
 	"permify/internal/schema"
+	"permify/pkg/database"
 	db "permify/pkg/database/postgres"
 	"permify/pkg/logger"
 	base "permify/pkg/pb/base/v1"
@@ -50,30 +52,21 @@ func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string)
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
 	}
 
-	var rows *sql.Rows
-	rows, err = r.database.DB.QueryContext(ctx, query, args...)
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-	}
-	defer rows.Close()
-
 	var definitions []string
-	for rows.Next() {
-		sd := repositories.SchemaDefinition{}
-		err = rows.Scan(&sd.EntityType, &sd.SerializedDefinition, &sd.Version)
+	for _, readDB := range utils.SchemaReadDBs(r.database) {
+		definitions, err = r.readDefinitions(ctx, readDB, query, args)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			return nil, err
 		}
-		definitions = append(definitions, sd.Serialized())
+		if len(definitions) > 0 {
+			break
+		}
 	}
-	if err = rows.Err(); err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+
+	if len(definitions) == 0 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
 	}
 
 	sch, err = schema.NewSchemaFromStringDefinitions(true, definitions...)
@@ -86,6 +79,25 @@ func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string)
 	return sch, err
 }
 
+// readDefinitions - Reads the serialized definitions the query selects from the database
+func (r *SchemaReader) readDefinitions(ctx context.Context, readDB *sql.DB, query string, args []interface{}) (definitions []string, err error) {
+	var rows *sql.Rows
+	rows, err = readDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		sd := repositories.SchemaDefinition{}
+		if err = rows.Scan(&sd.EntityType, &sd.SerializedDefinition, &sd.Version); err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, sd.Serialized())
+	}
+	return definitions, rows.Err()
+}
+
 // ReadSchemaDefinition - Reads entity config from the repository.
 func (r *SchemaReader) ReadSchemaDefinition(ctx context.Context, tenantID, entityType, version string) (definition *base.EntityDefinition, v string, err error) {
 	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-definition")
@@ -104,14 +116,19 @@ func (r *SchemaReader) ReadSchemaDefinition(ctx context.Context, tenantID, entit
 	}
 
 	var def repositories.SchemaDefinition
-	row := r.database.DB.QueryRowContext(ctx, query, args...)
-	if err = row.Err(); err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-	}
+	for _, readDB := range utils.SchemaReadDBs(r.database) {
+		row := readDB.QueryRowContext(ctx, query, args...)
+		if err = row.Err(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
 
-	if err = row.Scan(&def.EntityType, &def.SerializedDefinition, &def.Version); err != nil {
+		if err = row.Scan(&def.EntityType, &def.SerializedDefinition, &def.Version); err == nil || !errors.Is(err, sql.ErrNoRows) {
+			break
+		}
+	}
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
@@ -160,3 +177,67 @@ func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (versio
 
 	return version, nil
 }
+
+// ListSchemas - Lists the schema versions of the tenant, the newest first.
+func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.list-schemas")
+	defer span.End()
+
+	builder := r.database.Builder.Select("version").Distinct().From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		builder = builder.Where(squirrel.LtOrEq{"version": t.(utils.ContinuousToken).Value})
+	}
+
+	builder = builder.OrderBy("version DESC").Limit(uint64(pagination.PageSize() + 1))
+
+	var query string
+	var args []interface{}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	var lastVersion string
+	schemas = make([]*base.SchemaList, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		sv := repositories.SchemaVersion{}
+		err = rows.Scan(&sv.Version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		lastVersion = sv.Version
+		schemas = append(schemas, sv.ToSchemaList())
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	if len(schemas) > int(pagination.PageSize()) {
+		return schemas[:pagination.PageSize()], utils.NewContinuousToken(lastVersion).Encode(), nil
+	}
+
+	return schemas, utils.NewNoopContinuousToken().Encode(), nil
+}
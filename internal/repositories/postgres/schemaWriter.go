@@ -8,6 +8,7 @@ import (
 	otelCodes "go.opentelemetry.io/otel/codes"
 
 	"permify/internal/repositories"
+	"permify/internal/repositories/postgres/utils"
 	db "permify/pkg/database/postgres"
 	"permify/pkg/logger"
 	base "permify/pkg/pb/base/v1"
@@ -52,12 +53,41 @@ func (w *SchemaWriter) WriteSchema(ctx context.Context, schemas []repositories.S
 		return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
 	}
 
-	_, err = w.database.DB.ExecContext(ctx, query, args...)
+	var tx *sql.Tx
+	tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
 		return err
 	}
+	defer utils.Rollback(tx, w.logger)
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return err
+	}
+
+	// the listeners of the other instances invalidate the cached schemas of the tenants
+	notified := map[string]bool{}
+	for _, schema := range schemas {
+		if notified[schema.TenantID] {
+			continue
+		}
+		notified[schema.TenantID] = true
+		if err = utils.Notify(ctx, tx, repositories.Change{TenantID: schema.TenantID, Kind: repositories.SchemaChange}); err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
 
 	return nil
 }
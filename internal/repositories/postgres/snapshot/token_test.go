@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"permify/internal/repositories/postgres/types"
+	base "permify/pkg/pb/base/v1"
 	"permify/pkg/token"
 )
 
@@ -19,73 +20,68 @@ func TestToken(t *testing.T) {
 }
 
 var _ = Describe("token", func() {
+	var keys *token.SigningKeys
+
+	BeforeEach(func() {
+		var err error
+		keys, err = token.NewSigningKeys("secret")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
 	Context("Encode", func() {
 		It("Case 1: Success", func() {
-			tests := []struct {
-				target   token.SnapToken
-				expected string
-			}{
-				{NewToken(types.XID8{Uint: 4, Status: pgtype.Present}), "BAAAAAAAAAA="},
-				{NewToken(types.XID8{Uint: 12, Status: pgtype.Present}), "DAAAAAAAAAA="},
-				{NewToken(types.XID8{Uint: 43242, Status: pgtype.Present}), "6qgAAAAAAAA="},
-				{NewToken(types.XID8{Uint: 54342345, Status: pgtype.Present}), "yTI9AwAAAAA="},
-				{NewToken(types.XID8{Uint: 87648723472386, Status: pgtype.Present}), "AhAHT7dPAAA="},
-				{NewToken(types.XID8{Uint: 2349875239487420823, Status: pgtype.Present}), "lzkihBRvnCA="},
+			tests := []token.SnapToken{
+				NewToken(keys, "t1", types.XID8{Uint: 4, Status: pgtype.Present}),
+				NewToken(keys, "t1", types.XID8{Uint: 43242, Status: pgtype.Present}),
+				NewToken(keys, "t2", types.XID8{Uint: 87648723472386, Status: pgtype.Present}),
+				NewToken(keys, "t2", types.XID8{Uint: 2349875239487420823, Status: pgtype.Present}),
 			}
 
 			for _, tt := range tests {
-				Expect(tt.target.Encode().String()).Should(Equal(tt.expected))
+				encoded := tt.Encode()
+				t, err := EncodedToken{Keys: keys, TenantID: tt.(Token).TenantID, Value: encoded.String()}.Decode()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(t).Should(Equal(tt))
 			}
 		})
 
-		It("Case 2: Fail", func() {
-			tests := []struct {
-				target   token.SnapToken
-				expected string
-			}{
-				{NewToken(types.XID8{Uint: 4, Status: pgtype.Present}), " BAAAAAAAAAA="},
-			}
-
-			for _, tt := range tests {
-				Expect(tt.target.Encode().String()).ShouldNot(Equal(tt.expected))
-			}
+		It("Case 2: Tokens are bound to their tenant", func() {
+			Expect(NewToken(keys, "t1", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()).
+				ShouldNot(Equal(NewToken(keys, "t2", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()))
 		})
 	})
 
 	Context("Decode", func() {
-		It("Case 1: Success", func() {
-			tests := []struct {
-				target   token.EncodedSnapToken
-				expected token.SnapToken
-			}{
-				{EncodedToken{Value: "BAAAAAAAAAA="}, NewToken(types.XID8{Uint: 4, Status: pgtype.Present})},
-				{EncodedToken{Value: "DAAAAAAAAAA="}, NewToken(types.XID8{Uint: 12, Status: pgtype.Present})},
-				{EncodedToken{Value: "6qgAAAAAAAA="}, NewToken(types.XID8{Uint: 43242, Status: pgtype.Present})},
-				{EncodedToken{Value: "yTI9AwAAAAA="}, NewToken(types.XID8{Uint: 54342345, Status: pgtype.Present})},
-				{EncodedToken{Value: "AhAHT7dPAAA="}, NewToken(types.XID8{Uint: 87648723472386, Status: pgtype.Present})},
-				{EncodedToken{Value: "lzkihBRvnCA="}, NewToken(types.XID8{Uint: 2349875239487420823, Status: pgtype.Present})},
+		It("Case 1: Fail", func() {
+			encoded := NewToken(keys, "t1", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
+
+			tests := []EncodedToken{
+				// token of another tenant
+				{Keys: keys, TenantID: "t2", Value: encoded},
+				// unsigned token of the previous format
+				{Keys: keys, TenantID: "t1", Value: "BAAAAAAAAAA="},
+				{Keys: keys, TenantID: "t1", Value: "BAAAaAAAAAA="},
 			}
 
 			for _, tt := range tests {
-				t, err := tt.target.Decode()
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(t).Should(Equal(tt.expected))
+				_, err := tt.Decode()
+				Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
 			}
 		})
 
-		It("Case 2: Fail", func() {
-			tests := []struct {
-				target   token.EncodedSnapToken
-				expected token.SnapToken
-			}{
-				{EncodedToken{Value: "BAAAaAAAAAA="}, Token{Value: types.XID8{Uint: 4, Status: pgtype.Present}}},
-			}
+		It("Case 2: Tokens signed with a rotated key", func() {
+			encoded := NewToken(keys, "t1", types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String()
 
-			for _, tt := range tests {
-				t, err := tt.target.Decode()
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(t).ShouldNot(Equal(tt.expected))
-			}
+			rotated, err := token.NewSigningKeys("new-secret", "secret")
+			Expect(err).ShouldNot(HaveOccurred())
+			t, err := EncodedToken{Keys: rotated, TenantID: "t1", Value: encoded}.Decode()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(t.(Token).Value).Should(Equal(types.XID8{Uint: 4, Status: pgtype.Present}))
+
+			other, err := token.NewSigningKeys("other-secret")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = EncodedToken{Keys: other, TenantID: "t1", Value: encoded}.Decode()
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
 		})
 	})
 })
@@ -63,7 +63,8 @@ func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagi
 	}
 
 	var rows *sql.Rows
-	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	// tenants are not bound to snapshots, they are listed from a replica that is at most the maximum replica lag behind
+	rows, err = utils.ReadDB(r.database).QueryContext(ctx, query, args...)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
@@ -9,6 +10,8 @@ import (
 
 	"github.com/Masterminds/squirrel"
 
+	"permify/internal/repositories"
+	db "permify/pkg/database/postgres"
 	"permify/pkg/logger"
 )
 
@@ -26,17 +29,63 @@ func SnapshotQuery(sl squirrel.SelectBuilder, revision uint64) squirrel.SelectBu
 	})
 }
 
-// GarbageCollectQuery -
-func GarbageCollectQuery(window time.Duration, tenantID string) squirrel.DeleteBuilder {
-	return squirrel.Delete("relation_tuples").
-		Where(squirrel.Expr(fmt.Sprintf("created_tx_id IN (SELECT id FROM transactions WHERE timestamp < '%v')", time.Now().Add(-window).Format(time.RFC3339)))).
-		Where(squirrel.And{
-			squirrel.Or{
-				squirrel.Expr("expired_tx_id = '0'::xid8"),
-				squirrel.Expr(fmt.Sprintf("expired_tx_id IN (SELECT id FROM transactions WHERE timestamp < '%v')", time.Now().Add(-window).Format(time.RFC3339))),
-			},
-			squirrel.Expr(fmt.Sprintf("tenant_id = '%v'", tenantID))})
+// SnapshotReadDB - Returns a read replica that has replayed the transaction of the revision, the primary if there
+// are no replicas or none of them has replayed it yet. Replicas are chosen by the replay positions their tracker
+// reads in the background, and a replica does not go back from a position, so the read sees the revision on any
+// of its connections.
+func SnapshotReadDB(database *db.Postgres, revision uint64) *sql.DB {
+	if replica := database.ReplicaAt(revision); replica != nil {
+		return replica
+	}
+	return database.DB
+}
+
+// ReadDB - Returns a read replica that is behind the primary by at most the maximum replica lag, for the reads
+// that are not bound to a snapshot. The primary is returned if there are no replicas or none of them is that fresh.
+func ReadDB(database *db.Postgres) *sql.DB {
+	if replica := database.FreshReplica(); replica != nil {
+		return replica
+	}
+	return database.DB
+}
+
+// SchemaReadDBs - Returns the databases a schema version is looked up in, in order. Versions do not change once
+// they are written, so a fresh read replica is tried first and the primary when the version is not replayed there.
+func SchemaReadDBs(database *db.Postgres) []*sql.DB {
+	if replica := database.FreshReplica(); replica != nil {
+		return []*sql.DB{replica, database.DB}
+	}
+	return []*sql.DB{database.DB}
+}
 
+// GarbageCollectCondition - Matches the tuples of a tenant that were expired before the cutoff. They are not
+// visible to the snapshots that are younger than the cutoff anymore.
+func GarbageCollectCondition(cutoff time.Time, tenantID string) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{"tenant_id": tenantID},
+		squirrel.Expr("expired_tx_id <> '0'::xid8"),
+		squirrel.Expr("expired_tx_id IN (SELECT id FROM transactions WHERE tenant_id = ? AND timestamp < ?)", tenantID, cutoff.UTC()),
+	}
+}
+
+// TransactionsGarbageCollectCondition - Matches the transactions of a tenant that were recorded before the cutoff,
+// except the latest one which is the head snapshot of the tenant.
+func TransactionsGarbageCollectCondition(cutoff time.Time, tenantID string) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{"tenant_id": tenantID},
+		squirrel.Lt{"timestamp": cutoff.UTC()},
+		squirrel.Expr("id <> (SELECT id FROM transactions WHERE tenant_id = ? ORDER BY id DESC LIMIT 1)", tenantID),
+	}
+}
+
+// Notify - Publishes the change to the listeners of the changes channel, it is delivered when the transaction commits
+func Notify(ctx context.Context, tx *sql.Tx, change repositories.Change) error {
+	payload, err := change.Encode()
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", repositories.ChangesChannel, payload)
+	return err
 }
 
 // Rollback - Rollbacks a transaction and logs the error
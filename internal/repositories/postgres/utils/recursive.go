@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+// RecursiveMembershipQuery - Walks a self-referential relation from the entity with a recursive query. The members
+// are the entities reachable through subjects of the same type and relation, with the level they are first reached at
+// per path, descending at most depth levels. The query returns whether the subject is a subject of the relation of a
+// member above depth, the way the check engine would find it, and whether there are members at depth, which the check
+// engine could not evaluate anymore.
+func RecursiveMembershipQuery(tenantID string, entity *base.Entity, relation string, subject *base.Subject, revision uint64, depth int32) (string, []interface{}, error) {
+	visible, visibleArgs, err := SnapshotQuery(squirrel.Select("entity_id, subject_type, subject_id, subject_relation").From("relation_tuples").Where(squirrel.Eq{
+		"tenant_id":   tenantID,
+		"entity_type": entity.GetType(),
+		"relation":    relation,
+	}), revision).ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	// the visible tuples are inlined into both of their references rather than materialized for the whole relation,
+	// the start of the walk is cast to the type of the ids, recursive queries need the same types in both of their terms
+	query := fmt.Sprintf(`WITH RECURSIVE visible AS NOT MATERIALIZED (%s),
+members (entity_id, depth) AS (
+SELECT ?::varchar, 0
+UNION
+SELECT visible.subject_id, members.depth + 1 FROM members JOIN visible ON visible.entity_id = members.entity_id
+WHERE visible.subject_type = ? AND visible.subject_relation = ? AND members.depth < ?
+)
+SELECT EXISTS (SELECT 1 FROM members JOIN visible ON visible.entity_id = members.entity_id WHERE members.depth < ? AND visible.subject_type = ? AND visible.subject_id = ? AND visible.subject_relation = ?),
+EXISTS (SELECT 1 FROM members WHERE members.depth = ?)`, visible)
+
+	args := append(visibleArgs,
+		entity.GetId(),
+		entity.GetType(), relation, depth,
+		depth, subject.GetType(), subject.GetId(), subject.GetRelation(),
+		depth,
+	)
+
+	query, err = squirrel.Dollar.ReplacePlaceholders(query)
+	if err != nil {
+		return "", nil, err
+	}
+	return query, args, nil
+}
@@ -0,0 +1,356 @@
+// Package repositorytest provides the specs every database engine of the repositories has to pass. An engine
+// registers them in its own ginkgo suite with DescribeRepositories.
+package repositorytest
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rs/xid"
+
+	"permify/internal/repositories"
+	"permify/pkg/database"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+	"permify/pkg/tuple"
+)
+
+// Repositories - Structure for the repositories of the engine under test
+type Repositories struct {
+	RelationshipReader repositories.RelationshipReader
+	RelationshipWriter repositories.RelationshipWriter
+	SchemaReader       repositories.SchemaReader
+	SchemaWriter       repositories.SchemaWriter
+	TenantReader       repositories.TenantReader
+	TenantWriter       repositories.TenantWriter
+}
+
+// Options - Structure for the capabilities of the engine under test
+type Options struct {
+	// Snapshots - relationships are read at the snapshot they are asked for, and writes return increasing snapshots
+	Snapshots bool
+}
+
+// DescribeRepositories registers the shared specs for an engine. The setup function is called before each spec, every spec
+// works in a tenant of its own, so the engine does not need to be emptied between them.
+func DescribeRepositories(engine string, setup func() Repositories, options Options) bool {
+	return Describe(engine+" repositories", func() {
+		var repos Repositories
+		var tenantID string
+		ctx := context.Background()
+
+		BeforeEach(func() {
+			repos = setup()
+			tenantID = xid.New().String()
+		})
+
+		write := func(tuples ...string) token.EncodedSnapToken {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			snap, err := repos.RelationshipWriter.WriteRelationships(ctx, tenantID, collection)
+			Expect(err).ShouldNot(HaveOccurred())
+			return snap
+		}
+
+		head := func() string {
+			snap, err := repos.RelationshipReader.HeadSnapshot(ctx, tenantID)
+			Expect(err).ShouldNot(HaveOccurred())
+			return snap.Encode().String()
+		}
+
+		query := func(filter *base.TupleFilter, snap string) []string {
+			it, err := repos.RelationshipReader.QueryRelationships(ctx, tenantID, filter, snap)
+			Expect(err).ShouldNot(HaveOccurred())
+			var tuples []string
+			for it.HasNext() {
+				tuples = append(tuples, tuple.ToString(it.GetNext()))
+			}
+			return tuples
+		}
+
+		Context("Relationships", func() {
+			It("should query the written relationships by filter", func() {
+				write(
+					"organization:1#admin@user:1",
+					"organization:1#member@user:2",
+					"organization:2#admin@user:1",
+					"repository:1#parent@organization:1#...",
+					"repository:1#owner@team:1#member",
+				)
+
+				Expect(query(&base.TupleFilter{
+					Entity: &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+				}, head())).Should(ConsistOf(
+					"organization:1#admin@user:1",
+					"organization:1#member@user:2",
+				))
+
+				Expect(query(&base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: "organization"},
+					Relation: "admin",
+					Subject:  &base.SubjectFilter{Type: "user", Ids: []string{"1"}},
+				}, head())).Should(ConsistOf(
+					"organization:1#admin@user:1",
+					"organization:2#admin@user:1",
+				))
+
+				Expect(query(&base.TupleFilter{
+					Entity:  &base.EntityFilter{Type: "repository", Ids: []string{"1"}},
+					Subject: &base.SubjectFilter{Type: "team", Relation: "member"},
+				}, head())).Should(ConsistOf(
+					"repository:1#owner@team:1#member",
+				))
+			})
+
+			It("should not see the relationships of other tenants", func() {
+				write("organization:1#admin@user:1")
+
+				other := xid.New().String()
+				st, err := repos.RelationshipReader.HeadSnapshot(ctx, other)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				it, err := repos.RelationshipReader.QueryRelationships(ctx, other, &base.TupleFilter{
+					Entity: &base.EntityFilter{Type: "organization"},
+				}, st.Encode().String())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(it.HasNext()).Should(BeFalse())
+			})
+
+			It("should read the relationships page by page", func() {
+				write(
+					"organization:1#member@user:1",
+					"organization:1#member@user:2",
+					"organization:1#member@user:3",
+					"organization:1#member@user:4",
+					"organization:1#member@user:5",
+				)
+
+				filter := &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization", Ids: []string{"1"}}, Relation: "member"}
+				snap := head()
+
+				var pages [][]string
+				var ct string
+				for {
+					collection, next, err := repos.RelationshipReader.ReadRelationships(ctx, tenantID, filter, snap, database.NewPagination(database.Size(2), database.Token(ct)))
+					Expect(err).ShouldNot(HaveOccurred())
+
+					var page []string
+					for _, t := range collection.GetTuples() {
+						page = append(page, tuple.SubjectToString(t.GetSubject()))
+					}
+					pages = append(pages, page)
+
+					ct = next.String()
+					if ct == "" {
+						break
+					}
+				}
+
+				Expect(pages).Should(Equal([][]string{
+					{"user:1", "user:2"},
+					{"user:3", "user:4"},
+					{"user:5"},
+				}))
+			})
+
+			It("should delete the relationships matching the filter", func() {
+				write(
+					"organization:1#admin@user:1",
+					"organization:1#member@user:1",
+					"organization:1#member@user:2",
+				)
+
+				_, err := repos.RelationshipWriter.DeleteRelationships(ctx, tenantID, &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+					Relation: "member",
+					Subject:  &base.SubjectFilter{Type: "user", Ids: []string{"1"}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(query(&base.TupleFilter{
+					Entity: &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+				}, head())).Should(ConsistOf(
+					"organization:1#admin@user:1",
+					"organization:1#member@user:2",
+				))
+			})
+
+			It("should count the relationships", func() {
+				write(
+					"organization:1#admin@user:1",
+					"organization:1#member@user:1",
+					"organization:1#member@user:2",
+					"organization:2#member@user:1",
+					"repository:1#owner@user:1",
+				)
+
+				count, err := repos.RelationshipReader.CountRelationships(ctx, tenantID, &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: "organization"},
+					Relation: "member",
+				}, "")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(count).Should(Equal(uint64(3)))
+
+				stats, err := repos.RelationshipReader.RelationshipStats(ctx, tenantID, "", 1)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stats.Total()).Should(Equal(uint64(5)))
+				Expect(stats.Counts).Should(HaveLen(3))
+				Expect(stats.Counts[0].GetEntityType()).Should(Equal("organization"))
+				Expect(stats.Counts[0].GetRelation()).Should(Equal("admin"))
+				Expect(stats.Counts[1].GetCount()).Should(Equal(uint64(3)))
+				Expect(stats.TopEntities).Should(HaveLen(1))
+				Expect(tuple.EntityToString(stats.TopEntities[0].GetEntity())).Should(Equal("organization:1"))
+				Expect(stats.TopEntities[0].GetCount()).Should(Equal(uint64(2)))
+			})
+		})
+
+		Context("Snapshots", func() {
+			BeforeEach(func() {
+				if !options.Snapshots {
+					Skip(engine + " does not read at snapshots")
+				}
+			})
+
+			It("should return increasing snapshots", func() {
+				first, err := write("organization:1#admin@user:1").Decode()
+				Expect(err).ShouldNot(HaveOccurred())
+
+				second, err := repos.RelationshipWriter.DeleteRelationships(ctx, tenantID, &base.TupleFilter{
+					Entity: &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				st, err := second.Decode()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(st.Gt(first)).Should(BeTrue())
+
+				h, err := repos.RelationshipReader.HeadSnapshot(ctx, tenantID)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(h.Eg(st)).Should(BeTrue())
+			})
+
+			It("should read the relationships at a snapshot", func() {
+				filter := &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization", Ids: []string{"1"}}}
+
+				first := write("organization:1#admin@user:1")
+				second := write("organization:1#member@user:2")
+				third, err := repos.RelationshipWriter.DeleteRelationships(ctx, tenantID, &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+					Relation: "admin",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(query(filter, first.String())).Should(ConsistOf("organization:1#admin@user:1"))
+				Expect(query(filter, second.String())).Should(ConsistOf("organization:1#admin@user:1", "organization:1#member@user:2"))
+				Expect(query(filter, third.String())).Should(ConsistOf("organization:1#member@user:2"))
+
+				count, err := repos.RelationshipReader.CountRelationships(ctx, tenantID, filter, second.String())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(count).Should(Equal(uint64(2)))
+			})
+
+			It("should write a deleted relationship again", func() {
+				filter := &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization", Ids: []string{"1"}}}
+
+				write("organization:1#admin@user:1")
+				_, err := repos.RelationshipWriter.DeleteRelationships(ctx, tenantID, filter)
+				Expect(err).ShouldNot(HaveOccurred())
+				write("organization:1#admin@user:1")
+
+				Expect(query(filter, head())).Should(ConsistOf("organization:1#admin@user:1"))
+			})
+		})
+
+		Context("Schemas", func() {
+			definitions := func(version string, entities ...string) []repositories.SchemaDefinition {
+				var definitions []repositories.SchemaDefinition
+				for _, entity := range entities {
+					definitions = append(definitions, repositories.SchemaDefinition{
+						TenantID:             tenantID,
+						EntityType:           entity,
+						SerializedDefinition: []byte("entity " + entity + " {}"),
+						Version:              version,
+					})
+				}
+				return definitions
+			}
+
+			It("should read the written versions", func() {
+				v1 := xid.New().String()
+				Expect(repos.SchemaWriter.WriteSchema(ctx, definitions(v1, "user"))).Should(Succeed())
+				v2 := xid.New().String()
+				Expect(repos.SchemaWriter.WriteSchema(ctx, definitions(v2, "user", "organization"))).Should(Succeed())
+
+				version, err := repos.SchemaReader.HeadVersion(ctx, tenantID)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(version).Should(Equal(v2))
+
+				sch, err := repos.SchemaReader.ReadSchema(ctx, tenantID, v1)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(sch.GetEntityDefinitions()).Should(HaveLen(1))
+
+				sch, err = repos.SchemaReader.ReadSchema(ctx, tenantID, v2)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(sch.GetEntityDefinitions()).Should(HaveKey("organization"))
+
+				definition, v, err := repos.SchemaReader.ReadSchemaDefinition(ctx, tenantID, "organization", v2)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(definition.GetName()).Should(Equal("organization"))
+				Expect(v).Should(Equal(v2))
+
+				schemas, _, err := repos.SchemaReader.ListSchemas(ctx, tenantID, database.NewPagination(database.Size(10)))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(schemas).Should(HaveLen(2))
+				Expect(schemas[0].GetVersion()).Should(Equal(v2))
+				Expect(schemas[1].GetVersion()).Should(Equal(v1))
+			})
+
+			It("should not find the schema of a tenant without one", func() {
+				_, err := repos.SchemaReader.HeadVersion(ctx, tenantID)
+				Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+
+				_, err = repos.SchemaReader.ReadSchema(ctx, tenantID, xid.New().String())
+				Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+			})
+		})
+
+		Context("Tenants", func() {
+			It("should create, list and delete tenants", func() {
+				tenant, err := repos.TenantWriter.CreateTenant(ctx, tenantID, "example")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tenant.GetId()).Should(Equal(tenantID))
+
+				listed := func() bool {
+					var ct string
+					for {
+						tenants, next, err := repos.TenantReader.ListTenants(ctx, database.NewPagination(database.Size(100), database.Token(ct)))
+						Expect(err).ShouldNot(HaveOccurred())
+						for _, t := range tenants {
+							if t.GetId() == tenantID {
+								Expect(t.GetName()).Should(Equal("example"))
+								return true
+							}
+						}
+						ct = next.String()
+						if ct == "" {
+							return false
+						}
+					}
+				}
+				Expect(listed()).Should(BeTrue())
+
+				deleted, err := repos.TenantWriter.DeleteTenant(ctx, tenantID)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(deleted.GetName()).Should(Equal("example"))
+				Expect(deleted.GetCreatedAt().AsTime()).Should(BeTemporally("~", tenant.GetCreatedAt().AsTime(), time.Second))
+				Expect(listed()).Should(BeFalse())
+			})
+		})
+	})
+}
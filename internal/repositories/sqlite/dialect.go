@@ -0,0 +1,43 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"permify/internal/repositories/sqlrepo"
+	db "permify/pkg/database/sqlite"
+)
+
+// Dialect - SQLite dialect of the SQL repositories. Write transactions begin immediately and hold the lock of
+// the database until they end, so they are serialized without row locks.
+var Dialect = sqlrepo.Dialect{
+	WriteIsolation:         sql.LevelSerializable,
+	TenantIsolation:        sql.LevelSerializable,
+	IsSerializationFailure: IsSerializationFailure,
+	IsDuplicateEntry:       IsDuplicateEntry,
+}
+
+// NewDatabase - Creates the database of the SQL repositories from a SQLite instance
+func NewDatabase(database *db.SQLite) *sqlrepo.Database {
+	return &sqlrepo.Database{
+		DB:      database.DB,
+		Builder: database.Builder,
+		Dialect: Dialect,
+	}
+}
+
+// IsSerializationFailure - Checks if a statement failed because the database was locked by another connection
+// for longer than the busy timeout, and can be retried
+func IsSerializationFailure(err error) bool {
+	var e *sqlite.Error
+	return errors.As(err, &e) && (e.Code()&0xff == sqlite3.SQLITE_BUSY || e.Code()&0xff == sqlite3.SQLITE_LOCKED)
+}
+
+// IsDuplicateEntry - Checks if a statement failed because of a unique constraint
+func IsDuplicateEntry(err error) bool {
+	var e *sqlite.Error
+	return errors.As(err, &e) && (e.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || e.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY)
+}
@@ -0,0 +1,227 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/sync/errgroup"
+
+	"permify/internal/config"
+	"permify/internal/repositories"
+	"permify/internal/repositories/sqlite/utils"
+	"permify/internal/repositories/sqlrepo"
+	sqlrepoutils "permify/internal/repositories/sqlrepo/utils"
+	db "permify/pkg/database/sqlite"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+)
+
+// GarbageCollector - Structure for GarbageCollector
+type GarbageCollector struct {
+	database *db.SQLite
+	// options
+	txOptions sql.TxOptions
+	// logger
+	logger logger.Interface
+	// metrics of the collection passes
+	metrics *repositories.GarbageCollectionMetrics
+	// context to manage the collection loop and its cancellation
+	ctx    context.Context
+	cancel context.CancelFunc
+	// errgroup for the collection loop
+	g *errgroup.Group
+	// interval for garbage collection
+	interval time.Duration
+	// timeout for garbage collection
+	timeout time.Duration
+	// window for garbage collection
+	window time.Duration
+}
+
+// NewGarbageCollector creates a new GarbageCollector instance. Writes to sqlite are serialized,
+// so the tenants are collected one after another and the number of threads is not used.
+func NewGarbageCollector(ctx context.Context, db *db.SQLite, logger logger.Interface, cfg config.DatabaseGarbageCollection) *GarbageCollector {
+	ctx, cancel := context.WithCancel(ctx)
+	return &GarbageCollector{
+		g:         &errgroup.Group{},
+		interval:  cfg.Interval,
+		timeout:   cfg.Timeout,
+		window:    cfg.Window,
+		txOptions: sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false},
+		database:  db,
+		logger:    logger,
+		metrics:   repositories.NewGarbageCollectionMetrics(db.GetEngineType()),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Start runs the garbage collection every interval until the collector is stopped.
+func (c *GarbageCollector) Start() error {
+	c.g.Go(func() error {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				c.logger.Info("garbage collector stopped")
+				return nil
+			case <-ticker.C:
+				c.logger.Info("garbage collector started")
+				ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+				if _, err := c.Collect(ctx, repositories.GarbageCollectionOptions{}); err != nil {
+					c.logger.Error("garbage collector failed with error: " + err.Error())
+				}
+				cancel()
+			}
+		}
+	})
+
+	return nil
+}
+
+// Stop stops the collection loop.
+func (c *GarbageCollector) Stop() {
+	c.cancel()
+}
+
+// Wait waits for the collection loop to finish.
+// Returns an error if it encountered an error.
+func (c *GarbageCollector) Wait() error {
+	return c.g.Wait()
+}
+
+// Collect deletes the tuples that were expired before the window and the transactions that were recorded
+// before it, except the latest transaction of every tenant, and returns the rows removed per tenant. Snapshots
+// older than the window can not be read consistently afterwards. With dry run the rows are only counted.
+func (c *GarbageCollector) Collect(ctx context.Context, options repositories.GarbageCollectionOptions) (results []*base.GarbageCollectResult, err error) {
+	ctx, span := tracer.Start(ctx, "garbage-collector.collect")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		c.metrics.Record(ctx, options, results, time.Since(start), err)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}()
+
+	window := options.Window
+	if window == 0 {
+		window = c.window
+	}
+	cutoff := time.Now().Add(-window)
+
+	tenants := []string{options.TenantID}
+	if options.TenantID == "" {
+		tenants, err = c.getTenants(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results = make([]*base.GarbageCollectResult, 0, len(tenants))
+	for _, tenantID := range tenants {
+		var result *base.GarbageCollectResult
+		result, err = c.executeCollector(ctx, tenantID, cutoff, options.DryRun)
+		if err != nil {
+			c.logger.Error("garbage collector failed for tenant: " + tenantID + " with error: " + err.Error())
+			return nil, err
+		}
+		c.logger.Info("garbage collector finished for tenant: " + tenantID)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// getTenants - returns the ids of the tenants that have transactions
+func (c *GarbageCollector) getTenants(ctx context.Context) ([]string, error) {
+	query, args, err := c.database.Builder.Select("tenant_id").Distinct().From(sqlrepo.TransactionsTable).OrderBy("tenant_id").ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows *sql.Rows
+	rows, err = c.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tenants []string
+	for rows.Next() {
+		var tenantID string
+		if err = rows.Scan(&tenantID); err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenantID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+// executeCollector - collects the garbage of a tenant in a transaction, or counts it with dry run
+func (c *GarbageCollector) executeCollector(ctx context.Context, tenantID string, cutoff time.Time, dryRun bool) (*base.GarbageCollectResult, error) {
+	tx, err := c.database.DB.BeginTx(ctx, &c.txOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer sqlrepoutils.Rollback(tx, c.logger)
+
+	result := &base.GarbageCollectResult{TenantId: tenantID}
+
+	// the tuples go first, their condition refers to the transactions that are removed afterwards
+	for _, table := range []struct {
+		name      string
+		condition squirrel.Sqlizer
+		rows      *uint64
+	}{
+		{sqlrepo.RelationTuplesTable, utils.GarbageCollectCondition(cutoff, tenantID), &result.RelationTuples},
+		{sqlrepo.TransactionsTable, utils.TransactionsGarbageCollectCondition(cutoff, tenantID), &result.Transactions},
+	} {
+		var query string
+		var args []interface{}
+
+		if dryRun {
+			query, args, err = c.database.Builder.Select("COUNT(*)").From(table.name).Where(table.condition).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			if err = tx.QueryRowContext(ctx, query, args...).Scan(table.rows); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		query, args, err = c.database.Builder.Delete(table.name).Where(table.condition).ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		var res sql.Result
+		res, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		var affected int64
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		*table.rows = uint64(affected)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
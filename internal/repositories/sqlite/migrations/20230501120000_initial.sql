@@ -0,0 +1,48 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS relation_tuples (
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id        TEXT    NOT NULL,
+    entity_type      TEXT    NOT NULL,
+    entity_id        TEXT    NOT NULL,
+    relation         TEXT    NOT NULL,
+    subject_type     TEXT    NOT NULL,
+    subject_id       TEXT    NOT NULL,
+    subject_relation TEXT    NOT NULL DEFAULT '',
+    created_tx_id    INTEGER NOT NULL,
+    expired_tx_id    INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT uq_relation_tuple_not_expired UNIQUE (tenant_id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation, expired_tx_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tuples_subject ON relation_tuples (tenant_id, subject_type, subject_id, subject_relation, entity_type, relation);
+CREATE INDEX IF NOT EXISTS idx_tuples_entity ON relation_tuples (tenant_id, entity_type, entity_id, relation);
+
+CREATE TABLE IF NOT EXISTS schema_definitions (
+    tenant_id             TEXT NOT NULL,
+    entity_type           TEXT NOT NULL,
+    serialized_definition BLOB NOT NULL,
+    version               TEXT NOT NULL,
+    CONSTRAINT pk_schema_definition PRIMARY KEY (tenant_id, entity_type, version)
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenant_id TEXT    NOT NULL,
+    timestamp INTEGER NOT NULL DEFAULT (CAST(strftime('%s', 'now') AS INTEGER))
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_tenant ON transactions (tenant_id, id);
+
+CREATE TABLE IF NOT EXISTS tenants (
+    id         TEXT     NOT NULL,
+    name       TEXT     NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_tenants PRIMARY KEY (id)
+);
+
+INSERT INTO tenants (id, name) VALUES ('t1', 'example tenant');
+
+-- +goose Down
+DROP TABLE IF EXISTS relation_tuples;
+DROP TABLE IF EXISTS schema_definitions;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS tenants;
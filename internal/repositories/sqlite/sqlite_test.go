@@ -0,0 +1,142 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/config"
+	"permify/internal/repositories"
+	"permify/internal/repositories/repositorytest"
+	"permify/internal/repositories/sqlrepo"
+	"permify/pkg/database"
+	db "permify/pkg/database/sqlite"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
+)
+
+func TestSQLite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sqlite-suite")
+}
+
+// instance is the database the specs share, it is created and migrated before them
+var instance *db.SQLite
+
+var _ = BeforeSuite(func() {
+	uri := filepath.Join(GinkgoT().TempDir(), "permify.db")
+
+	Expect(repositories.Migrate(config.Database{Engine: "sqlite", URI: uri}, logger.New("debug"))).Should(Succeed())
+
+	var err error
+	instance, err = db.New(uri)
+	Expect(err).ShouldNot(HaveOccurred())
+	DeferCleanup(instance.Close)
+})
+
+var _ = repositorytest.DescribeRepositories("sqlite", func() repositorytest.Repositories {
+	l := logger.New("debug")
+
+	return repositorytest.Repositories{
+		RelationshipReader: sqlrepo.NewRelationshipReader(NewDatabase(instance), l),
+		RelationshipWriter: sqlrepo.NewRelationshipWriter(NewDatabase(instance), l),
+		SchemaReader:       sqlrepo.NewSchemaReader(NewDatabase(instance), l),
+		SchemaWriter:       sqlrepo.NewSchemaWriter(NewDatabase(instance), l),
+		TenantReader:       sqlrepo.NewTenantReader(NewDatabase(instance), l),
+		TenantWriter:       sqlrepo.NewTenantWriter(NewDatabase(instance), l),
+	}
+}, repositorytest.Options{Snapshots: true})
+
+var _ = Describe("GarbageCollector", func() {
+	ctx := context.Background()
+	l := logger.New("debug")
+
+	rows := func(table, tenantID string) (count int) {
+		Expect(instance.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table+" WHERE tenant_id = ?", tenantID).Scan(&count)).Should(Succeed())
+		return count
+	}
+
+	It("should delete the tuples expired before the window", func() {
+		writer := sqlrepo.NewRelationshipWriter(NewDatabase(instance), l)
+		reader := sqlrepo.NewRelationshipReader(NewDatabase(instance), l)
+
+		for _, t := range []string{"organization:1#admin@user:1", "organization:1#member@user:2"} {
+			tup, err := tuple.Tuple(t)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = writer.WriteRelationships(ctx, "gc", database.NewTupleCollection(tup))
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		_, err := writer.DeleteRelationships(ctx, "gc", &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+			Relation: "admin",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		head, err := reader.HeadSnapshot(ctx, "gc")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// move the transactions an hour back
+		_, err = instance.DB.ExecContext(ctx, "UPDATE transactions SET timestamp = timestamp - 3600 WHERE tenant_id = ?", "gc")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(rows(sqlrepo.RelationTuplesTable, "gc")).Should(Equal(2))
+		Expect(rows(sqlrepo.TransactionsTable, "gc")).Should(Equal(3))
+
+		gc := NewGarbageCollector(ctx, instance, l, config.DatabaseGarbageCollection{Window: time.Minute})
+
+		results, err := gc.Collect(ctx, repositories.GarbageCollectionOptions{TenantID: "gc", DryRun: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).Should(HaveLen(1))
+		Expect(results[0].GetRelationTuples()).Should(Equal(uint64(1)))
+		Expect(results[0].GetTransactions()).Should(Equal(uint64(2)))
+
+		Expect(rows(sqlrepo.RelationTuplesTable, "gc")).Should(Equal(2))
+		Expect(rows(sqlrepo.TransactionsTable, "gc")).Should(Equal(3))
+
+		results, err = gc.Collect(ctx, repositories.GarbageCollectionOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, result := range results {
+			if result.GetTenantId() == "gc" {
+				Expect(result.GetRelationTuples()).Should(Equal(uint64(1)))
+				Expect(result.GetTransactions()).Should(Equal(uint64(2)))
+			}
+		}
+
+		Expect(rows(sqlrepo.RelationTuplesTable, "gc")).Should(Equal(1))
+		Expect(rows(sqlrepo.TransactionsTable, "gc")).Should(Equal(1))
+
+		h, err := reader.HeadSnapshot(ctx, "gc")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(h.Eg(head)).Should(BeTrue())
+
+		count, err := reader.CountRelationships(ctx, "gc", &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}}, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(count).Should(Equal(uint64(1)))
+	})
+
+	It("should keep the tuples expired in the window", func() {
+		writer := sqlrepo.NewRelationshipWriter(NewDatabase(instance), l)
+
+		tup, err := tuple.Tuple("organization:1#admin@user:1")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = writer.WriteRelationships(ctx, "gc-window", database.NewTupleCollection(tup))
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = writer.DeleteRelationships(ctx, "gc-window", &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		gc := NewGarbageCollector(ctx, instance, l, config.DatabaseGarbageCollection{Window: time.Minute})
+		results, err := gc.Collect(ctx, repositories.GarbageCollectionOptions{TenantID: "gc-window"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).Should(HaveLen(1))
+		Expect(results[0].GetRelationTuples()).Should(BeZero())
+		Expect(results[0].GetTransactions()).Should(BeZero())
+
+		Expect(rows(sqlrepo.RelationTuplesTable, "gc-window")).Should(Equal(1))
+		Expect(rows(sqlrepo.TransactionsTable, "gc-window")).Should(Equal(2))
+	})
+})
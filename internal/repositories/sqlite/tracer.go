@@ -0,0 +1,7 @@
+package sqlite
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("repositories.sqlite")
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// GarbageCollectCondition - Matches the tuples of a tenant that were expired before the cutoff. They are not
+// visible to the snapshots that are younger than the cutoff anymore.
+func GarbageCollectCondition(cutoff time.Time, tenantID string) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{"tenant_id": tenantID},
+		squirrel.NotEq{"expired_tx_id": 0},
+		squirrel.Expr("expired_tx_id IN (SELECT id FROM transactions WHERE tenant_id = ? AND timestamp < ?)", tenantID, cutoff.Unix()),
+	}
+}
+
+// TransactionsGarbageCollectCondition - Matches the transactions of a tenant that were recorded before the cutoff,
+// except the latest one which is the head snapshot of the tenant.
+func TransactionsGarbageCollectCondition(cutoff time.Time, tenantID string) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{"tenant_id": tenantID},
+		squirrel.Lt{"timestamp": cutoff.Unix()},
+		squirrel.Expr("id < (SELECT MAX(id) FROM transactions WHERE tenant_id = ?)", tenantID),
+	}
+}
@@ -0,0 +1,13 @@
+package sqlrepo
+
+const (
+	RelationTuplesTable   = "relation_tuples"
+	SchemaDefinitionTable = "schema_definitions"
+	TransactionsTable     = "transactions"
+	TenantsTable          = "tenants"
+)
+
+const (
+	_defaultMaxTuplesPerWrite = 100
+	_defaultMaxRetries        = 10
+)
@@ -0,0 +1,33 @@
+package sqlrepo
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Database - SQL database the repositories of this package run on. The tables of the engines are the same, the
+// behaviour that differs between them is given by their dialect.
+type Database struct {
+	DB      *sql.DB
+	Builder squirrel.StatementBuilderType
+	Dialect Dialect
+}
+
+// Dialect - Behaviour of a SQL engine the repositories depend on
+type Dialect struct {
+	// WriteIsolation - isolation level of the transactions that write relationships
+	WriteIsolation sql.IsolationLevel
+	// TenantIsolation - isolation level of the transactions that delete tenants
+	TenantIsolation sql.IsolationLevel
+	// LockSuffix - suffix of the selects that lock the rows they read, e.g. "FOR UPDATE". It is empty for the
+	// engines whose write transactions hold the lock of the whole database, those need no row locks.
+	LockSuffix string
+	// TimePrecision - precision of the timestamp columns, the times the repositories write are truncated to it
+	TimePrecision time.Duration
+	// IsSerializationFailure - checks if a statement failed because of a concurrent transaction and can be retried
+	IsSerializationFailure func(err error) bool
+	// IsDuplicateEntry - checks if a statement failed because of a unique constraint
+	IsDuplicateEntry func(err error) bool
+}
@@ -0,0 +1,540 @@
+package sqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"permify/internal/repositories"
+	"permify/internal/repositories/sqlrepo/snapshot"
+	"permify/internal/repositories/sqlrepo/utils"
+	"permify/pkg/database"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+)
+
+// RelationshipReader is a structure that holds information and dependencies
+// required for reading relationship data from the database.
+type RelationshipReader struct {
+	// database is a pointer to a SQL database instance, which is used
+	// to perform operations on the relationship data.
+	database *Database
+
+	// txOptions holds the configuration for database transactions, such as
+	// isolation level and read-only mode, to be applied when performing
+	// operations on the relationship data.
+	txOptions sql.TxOptions
+
+	// logger is an instance of a logger that implements the logger.Interface
+	// and is used to log messages related to the operations performed by
+	// the RelationshipReader.
+	logger logger.Interface
+}
+
+// NewRelationshipReader creates a new instance of the RelationshipReader struct
+// with the given database and logger instances. It also sets the default transaction
+// options for the RelationshipReader.
+//
+// Parameters:
+//   - database: A pointer to a SQL database instance, which will be used
+//     to perform operations on the relationship data.
+//   - logger:   An instance of a logger that implements the logger.Interface, which
+//     will be used to log messages related to the operations performed by
+//     the RelationshipReader.
+//
+// Returns:
+//   - A pointer to a new RelationshipReader instance, initialized with the given
+//     database and logger instances, and the default transaction options.
+func NewRelationshipReader(database *Database, logger logger.Interface) *RelationshipReader {
+	return &RelationshipReader{
+		database:  database,
+		txOptions: sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true},
+		logger:    logger,
+	}
+}
+
+// QueryRelationships retrieves relationships from the database based on a given filter,
+// tenant ID, and snapshot value. It returns a TupleIterator containing the filtered results.
+//
+// Parameters:
+//   - ctx:       The context used for tracing and cancellation.
+//   - tenantID:  The tenant ID for which the relationships should be queried.
+//   - filter:    A pointer to a TupleFilter struct that defines the filtering criteria
+//     for the relationships query.
+//   - snap:      A string representing the snapshot value to be used for the query.
+//
+// Returns:
+// - it:        A pointer to a TupleIterator containing the filtered relationships.
+// - err:       An error, if any occurred during the execution of the query.
+func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.query-relationships")
+	defer span.End()
+
+	// Decode the snapshot value.
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Begin a new read-only transaction with the specified isolation level.
+	var tx *sql.Tx
+	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Rollback the transaction in case of any error.
+	defer utils.Rollback(tx, r.logger)
+
+	// Build the relationships query based on the provided filter and snapshot value.
+	var args []interface{}
+	builder := r.database.Builder.Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value)
+
+	// Generate the SQL query and arguments.
+	var query string
+	query, args, err = builder.ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	// Execute the SQL query and retrieve the result rows.
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	// Process the result rows and store the relationships in a TupleCollection.
+	collection := database.NewTupleCollection()
+	for rows.Next() {
+		rt := repositories.RelationTuple{}
+		err = rows.Scan(&rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		collection.Add(rt.ToTuple())
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Commit the transaction.
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Return a TupleIterator created from the TupleCollection.
+	return collection.CreateTupleIterator(), nil
+}
+
+// ReadRelationships retrieves relationships from the database based on a given filter,
+// tenant ID, snapshot value, and pagination settings. It returns a TupleCollection
+// containing the filtered results and an encoded continuous token for pagination.
+//
+// Parameters:
+//   - ctx:        The context used for tracing and cancellation.
+//   - tenantID:   The tenant ID for which the relationships should be queried.
+//   - filter:     A pointer to a TupleFilter struct that defines the filtering criteria
+//     for the relationships query.
+//   - snap:       A string representing the snapshot value to be used for the query.
+//   - pagination: A Pagination struct containing the page size and token for the query.
+//
+// Returns:
+// - collection: A pointer to a TupleCollection containing the filtered relationships.
+// - ct:         An EncodedContinuousToken representing the next token for pagination.
+// - err:        An error, if any occurred during the execution of the query.
+func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.read-relationships")
+	defer span.End()
+
+	// Decode the snapshot value.
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	// Begin a new read-only transaction with the specified isolation level.
+	var tx *sql.Tx
+	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	// Rollback the transaction in case of any error.
+	defer utils.Rollback(tx, r.logger)
+
+	// Build the relationships query based on the provided filter, snapshot value, and pagination settings.
+	builder := r.database.Builder.Select("id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value)
+
+	// Apply the pagination token and limit to the query.
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		var v uint64
+		v, err = strconv.ParseUint(t.(utils.ContinuousToken).Value, 10, 64)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+		}
+		builder = builder.Where(squirrel.GtOrEq{"id": v})
+	}
+
+	builder = builder.OrderBy("id").Limit(uint64(pagination.PageSize() + 1))
+
+	// Generate the SQL query and arguments.
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, utils.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	// Execute the query and retrieve the rows.
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, utils.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	var lastID uint64
+
+	// Iterate through the rows and scan the result into a RelationTuple struct.
+	tuples := make([]*base.Tuple, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		rt := repositories.RelationTuple{}
+		err = rows.Scan(&rt.ID, &rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		lastID = rt.ID
+		tuples = append(tuples, rt.ToTuple())
+	}
+	// Check for any errors during iteration.
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	// Commit the transaction.
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	// Return the results and encoded continuous token for pagination.
+	if len(tuples) > int(pagination.PageSize()) {
+		return database.NewTupleCollection(tuples[:pagination.PageSize()]...), utils.NewContinuousToken(strconv.FormatUint(lastID, 10)).Encode(), nil
+	}
+
+	return database.NewTupleCollection(tuples...), utils.NewNoopContinuousToken().Encode(), nil
+}
+
+// HeadSnapshot retrieves the latest snapshot token for a given tenant ID.
+// It queries the transaction table to find the highest transaction ID associated with the tenant.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID for which the latest snapshot token should be retrieved.
+//
+// Returns:
+// - token.SnapToken: The latest snapshot token associated with the tenant.
+// - error:           An error, if any occurred during the execution of the query.
+func (r *RelationshipReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.head-snapshot")
+	defer span.End()
+
+	var id uint64
+
+	// Build the query to find the highest transaction ID associated with the tenant.
+	builder := r.database.Builder.Select("id").From(TransactionsTable).Where(squirrel.Eq{"tenant_id": tenantID}).OrderBy("id DESC").Limit(1)
+	query, args, err := builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	// Execute the query and retrieve the highest transaction ID.
+	row := r.database.DB.QueryRowContext(ctx, query, args...)
+	err = row.Scan(&id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		// If no rows are found, return a snapshot token with a value of 0.
+		if errors.Is(err, sql.ErrNoRows) {
+			return snapshot.Token{Value: 0}, nil
+		}
+		return nil, err
+	}
+
+	// Return the latest snapshot token associated with the tenant.
+	return snapshot.Token{Value: id}, nil
+}
+
+// CheckSnapshot verifies that a snap token sent by a client can be decoded.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID the snap token was issued for.
+// - snap:     The snap token sent by the client.
+//
+// Returns:
+// - error: ERROR_CODE_INVALID_SNAP_TOKEN if the token is invalid, nil otherwise.
+func (r *RelationshipReader) CheckSnapshot(ctx context.Context, tenantID, snap string) error {
+	// Start a new trace span and end it when the function exits.
+	_, span := tracer.Start(ctx, "relationship-reader.check-snapshot")
+	defer span.End()
+
+	// Decode the snapshot value.
+	if _, err := (snapshot.EncodedToken{Value: snap}).Decode(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// RelationshipStats counts the live relation tuples of a tenant at a snapshot with aggregate queries,
+// grouped by entity type, relation and subject type, along with the entity relations that have the
+// most subjects.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID for which the relationships should be counted.
+// - snap:     A string representing the snapshot value to be used, the head snapshot if empty.
+// - top:      The number of entity relations with the largest fan-out to return.
+//
+// Returns:
+// - stats:    A pointer to a RelationshipStats struct containing the counts.
+// - err:      An error, if any occurred during the execution of the queries.
+func (r *RelationshipReader) RelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (stats *repositories.RelationshipStats, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.relationship-stats")
+	defer span.End()
+
+	// Resolve the head snapshot if no snapshot value is given.
+	var st token.SnapToken
+	if snap == "" {
+		st, err = r.HeadSnapshot(ctx, tenantID)
+	} else {
+		st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Begin a new read-only transaction so both queries see the same data.
+	var tx *sql.Tx
+	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Rollback the transaction in case of any error.
+	defer utils.Rollback(tx, r.logger)
+
+	stats = &repositories.RelationshipStats{
+		Counts:      []*base.RelationshipCount{},
+		TopEntities: []*base.RelationshipFanOut{},
+		SnapToken:   st.Encode().String(),
+	}
+
+	// Count the tuples grouped by entity type, relation and subject type.
+	builder := r.database.Builder.Select("entity_type, relation, subject_type, COUNT(*)").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value)
+	builder = builder.GroupBy("entity_type", "relation", "subject_type").OrderBy("entity_type", "relation", "subject_type")
+
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c := &base.RelationshipCount{}
+		err = rows.Scan(&c.EntityType, &c.Relation, &c.SubjectType, &c.Count)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		stats.Counts = append(stats.Counts, c)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Find the entity relations with the most subjects.
+	if top > 0 {
+		builder = r.database.Builder.Select("entity_type, entity_id, relation, COUNT(*) AS fan_out").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+		builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value)
+		builder = builder.GroupBy("entity_type", "entity_id", "relation").OrderBy("fan_out DESC", "entity_type", "entity_id", "relation").Limit(uint64(top))
+
+		query, args, err = builder.ToSql()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+
+		var fanOutRows *sql.Rows
+		fanOutRows, err = tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		defer fanOutRows.Close()
+
+		for fanOutRows.Next() {
+			f := &base.RelationshipFanOut{Entity: &base.Entity{}}
+			err = fanOutRows.Scan(&f.Entity.Type, &f.Entity.Id, &f.Relation, &f.Count)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return nil, err
+			}
+			stats.TopEntities = append(stats.TopEntities, f)
+		}
+		if err = fanOutRows.Err(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	// Commit the transaction.
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// CountRelationships counts the live relation tuples of a tenant that match a filter at a snapshot
+// with a single aggregate query.
+//
+// Parameters:
+// - ctx:      The context used for tracing and cancellation.
+// - tenantID: The tenant ID for which the relationships should be counted.
+// - filter:   A pointer to a TupleFilter struct specifying the filter criteria for the query.
+// - snap:     A string representing the snapshot value to be used, the head snapshot if empty.
+//
+// Returns:
+// - count:    The number of matching relation tuples.
+// - err:      An error, if any occurred during the execution of the query.
+func (r *RelationshipReader) CountRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (count uint64, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "relationship-reader.count-relationships")
+	defer span.End()
+
+	// Resolve the head snapshot if no snapshot value is given.
+	var st token.SnapToken
+	if snap == "" {
+		st, err = r.HeadSnapshot(ctx, tenantID)
+	} else {
+		st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+
+	// Build the count query based on the provided filter and snapshot value.
+	builder := r.database.Builder.Select("COUNT(*)").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value)
+
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	// Execute the query and scan the count.
+	row := r.database.DB.QueryRowContext(ctx, query, args...)
+	if err = row.Scan(&count); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+	}
+
+	return count, nil
+}
@@ -0,0 +1,221 @@
+package sqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	otelCodes "go.opentelemetry.io/otel/codes"
+
+	"permify/internal/repositories/sqlrepo/snapshot"
+	"permify/internal/repositories/sqlrepo/utils"
+	"permify/pkg/database"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+)
+
+// RelationshipWriter - Structure for Relationship Writer
+type RelationshipWriter struct {
+	database *Database
+	// options
+	txOptions         sql.TxOptions
+	maxTuplesPerWrite int
+	maxRetries        int
+	// logger
+	logger logger.Interface
+}
+
+// NewRelationshipWriter - Creates a new RelationshipWriter
+func NewRelationshipWriter(database *Database, logger logger.Interface) *RelationshipWriter {
+	return &RelationshipWriter{
+		database:          database,
+		txOptions:         sql.TxOptions{Isolation: database.Dialect.WriteIsolation, ReadOnly: false},
+		maxTuplesPerWrite: _defaultMaxTuplesPerWrite,
+		maxRetries:        _defaultMaxRetries,
+		logger:            logger,
+	}
+}
+
+// WriteRelationships - Writes a collection of relationships to the database
+func (w *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.write-relationships")
+	defer span.End()
+
+	if len(collection.GetTuples()) > w.maxTuplesPerWrite {
+		return nil, errors.New("max tuples per write exceeded")
+	}
+
+	for i := 0; i <= w.maxRetries; i++ {
+		var tx *sql.Tx
+		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+
+		var id uint64
+		id, err = w.begin(ctx, tx, tenantID)
+		if err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if w.database.Dialect.IsSerializationFailure(err) {
+				continue
+			}
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
+		insertBuilder := w.database.Builder.Insert(RelationTuplesTable).Columns("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id, created_tx_id")
+
+		iter := collection.CreateTupleIterator()
+		for iter.HasNext() {
+			t := iter.GetNext()
+			insertBuilder = insertBuilder.Values(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation(), t.GetSubject().GetType(), t.GetSubject().GetId(), t.GetSubject().GetRelation(), tenantID, id)
+		}
+
+		var query string
+		var args []interface{}
+
+		query, args, err = insertBuilder.ToSql()
+		if err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if w.database.Dialect.IsSerializationFailure(err) {
+				continue
+			} else if w.database.Dialect.IsDuplicateEntry(err) {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
+			} else {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			}
+		}
+
+		if err = tx.Commit(); err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
+		return snapshot.NewToken(id).Encode(), nil
+	}
+
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// DeleteRelationships - Deletes a collection of relationships to the database
+func (w *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.delete-relationships")
+	defer span.End()
+
+	for i := 0; i <= w.maxRetries; i++ {
+		var tx *sql.Tx
+		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+
+		var id uint64
+		id, err = w.begin(ctx, tx, tenantID)
+		if err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if w.database.Dialect.IsSerializationFailure(err) {
+				continue
+			}
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
+		builder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", id).Where(squirrel.Eq{"tenant_id": tenantID, "expired_tx_id": 0})
+		builder = utils.FilterQueryForUpdateBuilder(builder, filter)
+
+		var query string
+		var args []interface{}
+
+		query, args, err = builder.ToSql()
+		if err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if w.database.Dialect.IsSerializationFailure(err) {
+				continue
+			} else {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			}
+		}
+
+		if err = tx.Commit(); err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+
+		return snapshot.NewToken(id).Encode(), nil
+	}
+
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// begin - Records a new transaction of the tenant and returns its id, which is the snapshot of the write. On the
+// engines with row locks the latest transaction of the tenant is locked first, so the writes of a tenant are
+// serialized and their ids increase in the order they are committed. Concurrent first writes of a tenant have
+// nothing to lock, one of them fails with a deadlock and is retried. On the others the write transactions hold
+// the lock of the database until they end, which orders them the same way.
+func (w *RelationshipWriter) begin(ctx context.Context, tx *sql.Tx, tenantID string) (id uint64, err error) {
+	var query string
+	var args []interface{}
+
+	if w.database.Dialect.LockSuffix != "" {
+		query, args, err = w.database.Builder.Select("id").From(TransactionsTable).Where(squirrel.Eq{"tenant_id": tenantID}).OrderBy("id DESC").Limit(1).Suffix(w.database.Dialect.LockSuffix).ToSql()
+		if err != nil {
+			return 0, err
+		}
+
+		var head uint64
+		if err = tx.QueryRowContext(ctx, query, args...).Scan(&head); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+	}
+
+	query, args, err = w.database.Builder.Insert(TransactionsTable).Columns("tenant_id").Values(tenantID).ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var result sql.Result
+	result, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	var lastInsertID int64
+	lastInsertID, err = result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(lastInsertID), nil
+}
@@ -0,0 +1,72 @@
+package snapshot
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/pkg/token"
+)
+
+// TestToken -
+func TestToken(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "token-suite")
+}
+
+var _ = Describe("token", func() {
+	Context("Encode", func() {
+		It("Case 1: Success", func() {
+			tests := []struct {
+				target   token.SnapToken
+				expected string
+			}{
+				{NewToken(0), "AAAAAAAAAAA="},
+				{NewToken(4), "BAAAAAAAAAA="},
+				{NewToken(43242), "6qgAAAAAAAA="},
+				{NewToken(2349875239487420823), "lzkihBRvnCA="},
+			}
+
+			for _, tt := range tests {
+				Expect(tt.target.Encode().String()).Should(Equal(tt.expected))
+			}
+		})
+	})
+
+	Context("Decode", func() {
+		It("Case 1: Success", func() {
+			tests := []struct {
+				target   token.EncodedSnapToken
+				expected token.SnapToken
+			}{
+				{EncodedToken{Value: "AAAAAAAAAAA="}, NewToken(0)},
+				{EncodedToken{Value: "BAAAAAAAAAA="}, NewToken(4)},
+				{EncodedToken{Value: "6qgAAAAAAAA="}, NewToken(43242)},
+				{EncodedToken{Value: "lzkihBRvnCA="}, NewToken(2349875239487420823)},
+			}
+
+			for _, tt := range tests {
+				t, err := tt.target.Decode()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(t).Should(Equal(tt.expected))
+			}
+		})
+
+		It("Case 2: Fail", func() {
+			for _, value := range []string{"", "BAAA", "not base64"} {
+				_, err := EncodedToken{Value: value}.Decode()
+				Expect(err).Should(HaveOccurred())
+			}
+		})
+	})
+
+	Context("Compare", func() {
+		It("Case 1", func() {
+			Expect(NewToken(5).Gt(NewToken(4))).Should(BeTrue())
+			Expect(NewToken(4).Lt(NewToken(5))).Should(BeTrue())
+			Expect(NewToken(4).Eg(NewToken(4))).Should(BeTrue())
+			Expect(NewToken(4).Eg(NewToken(5))).Should(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package sqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"permify/internal/repositories/sqlrepo/utils"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+)
+
+// TenantWriter - Structure for Tenant Writer
+type TenantWriter struct {
+	database *Database
+	// options
+	txOptions sql.TxOptions
+	// logger
+	logger logger.Interface
+}
+
+// NewTenantWriter - Creates a new TenantWriter
+func NewTenantWriter(database *Database, logger logger.Interface) *TenantWriter {
+	return &TenantWriter{
+		database:  database,
+		txOptions: sql.TxOptions{Isolation: database.Dialect.TenantIsolation, ReadOnly: false},
+		logger:    logger,
+	}
+}
+
+// CreateTenant - Creates a new Tenant. Not every engine can return the inserted row, so the creation time is
+// set here, in the precision of the created_at column.
+func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.create-tenant")
+	defer span.End()
+
+	createdAt := time.Now().UTC().Truncate(w.database.Dialect.TimePrecision)
+
+	query := w.database.Builder.Insert(TenantsTable).Columns("id, name, created_at").Values(id, name, createdAt).RunWith(w.database.DB)
+
+	_, err = query.ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if w.database.Dialect.IsDuplicateEntry(err) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
+		}
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return &base.Tenant{
+		Id:        id,
+		Name:      name,
+		CreatedAt: timestamppb.New(createdAt),
+	}, nil
+}
+
+// DeleteTenant - Deletes a Tenant. The tenant is read before it is deleted, in the same transaction.
+func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
+	defer span.End()
+
+	var tx *sql.Tx
+	tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+
+	defer utils.Rollback(tx, w.logger)
+
+	var name string
+	var createdAt time.Time
+
+	selectBuilder := w.database.Builder.Select("name, created_at").From(TenantsTable).Where(squirrel.Eq{"id": tenantID})
+	if w.database.Dialect.LockSuffix != "" {
+		selectBuilder = selectBuilder.Suffix(w.database.Dialect.LockSuffix)
+	}
+	err = selectBuilder.RunWith(tx).QueryRowContext(ctx).Scan(&name, &createdAt)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	deleteQuery := w.database.Builder.Delete(TenantsTable).Where(squirrel.Eq{"id": tenantID}).RunWith(tx)
+	_, err = deleteQuery.ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if err = tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return &base.Tenant{
+		Id:        tenantID,
+		Name:      name,
+		CreatedAt: timestamppb.New(createdAt),
+	}, nil
+}
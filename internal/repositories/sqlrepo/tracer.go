@@ -0,0 +1,7 @@
+package sqlrepo
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("repositories.sql")
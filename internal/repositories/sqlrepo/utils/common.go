@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+
+	"permify/pkg/logger"
+)
+
+// SnapshotQuery - Filters the tuples that are alive at the given revision. Transaction ids increase in the
+// commit order of the writes of a tenant, so a tuple is visible if it was created at or before the revision
+// and is not expired yet or expired after it.
+func SnapshotQuery(sl squirrel.SelectBuilder, revision uint64) squirrel.SelectBuilder {
+	return sl.Where(squirrel.LtOrEq{"created_tx_id": revision}).Where(squirrel.Or{
+		squirrel.Eq{"expired_tx_id": 0},
+		squirrel.Gt{"expired_tx_id": revision},
+	})
+}
+
+// Rollback - Rollbacks a transaction and logs the error
+func Rollback(tx *sql.Tx, logger logger.Interface) {
+	if err := tx.Rollback(); !errors.Is(err, sql.ErrTxDone) && err != nil {
+		logger.Error("failed to rollback transaction", err)
+	}
+}
@@ -0,0 +1,228 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+// Diff structurally compares two `SchemaDefinition`s and returns the changes that turn `from` into `to`.
+// Changes are ordered by entity name; within an entity the entity change comes first, then relation
+// changes and permission changes, each ordered by name. Members of added or removed entities are not listed separately.
+func Diff(from, to *base.SchemaDefinition) (changes []*base.SchemaChange) {
+	// Collect the names of the entities of both schemas
+	names := map[string]struct{}{}
+	for name := range from.GetEntityDefinitions() {
+		names[name] = struct{}{}
+	}
+	for name := range to.GetEntityDefinitions() {
+		names[name] = struct{}{}
+	}
+
+	for _, name := range sortedKeys(names) {
+		before, inFrom := from.GetEntityDefinitions()[name]
+		after, inTo := to.GetEntityDefinitions()[name]
+
+		switch {
+		case !inFrom:
+			changes = append(changes, &base.SchemaChange{Kind: base.SchemaChange_KIND_ENTITY_ADDED, Entity: name})
+		case !inTo:
+			changes = append(changes, &base.SchemaChange{Kind: base.SchemaChange_KIND_ENTITY_REMOVED, Entity: name})
+		default:
+			changes = append(changes, diffRelations(name, before, after)...)
+			changes = append(changes, diffPermissions(name, before, after)...)
+		}
+	}
+
+	return changes
+}
+
+// diffRelations compares the relations of two versions of the same entity.
+func diffRelations(entity string, from, to *base.EntityDefinition) (changes []*base.SchemaChange) {
+	names := map[string]struct{}{}
+	for name := range from.GetRelations() {
+		names[name] = struct{}{}
+	}
+	for name := range to.GetRelations() {
+		names[name] = struct{}{}
+	}
+
+	for _, name := range sortedKeys(names) {
+		before, inFrom := from.GetRelations()[name]
+		after, inTo := to.GetRelations()[name]
+
+		switch {
+		case !inFrom:
+			changes = append(changes, &base.SchemaChange{
+				Kind:   base.SchemaChange_KIND_RELATION_ADDED,
+				Entity: entity,
+				Name:   name,
+				After:  RelationReferencesToString(after.GetRelationReferences()),
+			})
+		case !inTo:
+			changes = append(changes, &base.SchemaChange{
+				Kind:   base.SchemaChange_KIND_RELATION_REMOVED,
+				Entity: entity,
+				Name:   name,
+				Before: RelationReferencesToString(before.GetRelationReferences()),
+			})
+		default:
+			b := RelationReferencesToString(before.GetRelationReferences())
+			a := RelationReferencesToString(after.GetRelationReferences())
+			if b != a {
+				changes = append(changes, &base.SchemaChange{
+					Kind:   base.SchemaChange_KIND_RELATION_TYPES_CHANGED,
+					Entity: entity,
+					Name:   name,
+					Before: b,
+					After:  a,
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
+// diffPermissions compares the permissions of two versions of the same entity.
+func diffPermissions(entity string, from, to *base.EntityDefinition) (changes []*base.SchemaChange) {
+	names := map[string]struct{}{}
+	for name := range from.GetPermissions() {
+		names[name] = struct{}{}
+	}
+	for name := range to.GetPermissions() {
+		names[name] = struct{}{}
+	}
+
+	for _, name := range sortedKeys(names) {
+		before, inFrom := from.GetPermissions()[name]
+		after, inTo := to.GetPermissions()[name]
+
+		switch {
+		case !inFrom:
+			changes = append(changes, &base.SchemaChange{
+				Kind:   base.SchemaChange_KIND_PERMISSION_ADDED,
+				Entity: entity,
+				Name:   name,
+				After:  ChildToString(after.GetChild()),
+			})
+		case !inTo:
+			changes = append(changes, &base.SchemaChange{
+				Kind:   base.SchemaChange_KIND_PERMISSION_REMOVED,
+				Entity: entity,
+				Name:   name,
+				Before: ChildToString(before.GetChild()),
+			})
+		default:
+			if !proto.Equal(before.GetChild(), after.GetChild()) {
+				changes = append(changes, &base.SchemaChange{
+					Kind:   base.SchemaChange_KIND_PERMISSION_CHANGED,
+					Entity: entity,
+					Name:   name,
+					Before: ChildToString(before.GetChild()),
+					After:  ChildToString(after.GetChild()),
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
+// DiffSummary renders the changes returned by Diff as human-readable text, one change per line.
+func DiffSummary(changes []*base.SchemaChange) string {
+	if len(changes) == 0 {
+		return "no changes"
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, c := range changes {
+		switch c.GetKind() {
+		case base.SchemaChange_KIND_ENTITY_ADDED:
+			lines = append(lines, fmt.Sprintf("+ entity %s", c.GetEntity()))
+		case base.SchemaChange_KIND_ENTITY_REMOVED:
+			lines = append(lines, fmt.Sprintf("- entity %s", c.GetEntity()))
+		case base.SchemaChange_KIND_RELATION_ADDED:
+			lines = append(lines, fmt.Sprintf("+ relation %s#%s %s", c.GetEntity(), c.GetName(), c.GetAfter()))
+		case base.SchemaChange_KIND_RELATION_REMOVED:
+			lines = append(lines, fmt.Sprintf("- relation %s#%s %s", c.GetEntity(), c.GetName(), c.GetBefore()))
+		case base.SchemaChange_KIND_RELATION_TYPES_CHANGED:
+			lines = append(lines, fmt.Sprintf("~ relation %s#%s %s -> %s", c.GetEntity(), c.GetName(), c.GetBefore(), c.GetAfter()))
+		case base.SchemaChange_KIND_PERMISSION_ADDED:
+			lines = append(lines, fmt.Sprintf("+ permission %s#%s = %s", c.GetEntity(), c.GetName(), c.GetAfter()))
+		case base.SchemaChange_KIND_PERMISSION_REMOVED:
+			lines = append(lines, fmt.Sprintf("- permission %s#%s = %s", c.GetEntity(), c.GetName(), c.GetBefore()))
+		case base.SchemaChange_KIND_PERMISSION_CHANGED:
+			lines = append(lines, fmt.Sprintf("~ permission %s#%s = %s -> %s", c.GetEntity(), c.GetName(), c.GetBefore(), c.GetAfter()))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// RelationReferencesToString renders relation references the way they are written in the DSL, e.g. "@user @organization#member".
+// References are sorted so that the result does not depend on their declaration order.
+func RelationReferencesToString(references []*base.RelationReference) string {
+	refs := make([]string, 0, len(references))
+	for _, ref := range references {
+		if ref.GetRelation() != "" {
+			refs = append(refs, fmt.Sprintf("@%s#%s", ref.GetType(), ref.GetRelation()))
+		} else {
+			refs = append(refs, fmt.Sprintf("@%s", ref.GetType()))
+		}
+	}
+	sort.Strings(refs)
+	return strings.Join(refs, " ")
+}
+
+// ChildToString renders a permission rewrite the way it is written in the DSL, e.g. "owner or (admin and not parent.banned)".
+func ChildToString(child *base.Child) string {
+	switch child.GetType().(type) {
+	case *base.Child_Leaf:
+		leaf := child.GetLeaf()
+		var s string
+		switch leaf.GetType().(type) {
+		case *base.Leaf_ComputedUserSet:
+			s = leaf.GetComputedUserSet().GetRelation()
+		case *base.Leaf_TupleToUserSet:
+			s = GetTupleToUserSetPath(leaf.GetTupleToUserSet())
+		}
+		if leaf.GetExclusion() {
+			return "not " + s
+		}
+		return s
+	case *base.Child_Rewrite:
+		rewrite := child.GetRewrite()
+		op := " or "
+		switch rewrite.GetRewriteOperation() {
+		case base.Rewrite_OPERATION_INTERSECTION:
+			op = " and "
+		case base.Rewrite_OPERATION_EXCLUSION:
+			op = " but not "
+		}
+		parts := make([]string, 0, len(rewrite.GetChildren()))
+		for _, c := range rewrite.GetChildren() {
+			if c.GetRewrite() != nil {
+				parts = append(parts, "("+ChildToString(c)+")")
+				continue
+			}
+			parts = append(parts, ChildToString(c))
+		}
+		return strings.Join(parts, op)
+	}
+	return ""
+}
+
+// sortedKeys returns the keys of a set in ascending order.
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
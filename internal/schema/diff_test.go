@@ -0,0 +1,106 @@
+package schema
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("diff", func() {
+	Context("Diff", func() {
+		It("Case 1: no changes", func() {
+			from, err := NewSchemaFromStringDefinitions(true, `entity user {}`, `entity doc { relation owner @user permission edit = owner }`)
+			Expect(err).ShouldNot(HaveOccurred())
+			to, err := NewSchemaFromStringDefinitions(true, `entity doc { relation owner @user permission edit = owner }`, `entity user {}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			changes := Diff(from, to)
+			Expect(changes).Should(BeEmpty())
+			Expect(DiffSummary(changes)).Should(Equal("no changes"))
+		})
+
+		It("Case 2: entities, relations and permissions", func() {
+			from, err := NewSchemaFromStringDefinitions(true,
+				`entity user {}`,
+				`entity team { relation member @user }`,
+				`entity organization {
+					relation admin @user
+					relation member @user
+					permission view = admin or member
+					permission delete = admin
+				}`,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			to, err := NewSchemaFromStringDefinitions(true,
+				`entity user {}`,
+				`entity group { relation member @user }`,
+				`entity organization {
+					relation admin @user
+					relation member @user @group#member
+					relation owner @user
+					permission view = (admin or owner) and not member
+					permission edit = admin
+				}`,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			changes := Diff(from, to)
+			Expect(changes).Should(HaveLen(7))
+
+			Expect(changes[0].GetKind()).Should(Equal(base.SchemaChange_KIND_ENTITY_ADDED))
+			Expect(changes[0].GetEntity()).Should(Equal("group"))
+
+			Expect(changes[1].GetKind()).Should(Equal(base.SchemaChange_KIND_RELATION_TYPES_CHANGED))
+			Expect(changes[1].GetName()).Should(Equal("member"))
+			Expect(changes[1].GetBefore()).Should(Equal("@user"))
+			Expect(changes[1].GetAfter()).Should(Equal("@group#member @user"))
+
+			Expect(changes[2].GetKind()).Should(Equal(base.SchemaChange_KIND_RELATION_ADDED))
+			Expect(changes[2].GetName()).Should(Equal("owner"))
+
+			Expect(changes[3].GetKind()).Should(Equal(base.SchemaChange_KIND_PERMISSION_REMOVED))
+			Expect(changes[3].GetName()).Should(Equal("delete"))
+
+			Expect(changes[4].GetKind()).Should(Equal(base.SchemaChange_KIND_PERMISSION_ADDED))
+			Expect(changes[4].GetName()).Should(Equal("edit"))
+
+			Expect(changes[5].GetKind()).Should(Equal(base.SchemaChange_KIND_PERMISSION_CHANGED))
+			Expect(changes[5].GetBefore()).Should(Equal("admin or member"))
+			Expect(changes[5].GetAfter()).Should(Equal("(admin or owner) and not member"))
+
+			Expect(changes[6].GetKind()).Should(Equal(base.SchemaChange_KIND_ENTITY_REMOVED))
+			Expect(changes[6].GetEntity()).Should(Equal("team"))
+
+			Expect(DiffSummary(changes)).Should(Equal(`+ entity group
+~ relation organization#member @user -> @group#member @user
++ relation organization#owner @user
+- permission organization#delete = admin
++ permission organization#edit = admin
+~ permission organization#view = admin or member -> (admin or owner) and not member
+- entity team`))
+		})
+
+		It("Case 3: tuple to userset", func() {
+			from, err := NewSchemaFromStringDefinitions(true,
+				`entity user {}`,
+				`entity organization { relation admin @user }`,
+				`entity repository { relation parent @organization relation owner @user permission edit = owner }`,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			to, err := NewSchemaFromStringDefinitions(true,
+				`entity user {}`,
+				`entity organization { relation admin @user }`,
+				`entity repository { relation parent @organization relation owner @user permission edit = owner or parent.admin }`,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			changes := Diff(from, to)
+			Expect(changes).Should(HaveLen(1))
+			Expect(changes[0].GetKind()).Should(Equal(base.SchemaChange_KIND_PERMISSION_CHANGED))
+			Expect(changes[0].GetAfter()).Should(Equal("owner or parent.admin"))
+		})
+	})
+})
@@ -207,43 +207,7 @@ func (g *LinkedSchemaGraph) findRelationEntrance(target, source *base.RelationRe
 func (g *LinkedSchemaGraph) findEntranceLeaf(target, source *base.RelationReference, leaf *base.Leaf, visited map[string]struct{}) ([]*LinkedEntrance, error) {
 	switch t := leaf.GetType().(type) {
 	case *base.Leaf_TupleToUserSet:
-		tupleSet := t.TupleToUserSet.GetTupleSet().GetRelation()
-		computedUserSet := t.TupleToUserSet.GetComputed().GetRelation()
-
-		var res []*LinkedEntrance
-		entityDefinitions, exists := g.schema.EntityDefinitions[target.GetType()]
-		if !exists {
-			return nil, errors.New("entity definition not found")
-		}
-
-		relations, exists := entityDefinitions.Relations[tupleSet]
-		if !exists {
-			return nil, errors.New("relation definition not found")
-		}
-
-		for _, rel := range relations.GetRelationReferences() {
-			if rel.GetType() == source.GetType() && source.GetRelation() == computedUserSet {
-				res = append(res, &LinkedEntrance{
-					Kind:             TupleToUserSetLinkedEntrance,
-					TargetEntrance:   target,
-					TupleSetRelation: tupleSet,
-				})
-			}
-
-			results, err := g.findEntrance(
-				&base.RelationReference{
-					Type:     rel.GetType(),
-					Relation: computedUserSet,
-				},
-				source,
-				visited,
-			)
-			if err != nil {
-				return nil, err
-			}
-			res = append(res, results...)
-		}
-		return res, nil
+		return g.findEntranceTupleToUserSet(target, source, t.TupleToUserSet, visited)
 	case *base.Leaf_ComputedUserSet:
 
 		var entrances []*LinkedEntrance
@@ -277,11 +241,76 @@ func (g *LinkedSchemaGraph) findEntranceLeaf(target, source *base.RelationRefere
 	}
 }
 
+// findEntranceTupleToUserSet is a helper function that searches the LinkedSchemaGraph for entry points that can be reached
+// from the specified target relation through a tuple-to-user-set. The subjects of the tuple set are entered with the computed
+// relation of the tuple-to-user-set. For a multi-hop path such as parent.organization.admin, the computed relation is the
+// dotted path of the next hops, organization.admin, which is not defined on the entity of the subjects; its entry points
+// are searched in the next tuple-to-user-set instead of the entity definition.
+//
+// Parameters:
+//   - target: pointer to a base.RelationReference that identifies the target relation
+//   - source: pointer to a base.RelationReference that identifies the source relation used to reach the target relation
+//   - ttu: pointer to a base.TupleToUserSet object that represents the child of an action reference
+//   - visited: map used to track visited nodes and avoid infinite recursion
+//
+// Returns:
+//   - slice of LinkedEntrance objects that represent entry points into the LinkedSchemaGraph, or an error if the target or
+//     source relation does not exist in the schema graph
+func (g *LinkedSchemaGraph) findEntranceTupleToUserSet(target, source *base.RelationReference, ttu *base.TupleToUserSet, visited map[string]struct{}) ([]*LinkedEntrance, error) {
+	tupleSet := ttu.GetTupleSet().GetRelation()
+	computedUserSet := GetTupleToUserSetComputedRelation(ttu)
+
+	var res []*LinkedEntrance
+	entityDefinitions, exists := g.schema.EntityDefinitions[target.GetType()]
+	if !exists {
+		return nil, errors.New("entity definition not found")
+	}
+
+	relations, exists := entityDefinitions.Relations[tupleSet]
+	if !exists {
+		return nil, errors.New("relation definition not found")
+	}
+
+	for _, rel := range relations.GetRelationReferences() {
+		if rel.GetType() == source.GetType() && source.GetRelation() == computedUserSet {
+			res = append(res, &LinkedEntrance{
+				Kind:             TupleToUserSetLinkedEntrance,
+				TargetEntrance:   target,
+				TupleSetRelation: tupleSet,
+			})
+		}
+
+		next := &base.RelationReference{
+			Type:     rel.GetType(),
+			Relation: computedUserSet,
+		}
+
+		var results []*LinkedEntrance
+		var err error
+		if ttu.GetNext() != nil {
+			key := utils.Key(next.GetType(), next.GetRelation())
+			if _, ok := visited[key]; ok {
+				continue
+			}
+			visited[key] = struct{}{}
+			results, err = g.findEntranceTupleToUserSet(next, source, ttu.GetNext(), visited)
+		} else {
+			results, err = g.findEntrance(next, source, visited)
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, results...)
+	}
+	return res, nil
+}
+
 // findEntranceWithRewrite is a helper function that searches the LinkedSchemaGraph for entry points that can be reached from
 // the specified target relation through an action reference with a rewrite child. The function recursively searches each child of
 // the rewrite and calls either findEntranceWithRewrite or findEntranceWithLeaf, depending on the child's type. The function
 // only returns entry points that can be reached from the target relation using the specified source relation. If the target or
-// source relation does not exist in the schema graph, the function returns an error.
+// source relation does not exist in the schema graph, the function returns an error. Subjects of the excluded children of an
+// exclusion never grant it, so only the first child of an exclusion is searched.
 //
 // Parameters:
 //   - target: pointer to a base.RelationReference that identifies the target relation
@@ -294,7 +323,11 @@ func (g *LinkedSchemaGraph) findEntranceLeaf(target, source *base.RelationRefere
 //     source relation does not exist in the schema graph
 func (g *LinkedSchemaGraph) findEntranceRewrite(target *base.RelationReference, source *base.RelationReference, rewrite *base.Rewrite, visited map[string]struct{}) (results []*LinkedEntrance, err error) {
 	var res []*LinkedEntrance
-	for _, child := range rewrite.GetChildren() {
+	children := rewrite.GetChildren()
+	if rewrite.GetRewriteOperation() == base.Rewrite_OPERATION_EXCLUSION && len(children) > 0 {
+		children = children[:1]
+	}
+	for _, child := range children {
 		switch child.GetType().(type) {
 		case *base.Child_Rewrite:
 			results, err = g.findEntranceRewrite(target, source, child.GetRewrite(), visited)
@@ -910,5 +910,134 @@ var _ = Describe("connected schema", func() {
 				},
 			}))
 		})
+
+		It("Case 18", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity organization {
+				relation admin @user
+			}
+
+			entity folder {
+				relation org @organization
+			}
+
+			entity doc {
+				relation parent @folder
+				action delete = parent.org.admin
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := compiler.NewCompiler(false, sch)
+			a, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			g := NewLinkedGraph(NewSchemaFromEntityDefinitions(a...))
+
+			ent, err := g.RelationshipLinkedEntrances(&base.RelationReference{
+				Type:     "doc",
+				Relation: "delete",
+			}, &base.RelationReference{
+				Type:     "user",
+				Relation: "",
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ent).Should(Equal([]*LinkedEntrance{
+				{
+					Kind: RelationLinkedEntrance,
+					TargetEntrance: &base.RelationReference{
+						Type:     "organization",
+						Relation: "admin",
+					},
+					TupleSetRelation: "",
+				},
+			}))
+
+			// the intermediate entity is entered with the rest of the path
+			ent, err = g.RelationshipLinkedEntrances(&base.RelationReference{
+				Type:     "doc",
+				Relation: "delete",
+			}, &base.RelationReference{
+				Type:     "folder",
+				Relation: "org.admin",
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ent).Should(Equal([]*LinkedEntrance{
+				{
+					Kind: TupleToUserSetLinkedEntrance,
+					TargetEntrance: &base.RelationReference{
+						Type:     "doc",
+						Relation: "delete",
+					},
+					TupleSetRelation: "parent",
+				},
+			}))
+
+			ent, err = g.RelationshipLinkedEntrances(&base.RelationReference{
+				Type:     "doc",
+				Relation: "delete",
+			}, &base.RelationReference{
+				Type:     "organization",
+				Relation: "admin",
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ent).Should(Equal([]*LinkedEntrance{
+				{
+					Kind: TupleToUserSetLinkedEntrance,
+					TargetEntrance: &base.RelationReference{
+						Type:     "folder",
+						Relation: "org.admin",
+					},
+					TupleSetRelation: "org",
+				},
+			}))
+		})
+
+		It("Case 19", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity repository {
+				relation editor @user
+				relation banned @user
+				action edit = editor but not banned
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := compiler.NewCompiler(false, sch)
+			a, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			g := NewLinkedGraph(NewSchemaFromEntityDefinitions(a...))
+
+			// the excluded relation never grants the action
+			ent, err := g.RelationshipLinkedEntrances(&base.RelationReference{
+				Type:     "repository",
+				Relation: "edit",
+			}, &base.RelationReference{
+				Type:     "user",
+				Relation: "",
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ent).Should(Equal([]*LinkedEntrance{
+				{
+					Kind: RelationLinkedEntrance,
+					TargetEntrance: &base.RelationReference{
+						Type:     "repository",
+						Relation: "editor",
+					},
+					TupleSetRelation: "",
+				},
+			}))
+		})
 	})
 })
@@ -7,6 +7,7 @@ import (
 	"permify/pkg/dsl/compiler"
 	"permify/pkg/dsl/parser"
 	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
 )
 
 // NewSchemaFromStringDefinitions creates a new `SchemaDefinition` from a list of string definitions.
@@ -145,3 +146,41 @@ func IsDirectlyRelated(target *base.RelationDefinition, source *base.RelationRef
 	// If no match is found, return false
 	return false
 }
+
+// IsSelfReferentialRelation checks if a relation of an `EntityDefinition` can only be satisfied by users directly or
+// through the same relation of other entities of its own type, e.g. `relation member @user @group#member` of a
+// group. Membership of such a relation is the transitive closure of its tuples.
+func IsSelfReferentialRelation(entityDefinition *base.EntityDefinition, name string) bool {
+	relation, ok := entityDefinition.GetRelations()[name]
+	if !ok {
+		return false
+	}
+	self := false
+	for _, ref := range relation.GetRelationReferences() {
+		switch {
+		case ref.GetType() == entityDefinition.GetName() && ref.GetRelation() == name:
+			self = true
+		case ref.GetType() == tuple.USER && ref.GetRelation() == "":
+		default:
+			// any other subject is evaluated by the engine
+			return false
+		}
+	}
+	return self
+}
+
+// GetTupleToUserSetPath returns the dotted path of a `TupleToUserSet` the way it is written in the DSL,
+// e.g. "parent.admin", or "parent.organization.admin" for a multi-hop one.
+func GetTupleToUserSetPath(ttu *base.TupleToUserSet) string {
+	return ttu.GetTupleSet().GetRelation() + "." + GetTupleToUserSetComputedRelation(ttu)
+}
+
+// GetTupleToUserSetComputedRelation returns what a `TupleToUserSet` evaluates on the subjects of its tuple set.
+// It is the relation of the computed user set, or the dotted path of the next hops for a multi-hop one,
+// e.g. "organization.admin" for "parent.organization.admin".
+func GetTupleToUserSetComputedRelation(ttu *base.TupleToUserSet) string {
+	if ttu.GetNext() != nil {
+		return GetTupleToUserSetPath(ttu.GetNext())
+	}
+	return ttu.GetComputed().GetRelation()
+}
@@ -325,4 +325,33 @@ var _ = Describe("schema", func() {
 			}))
 		})
 	})
+
+	Context("IsSelfReferentialRelation", func() {
+		It("Case 1", func() {
+			entities, err := NewEntityDefinitionsFromStringDefinitions(true, `
+			entity user {}
+
+			entity team {
+				relation member @user
+			}
+
+			entity group {
+				relation member @user @group#member
+				relation manager @user @group#manager @team#member
+				relation owner @user
+				relation parent @group
+
+				permission view = member or manager
+			}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			group := entities[2]
+			Expect(IsSelfReferentialRelation(group, "member")).Should(BeTrue())
+			Expect(IsSelfReferentialRelation(group, "manager")).Should(BeFalse())
+			Expect(IsSelfReferentialRelation(group, "owner")).Should(BeFalse())
+			Expect(IsSelfReferentialRelation(group, "parent")).Should(BeFalse())
+			Expect(IsSelfReferentialRelation(group, "view")).Should(BeFalse())
+			Expect(IsSelfReferentialRelation(entities[1], "member")).Should(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,52 @@
+package servers
+
+import (
+	"context"
+
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/status"
+
+	"permify/internal/services"
+	"permify/pkg/logger"
+	v1 "permify/pkg/pb/base/v1"
+)
+
+// AdminServer - Structure for Admin Server
+type AdminServer struct {
+	v1.UnimplementedAdminServer
+
+	adminService services.IAdminService
+	logger       logger.Interface
+}
+
+// NewAdminServer - Creates new Admin Server
+func NewAdminServer(s services.IAdminService, l logger.Interface) *AdminServer {
+	return &AdminServer{
+		adminService: s,
+		logger:       l,
+	}
+}
+
+// GarbageCollect - Runs a garbage collection pass for a tenant or for every tenant
+func (a *AdminServer) GarbageCollect(ctx context.Context, request *v1.GarbageCollectRequest) (*v1.GarbageCollectResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.garbage-collect")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	results, err := a.adminService.GarbageCollect(ctx, request.GetTenantId(), request.GetWindow().AsDuration(), request.GetDryRun())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		a.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.GarbageCollectResponse{
+		Results: results,
+		DryRun:  request.GetDryRun(),
+	}, nil
+}
@@ -13,6 +13,12 @@ func GetStatus(err error) codes.Code {
 		return codes.Internal
 	}
 	switch {
+	case code == int32(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN):
+		return codes.InvalidArgument
+	case code == int32(base.ErrorCode_ERROR_CODE_GARBAGE_COLLECTION_IN_PROGRESS):
+		return codes.Aborted
+	case code == int32(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED):
+		return codes.Unimplemented
 	case code > 999 && code < 1999:
 		return codes.Unauthenticated
 	case code > 1999 && code < 2999:
@@ -107,3 +107,29 @@ func (r *RelationshipServer) Delete(ctx context.Context, request *v1.Relationshi
 		SnapToken: snap.String(),
 	}, nil
 }
+
+// Stats - Counts the relation tuples of a tenant grouped by entity type, relation and subject type
+func (r *RelationshipServer) Stats(ctx context.Context, request *v1.RelationshipStatsRequest) (*v1.RelationshipStatsResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.stats")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	top := request.GetTop()
+	if top == 0 {
+		top = 10
+	}
+
+	response, err := r.relationshipService.ReadRelationshipStats(ctx, request.GetTenantId(), request.GetMetadata().GetSnapToken(), top)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return response, nil
+}
@@ -1,12 +1,21 @@
 package servers
 
 import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"golang.org/x/net/context"
 
 	"permify/internal/services"
+	"permify/pkg/development/graph"
+	"permify/pkg/dsl/ast"
+	"permify/pkg/dsl/decompiler"
+	"permify/pkg/dsl/formatter"
+	"permify/pkg/dsl/linter"
+	"permify/pkg/dsl/parser"
 	"permify/pkg/logger"
 	v1 "permify/pkg/pb/base/v1"
 )
@@ -32,17 +41,104 @@ func (r *SchemaServer) Write(ctx context.Context, request *v1.SchemaWriteRequest
 	ctx, span := tracer.Start(ctx, "schemas.write")
 	defer span.End()
 
-	version, err := r.schemaService.WriteSchema(ctx, request.GetTenantId(), request.GetSchema())
+	if len(request.GetFiles()) > 0 && request.GetSchema() != "" {
+		return nil, status.Error(codes.InvalidArgument, "schema and files cannot be used together")
+	}
+
+	// the schema is parsed once, and linted and formatted before it is written, so a written version is never
+	// reported as failed
+	var sch *ast.Schema
+	var err error
+	if len(request.GetFiles()) > 0 {
+		sch, err = parser.ParseFiles(request.GetFiles())
+	} else {
+		sch, err = parser.NewParser(request.GetSchema()).Parse()
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
 		r.logger.Error(err.Error())
-		return nil, status.Error(GetStatus(err), err.Error())
+		return nil, schemaWriteError(err, nil)
 	}
 
-	return &v1.SchemaWriteResponse{
-		SchemaVersion: version,
-	}, nil
+	response := &v1.SchemaWriteResponse{}
+
+	err = lintSchemaWriteResponse(sch, response)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, schemaWriteError(err, nil)
+	}
+
+	if request.GetFormat() {
+		err = formatSchemaWriteResponse(request, sch, response)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			r.logger.Error(err.Error())
+			return nil, schemaWriteError(err, nil)
+		}
+	}
+
+	response.SchemaVersion, response.BreakingChanges, err = r.schemaService.WriteParsedSchema(ctx, request.GetTenantId(), sch, request.GetForce())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, schemaWriteError(err, response.GetBreakingChanges())
+	}
+
+	return response, nil
+}
+
+// schemaWriteError - converts an error of a schema write to a status. The errors of the schema are attached with
+// their positions and codes, and the breaking changes that refused the write with their details.
+func schemaWriteError(err error, breakingChanges []*v1.SchemaBreakingChange) error {
+	st := status.New(GetStatus(err), err.Error())
+	var schemaErrs ast.Errors
+	if errors.As(err, &schemaErrs) {
+		st = status.New(codes.InvalidArgument, err.Error())
+		if withDetails, detailsErr := st.WithDetails(&v1.SchemaErrors{Errors: schemaErrs.ToSchemaErrors()}); detailsErr == nil {
+			st = withDetails
+		}
+	}
+	if len(breakingChanges) > 0 {
+		if withDetails, detailsErr := st.WithDetails(&v1.SchemaBreakingChanges{Changes: breakingChanges}); detailsErr == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
+
+// formatSchemaWriteResponse sets the schema, or each of the files, in the canonical layout on the response. A single
+// schema is printed from its parsed form, files are formatted one by one since the parsed form merges them.
+func formatSchemaWriteResponse(request *v1.SchemaWriteRequest, sch *ast.Schema, response *v1.SchemaWriteResponse) (err error) {
+	if len(request.GetFiles()) == 0 {
+		response.FormattedSchema = formatter.NewFormatter(sch).Format()
+		return nil
+	}
+
+	response.FormattedFiles = make(map[string]string, len(request.GetFiles()))
+	for name, source := range request.GetFiles() {
+		var formatted string
+		formatted, err = formatter.Format(source)
+		if err != nil {
+			return err
+		}
+		response.FormattedFiles[name] = formatted
+	}
+	return nil
+}
+
+// lintSchemaWriteResponse sets the lint warnings of the parsed schema on the response.
+func lintSchemaWriteResponse(sch *ast.Schema, response *v1.SchemaWriteResponse) error {
+	warnings, err := linter.NewLinter(sch).Lint()
+	if err != nil {
+		return err
+	}
+	response.LintWarnings = warnings.ToSchemaLintWarnings()
+	return nil
 }
 
 // Read - Read created Schema
@@ -50,6 +146,11 @@ func (r *SchemaServer) Read(ctx context.Context, request *v1.SchemaReadRequest)
 	ctx, span := tracer.Start(ctx, "schemas.read")
 	defer span.End()
 
+	v := request.GetMetadata().Validate()
+	if v != nil {
+		return nil, v
+	}
+
 	var err error
 	var response *v1.SchemaDefinition
 	response, err = r.schemaService.ReadSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
@@ -60,7 +161,146 @@ func (r *SchemaServer) Read(ctx context.Context, request *v1.SchemaReadRequest)
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	// the source of the schema is returned instead of its compiled definition
+	if request.GetMetadata().GetFormat() == "dsl" {
+		var dsl string
+		dsl, err = decompiler.NewDecompiler(response).Decompile()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			r.logger.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		return &v1.SchemaReadResponse{
+			Dsl: dsl,
+		}, nil
+	}
+
 	return &v1.SchemaReadResponse{
 		Schema: response,
 	}, nil
 }
+
+// Audit - Reports (and optionally deletes) relation tuples that do not conform to a schema version
+func (r *SchemaServer) Audit(ctx context.Context, request *v1.SchemaAuditRequest) (*v1.SchemaAuditResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.audit")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	violations, snapToken, err := r.schemaService.AuditSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion(), request.GetMetadata().GetSnapToken(), request.GetDelete())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaAuditResponse{
+		Violations: violations,
+		SnapToken:  snapToken,
+	}, nil
+}
+
+// List - Lists the versions of the schema
+func (r *SchemaServer) List(ctx context.Context, request *v1.SchemaListRequest) (*v1.SchemaListResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.list")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	head, schemas, ct, err := r.schemaService.ListSchemas(ctx, request.GetTenantId(), request.GetPageSize(), request.GetContinuousToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaListResponse{
+		Head:            head,
+		Schemas:         schemas,
+		ContinuousToken: ct.String(),
+	}, nil
+}
+
+// Diff - Compares two versions of the schema
+func (r *SchemaServer) Diff(ctx context.Context, request *v1.SchemaDiffRequest) (*v1.SchemaDiffResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.diff")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	response, err := r.schemaService.DiffSchema(ctx, request.GetTenantId(), request.GetMetadata().GetFromVersion(), request.GetMetadata().GetToVersion())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return response, nil
+}
+
+// Graph - Returns the graph of the entities, relations and permissions of a schema version, optionally drawn as a diagram
+func (r *SchemaServer) Graph(ctx context.Context, request *v1.SchemaGraphRequest) (*v1.SchemaGraphResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.graph")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	sch, err := r.schemaService.ReadSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	g, err := graph.SchemaToGraph(sch)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	response := &v1.SchemaGraphResponse{}
+	for _, n := range g.Nodes() {
+		response.Nodes = append(response.Nodes, &v1.SchemaGraphNode{
+			Type:  n.Type,
+			Id:    n.ID,
+			Label: n.Label,
+		})
+	}
+	for _, e := range g.Edges() {
+		exclusion, _ := e.Extra.(bool)
+		response.Edges = append(response.Edges, &v1.SchemaGraphEdge{
+			From:      e.From.ID,
+			To:        e.To.ID,
+			Label:     e.Label,
+			Exclusion: exclusion,
+		})
+	}
+
+	switch request.GetMetadata().GetFormat() {
+	case "dot":
+		response.Diagram = g.DOT()
+	case "mermaid":
+		response.Diagram = g.Mermaid()
+	}
+
+	return response, nil
+}
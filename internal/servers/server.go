@@ -42,6 +42,7 @@ type ServiceContainer struct {
 	PermissionService   services.IPermissionService
 	SchemaService       services.ISchemaService
 	TenancyService      services.ITenancyService
+	AdminService        services.IAdminService
 }
 
 // Run -
@@ -100,6 +101,7 @@ func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authenti
 	grpcV1.RegisterSchemaServer(grpcServer, NewSchemaServer(s.SchemaService, l))
 	grpcV1.RegisterRelationshipServer(grpcServer, NewRelationshipServer(s.RelationshipService, l))
 	grpcV1.RegisterTenancyServer(grpcServer, NewTenancyServer(s.TenancyService, l))
+	grpcV1.RegisterAdminServer(grpcServer, NewAdminServer(s.AdminService, l))
 	health.RegisterHealthServer(grpcServer, NewHealthServer())
 	grpcV1.RegisterWelcomeServer(grpcServer, NewWelcomeServer())
 	reflection.Register(grpcServer)
@@ -192,6 +194,9 @@ func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authenti
 		if err = grpcV1.RegisterTenancyHandler(ctx, mux, conn); err != nil {
 			return err
 		}
+		if err = grpcV1.RegisterAdminHandler(ctx, mux, conn); err != nil {
+			return err
+		}
 		if err = grpcV1.RegisterWelcomeHandler(ctx, mux, conn); err != nil {
 			return err
 		}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"permify/internal/repositories"
+	base "permify/pkg/pb/base/v1"
+)
+
+// AdminService -
+type AdminService struct {
+	gc repositories.GarbageCollector
+}
+
+// NewAdminService - the garbage collector is nil if the database engine does not keep the history of the relationships
+func NewAdminService(gc repositories.GarbageCollector) *AdminService {
+	return &AdminService{
+		gc: gc,
+	}
+}
+
+// GarbageCollect -
+func (s *AdminService) GarbageCollect(ctx context.Context, tenantID string, window time.Duration, dryRun bool) (results []*base.GarbageCollectResult, err error) {
+	if s.gc == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED.String())
+	}
+	if window < 0 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	return s.gc.Collect(ctx, repositories.GarbageCollectionOptions{
+		TenantID: tenantID,
+		Window:   window,
+		DryRun:   dryRun,
+	})
+}
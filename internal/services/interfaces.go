@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"permify/pkg/database"
+	"permify/pkg/dsl/ast"
 	base "permify/pkg/pb/base/v1"
 	"permify/pkg/token"
 )
@@ -22,12 +24,18 @@ type IRelationshipService interface {
 	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, size uint32, continuousToken string) (*database.TupleCollection, database.EncodedContinuousToken, error)
 	WriteRelationships(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (token.EncodedSnapToken, error)
 	DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error)
+	ReadRelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (*base.RelationshipStatsResponse, error)
 }
 
 // ISchemaService -
 type ISchemaService interface {
 	ReadSchema(ctx context.Context, tenantID string, version string) (response *base.SchemaDefinition, err error)
-	WriteSchema(ctx context.Context, tenantID string, schema string) (version string, err error)
+	WriteSchema(ctx context.Context, tenantID string, schema string, force bool) (version string, breakingChanges []*base.SchemaBreakingChange, err error)
+	WriteSchemaFiles(ctx context.Context, tenantID string, files map[string]string, force bool) (version string, breakingChanges []*base.SchemaBreakingChange, err error)
+	WriteParsedSchema(ctx context.Context, tenantID string, sch *ast.Schema, force bool) (version string, breakingChanges []*base.SchemaBreakingChange, err error)
+	AuditSchema(ctx context.Context, tenantID, version, snap string, del bool) (violations []*base.SchemaAuditViolation, snapToken string, err error)
+	ListSchemas(ctx context.Context, tenantID string, size uint32, ct string) (head string, schemas []*base.SchemaList, continuousToken database.EncodedContinuousToken, err error)
+	DiffSchema(ctx context.Context, tenantID, fromVersion, toVersion string) (response *base.SchemaDiffResponse, err error)
 }
 
 // ITenancyService -
@@ -36,3 +44,8 @@ type ITenancyService interface {
 	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
 	ListTenants(ctx context.Context, size uint32, ct string) (tenants []*base.Tenant, continuousToken database.EncodedContinuousToken, err error)
 }
+
+// IAdminService -
+type IAdminService interface {
+	GarbageCollect(ctx context.Context, tenantID string, window time.Duration, dryRun bool) (results []*base.GarbageCollectResult, err error)
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 
 	"permify/internal/engines"
+	"permify/internal/repositories"
 	base "permify/pkg/pb/base/v1"
 )
 
 // PermissionService -
 type PermissionService struct {
+	// repositories
+	rr repositories.RelationshipReader
 	// engines
 	cc *engines.CheckEngine
 	ec *engines.ExpandEngine
@@ -17,8 +20,9 @@ type PermissionService struct {
 }
 
 // NewPermissionService -
-func NewPermissionService(cc *engines.CheckEngine, ec *engines.ExpandEngine, ls *engines.LookupSchemaEngine, le *engines.LookupEntityEngine) *PermissionService {
+func NewPermissionService(rr repositories.RelationshipReader, cc *engines.CheckEngine, ec *engines.ExpandEngine, ls *engines.LookupSchemaEngine, le *engines.LookupEntityEngine) *PermissionService {
 	return &PermissionService{
+		rr: rr,
 		cc: cc,
 		ec: ec,
 		ls: ls,
@@ -28,11 +32,17 @@ func NewPermissionService(cc *engines.CheckEngine, ec *engines.ExpandEngine, ls
 
 // CheckPermissions -
 func (service *PermissionService) CheckPermissions(ctx context.Context, request *base.PermissionCheckRequest) (response *base.PermissionCheckResponse, err error) {
+	if err = service.checkSnapshot(ctx, request.GetTenantId(), request.GetMetadata().GetSnapToken()); err != nil {
+		return response, err
+	}
 	return service.cc.Run(ctx, request)
 }
 
 // ExpandPermissions -
 func (service *PermissionService) ExpandPermissions(ctx context.Context, request *base.PermissionExpandRequest) (response *base.PermissionExpandResponse, err error) {
+	if err = service.checkSnapshot(ctx, request.GetTenantId(), request.GetMetadata().GetSnapToken()); err != nil {
+		return response, err
+	}
 	return service.ec.Run(ctx, request)
 }
 
@@ -43,10 +53,25 @@ func (service *PermissionService) LookupSchema(ctx context.Context, request *bas
 
 // LookupEntity -
 func (service *PermissionService) LookupEntity(ctx context.Context, request *base.PermissionLookupEntityRequest) (response *base.PermissionLookupEntityResponse, err error) {
+	if err = service.checkSnapshot(ctx, request.GetTenantId(), request.GetMetadata().GetSnapToken()); err != nil {
+		return response, err
+	}
 	return service.le.Run(ctx, request)
 }
 
 // LookupEntityStream -
 func (service *PermissionService) LookupEntityStream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) (err error) {
+	if err = service.checkSnapshot(ctx, request.GetTenantId(), request.GetMetadata().GetSnapToken()); err != nil {
+		return err
+	}
 	return service.le.Stream(ctx, request, server)
 }
+
+// checkSnapshot - Checks the snap token sent by the client once per request, the engines read at the head snapshot
+// when no token is sent
+func (service *PermissionService) checkSnapshot(ctx context.Context, tenantID, snap string) error {
+	if snap == "" {
+		return nil
+	}
+	return service.rr.CheckSnapshot(ctx, tenantID, snap)
+}
@@ -2,13 +2,10 @@ package services
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 
 	"permify/internal/repositories"
-	"permify/internal/schema"
 	"permify/pkg/database"
 	base "permify/pkg/pb/base/v1"
 	"permify/pkg/token"
@@ -43,6 +40,10 @@ func (service *RelationshipService) ReadRelationships(ctx context.Context, tenan
 			return nil, nil, err
 		}
 		snap = st.Encode().String()
+	} else if err = service.rr.CheckSnapshot(ctx, tenantID, snap); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
 	}
 
 	return service.rr.ReadRelationships(ctx, tenantID, filter, snap, database.NewPagination(database.Size(size), database.Token(continuousToken)))
@@ -80,27 +81,7 @@ func (service *RelationshipService) WriteRelationships(ctx context.Context, tena
 			return token, err
 		}
 
-		if tuple.IsEntityAndSubjectEquals(tup) {
-			return token, errors.New(base.ErrorCode_ERROR_CODE_ENTITY_AND_SUBJECT_CANNOT_BE_EQUAL.String())
-		}
-
-		var rel *base.RelationDefinition
-		var vt []string
-		rel, err = schema.GetRelationByNameInEntityDefinition(entity, tup.GetRelation())
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			return token, err
-		}
-		for _, t := range rel.GetRelationReferences() {
-			if t.GetRelation() != "" {
-				vt = append(vt, fmt.Sprintf("%s#%s", t.GetType(), t.GetRelation()))
-			} else {
-				vt = append(vt, t.GetType())
-			}
-		}
-
-		err = tuple.ValidateSubjectType(subject, vt)
+		err = validateTuple(entity, tup)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -124,3 +105,31 @@ func (service *RelationshipService) DeleteRelationships(ctx context.Context, ten
 
 	return service.rw.DeleteRelationships(ctx, tenantID, filter)
 }
+
+// ReadRelationshipStats -
+func (service *RelationshipService) ReadRelationshipStats(ctx context.Context, tenantID, snap string, top uint32) (*base.RelationshipStatsResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.stats")
+	defer span.End()
+
+	if snap != "" {
+		if err := service.rr.CheckSnapshot(ctx, tenantID, snap); err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	stats, err := service.rr.RelationshipStats(ctx, tenantID, snap, top)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+
+	return &base.RelationshipStatsResponse{
+		Total:       stats.Total(),
+		Counts:      stats.Counts,
+		TopEntities: stats.TopEntities,
+		SnapToken:   stats.SnapToken,
+	}, nil
+}
@@ -1 +1,89 @@
 package services
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/config"
+	"permify/internal/factories"
+	"permify/pkg/database"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
+)
+
+var _ = Describe("relationship-service", func() {
+	var schemaService *SchemaService
+	var relationshipService *RelationshipService
+
+	BeforeEach(func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: database.MEMORY.String()})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		l := logger.New("debug")
+
+		rr := factories.RelationshipReaderFactory(db, signingKeys, l)
+		rw := factories.RelationshipWriterFactory(db, signingKeys, l)
+		sr := factories.SchemaReaderFactory(db, l)
+		sw := factories.SchemaWriterFactory(db, l)
+
+		schemaService = NewSchemaService(sw, sr, rr, rw)
+		relationshipService = NewRelationshipService(rr, rw, sr)
+	})
+
+	Context("Stats", func() {
+		It("Case 1", func() {
+			ctx := context.Background()
+
+			version, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				relation member @user
+			}
+
+			entity repository {
+				relation parent @organization
+				relation owner @user
+			}`, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"organization:1#admin@user:1",
+				"organization:1#member@user:1",
+				"organization:1#member@user:2",
+				"organization:1#member@user:3",
+				"organization:2#member@user:1",
+				"repository:1#parent@organization:1#...",
+				"repository:1#owner@user:2",
+			} {
+				var tup *base.Tuple
+				tup, err = tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+
+			_, err = relationshipService.WriteRelationships(ctx, "t1", tuples, version)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			stats, err := relationshipService.ReadRelationshipStats(ctx, "t1", "", 2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(stats.GetTotal()).Should(Equal(uint64(7)))
+			Expect(stats.GetSnapToken()).ShouldNot(BeEmpty())
+
+			Expect(stats.GetCounts()).Should(HaveLen(4))
+			Expect(stats.GetCounts()[1].GetRelation()).Should(Equal("member"))
+			Expect(stats.GetCounts()[1].GetCount()).Should(Equal(uint64(4)))
+
+			Expect(stats.GetTopEntities()).Should(HaveLen(2))
+			Expect(tuple.EntityToString(stats.GetTopEntities()[0].GetEntity())).Should(Equal("organization:1"))
+			Expect(stats.GetTopEntities()[0].GetRelation()).Should(Equal("member"))
+			Expect(stats.GetTopEntities()[0].GetCount()).Should(Equal(uint64(3)))
+		})
+	})
+})
@@ -2,16 +2,21 @@ package services
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rs/xid"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 
 	"permify/internal/repositories"
+	"permify/internal/schema"
+	"permify/pkg/database"
 	"permify/pkg/dsl/ast"
 	"permify/pkg/dsl/compiler"
 	"permify/pkg/dsl/parser"
 	base "permify/pkg/pb/base/v1"
+	"permify/pkg/token"
+	"permify/pkg/tuple"
 )
 
 // SchemaService -
@@ -19,13 +24,17 @@ type SchemaService struct {
 	// repositories
 	sw repositories.SchemaWriter
 	sr repositories.SchemaReader
+	rr repositories.RelationshipReader
+	rw repositories.RelationshipWriter
 }
 
 // NewSchemaService -
-func NewSchemaService(sw repositories.SchemaWriter, sr repositories.SchemaReader) *SchemaService {
+func NewSchemaService(sw repositories.SchemaWriter, sr repositories.SchemaReader, rr repositories.RelationshipReader, rw repositories.RelationshipWriter) *SchemaService {
 	return &SchemaService{
 		sw: sw,
 		sr: sr,
+		rr: rr,
+		rw: rw,
 	}
 }
 
@@ -46,8 +55,10 @@ func (service *SchemaService) ReadSchema(ctx context.Context, tenantID, version
 	return service.sr.ReadSchema(ctx, tenantID, version)
 }
 
-// WriteSchema -
-func (service *SchemaService) WriteSchema(ctx context.Context, tenantID, schema string) (response string, err error) {
+// WriteSchema - Writes a new schema version. Changes that break relation tuples written for the head version, removed
+// entities and relations that still have tuples and narrowed relation types, are rejected unless force is set; they are
+// returned in both cases.
+func (service *SchemaService) WriteSchema(ctx context.Context, tenantID, schema string, force bool) (response string, breakingChanges []*base.SchemaBreakingChange, err error) {
 	ctx, span := tracer.Start(ctx, "schemas.write")
 	defer span.End()
 
@@ -55,31 +66,317 @@ func (service *SchemaService) WriteSchema(ctx context.Context, tenantID, schema
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
-		return "", err
+		return "", nil, err
 	}
 
-	_, err = compiler.NewCompiler(false, sch).Compile()
+	return service.WriteParsedSchema(ctx, tenantID, sch, force)
+}
+
+// WriteSchemaFiles - Writes a new schema version from a set of named source files, see WriteSchema.
+func (service *SchemaService) WriteSchemaFiles(ctx context.Context, tenantID string, files map[string]string, force bool) (response string, breakingChanges []*base.SchemaBreakingChange, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.write-files")
+	defer span.End()
+
+	sch, err := parser.ParseFiles(files)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
-		return "", err
+		return "", nil, err
+	}
+
+	return service.WriteParsedSchema(ctx, tenantID, sch, force)
+}
+
+// WriteParsedSchema - Compiles the parsed schema, guards it against breaking changes and writes it as a new version.
+func (service *SchemaService) WriteParsedSchema(ctx context.Context, tenantID string, sch *ast.Schema, force bool) (response string, breakingChanges []*base.SchemaBreakingChange, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.write-version")
+	defer span.End()
+
+	var entities []*base.EntityDefinition
+	entities, err = compiler.NewCompiler(false, sch).Compile()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", nil, err
+	}
+
+	breakingChanges, err = service.breakingChanges(ctx, tenantID, entities)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", nil, err
+	}
+
+	if len(breakingChanges) > 0 && !force {
+		err = errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_BREAKING_CHANGE.String())
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", breakingChanges, err
 	}
 
 	version := xid.New().String()
 
 	cnf := make([]repositories.SchemaDefinition, 0, len(sch.Statements))
 	for _, st := range sch.Statements {
+		// entities are stored on their own, imports are only needed to validate the source files
+		es, ok := st.(*ast.EntityStatement)
+		if !ok {
+			continue
+		}
 		cnf = append(cnf, repositories.SchemaDefinition{
 			TenantID:             tenantID,
 			Version:              version,
-			EntityType:           st.(*ast.EntityStatement).Name.Literal,
-			SerializedDefinition: []byte(st.String()),
+			EntityType:           es.Name.Literal,
+			SerializedDefinition: []byte(es.String()),
 		})
 	}
 
 	err = service.sw.WriteSchema(ctx, cnf)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	return version, nil
+	return version, breakingChanges, nil
+}
+
+// breakingChanges - Compares the given entities with the head version of the tenant and counts, at the head snapshot,
+// the relation tuples each removed entity, removed relation and dropped relation type leaves without a definition.
+// Changes that break no tuples are left out.
+func (service *SchemaService) breakingChanges(ctx context.Context, tenantID string, entities []*base.EntityDefinition) (breakingChanges []*base.SchemaBreakingChange, err error) {
+	var head string
+	head, err = service.sr.HeadVersion(ctx, tenantID)
+	if err != nil {
+		// the first schema of a tenant cannot break anything
+		if err.Error() == base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var current *base.SchemaDefinition
+	current, err = service.sr.ReadSchema(ctx, tenantID, head)
+	if err != nil {
+		return nil, err
+	}
+
+	var st token.SnapToken
+	st, err = service.rr.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	snap := st.Encode().String()
+
+	next := schema.NewSchemaFromEntityDefinitions(entities...)
+
+	for _, change := range schema.Diff(current, next) {
+		var candidates []*base.SchemaBreakingChange
+		var filters []*base.TupleFilter
+
+		switch change.GetKind() {
+		case base.SchemaChange_KIND_ENTITY_REMOVED:
+			candidates = append(candidates, &base.SchemaBreakingChange{Kind: change.GetKind(), Entity: change.GetEntity()})
+			filters = append(filters, &base.TupleFilter{Entity: &base.EntityFilter{Type: change.GetEntity()}})
+		case base.SchemaChange_KIND_RELATION_REMOVED:
+			candidates = append(candidates, &base.SchemaBreakingChange{Kind: change.GetKind(), Entity: change.GetEntity(), Relation: change.GetName()})
+			filters = append(filters, &base.TupleFilter{Entity: &base.EntityFilter{Type: change.GetEntity()}, Relation: change.GetName()})
+		case base.SchemaChange_KIND_RELATION_TYPES_CHANGED:
+			after := next.GetEntityDefinitions()[change.GetEntity()].GetRelations()[change.GetName()]
+			for _, ref := range current.GetEntityDefinitions()[change.GetEntity()].GetRelations()[change.GetName()].GetRelationReferences() {
+				if schema.IsDirectlyRelated(after, ref) {
+					continue
+				}
+
+				// subjects without a relation are stored with the ellipsis, except users
+				subject := &base.Subject{Type: ref.GetType(), Relation: ref.GetRelation()}
+				if subject.GetRelation() == "" && !tuple.IsSubjectUser(subject) {
+					subject.Relation = tuple.ELLIPSIS
+				}
+
+				name := ref.GetType()
+				if ref.GetRelation() != "" {
+					name += "#" + ref.GetRelation()
+				}
+
+				candidates = append(candidates, &base.SchemaBreakingChange{
+					Kind:     change.GetKind(),
+					Entity:   change.GetEntity(),
+					Relation: change.GetName(),
+					Subject:  name,
+				})
+				filters = append(filters, &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: change.GetEntity()},
+					Relation: change.GetName(),
+					Subject:  &base.SubjectFilter{Type: subject.GetType(), Relation: subject.GetRelation()},
+				})
+			}
+		}
+
+		for i, candidate := range candidates {
+			var count uint64
+			count, err = service.rr.CountRelationships(ctx, tenantID, filters[i], snap)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				candidate.TupleCount = count
+				breakingChanges = append(breakingChanges, candidate)
+			}
+		}
+	}
+
+	return breakingChanges, nil
+}
+
+// AuditSchema - Reports the relation tuples of the tenant that do not conform to the given schema version at the given snapshot,
+// optionally deleting them. The returned snap token is the audited one, or the one after the deletion when del is set.
+func (service *SchemaService) AuditSchema(ctx context.Context, tenantID, version, snap string, del bool) (violations []*base.SchemaAuditViolation, snapToken string, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.audit")
+	defer span.End()
+
+	if version == "" {
+		version, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, "", err
+		}
+	}
+
+	if snap == "" {
+		var st token.SnapToken
+		st, err = service.rr.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, "", err
+		}
+		snap = st.Encode().String()
+	} else if err = service.rr.CheckSnapshot(ctx, tenantID, snap); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, "", err
+	}
+
+	var sch *base.SchemaDefinition
+	sch, err = service.sr.ReadSchema(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, "", err
+	}
+
+	// the tuples are read page by page, so large tenants are never loaded at once
+	violations = []*base.SchemaAuditViolation{}
+	ct := ""
+	for {
+		var collection *database.TupleCollection
+		var next database.EncodedContinuousToken
+		collection, next, err = service.rr.ReadRelationships(ctx, tenantID, &base.TupleFilter{}, snap, database.NewPagination(database.Size(_auditPageSize), database.Token(ct)))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, "", err
+		}
+
+		for _, tup := range collection.GetTuples() {
+			entity, verr := schema.GetEntityByName(sch, tup.GetEntity().GetType())
+			if verr == nil {
+				verr = validateTuple(entity, tup)
+			}
+			if verr != nil {
+				violations = append(violations, &base.SchemaAuditViolation{
+					Tuple:  tup,
+					Reason: verr.Error(),
+				})
+			}
+		}
+
+		ct = next.String()
+		if ct == "" {
+			break
+		}
+	}
+
+	snapToken = snap
+	if !del {
+		return violations, snapToken, nil
+	}
+
+	// the violations are removed in batches with filters that never catch conforming tuples, see auditDeleteFilters.
+	for _, filter := range auditDeleteFilters(violations, _auditDeleteBatchSize) {
+		var t token.EncodedSnapToken
+		t, err = service.rw.DeleteRelationships(ctx, tenantID, filter)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, "", err
+		}
+		snapToken = t.String()
+	}
+
+	return violations, snapToken, nil
+}
+
+// ListSchemas - Lists the schema versions of the tenant, the newest first, along with the head version.
+func (service *SchemaService) ListSchemas(ctx context.Context, tenantID string, size uint32, ct string) (head string, schemas []*base.SchemaList, continuousToken database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.list")
+	defer span.End()
+
+	schemas, continuousToken, err = service.sr.ListSchemas(ctx, tenantID, database.NewPagination(database.Size(size), database.Token(ct)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", nil, nil, err
+	}
+
+	// a tenant without any schema has no head version
+	if len(schemas) == 0 && ct == "" {
+		return "", schemas, continuousToken, nil
+	}
+
+	head, err = service.sr.HeadVersion(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", nil, nil, err
+	}
+
+	return head, schemas, continuousToken, nil
+}
+
+// DiffSchema - Compares two schema versions of the tenant, toVersion defaults to the head version.
+func (service *SchemaService) DiffSchema(ctx context.Context, tenantID, fromVersion, toVersion string) (response *base.SchemaDiffResponse, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.diff")
+	defer span.End()
+
+	if toVersion == "" {
+		toVersion, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	var from, to *base.SchemaDefinition
+	from, err = service.sr.ReadSchema(ctx, tenantID, fromVersion)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+
+	to, err = service.sr.ReadSchema(ctx, tenantID, toVersion)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+
+	changes := schema.Diff(from, to)
+	return &base.SchemaDiffResponse{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Changes:     changes,
+		Summary:     schema.DiffSummary(changes),
+	}, nil
 }
@@ -1 +1,388 @@
 package services
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/config"
+	"permify/internal/factories"
+	"permify/pkg/database"
+	"permify/pkg/logger"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
+)
+
+var _ = Describe("schema-service", func() {
+	var schemaService *SchemaService
+	var relationshipService *RelationshipService
+
+	BeforeEach(func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: database.MEMORY.String()})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		l := logger.New("debug")
+
+		rr := factories.RelationshipReaderFactory(db, signingKeys, l)
+		rw := factories.RelationshipWriterFactory(db, signingKeys, l)
+		sr := factories.SchemaReaderFactory(db, l)
+		sw := factories.SchemaWriterFactory(db, l)
+
+		schemaService = NewSchemaService(sw, sr, rr, rw)
+		relationshipService = NewRelationshipService(rr, rw, sr)
+	})
+
+	Context("Audit", func() {
+		It("Case 1", func() {
+			ctx := context.Background()
+
+			v1, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				relation member @user
+			}
+
+			entity repository {
+				relation parent @organization
+				relation owner @user @organization#member
+			}`, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"organization:1#admin@user:1",
+				"organization:1#member@user:2",
+				"repository:1#parent@organization:1#...",
+				"repository:1#owner@organization:1#member",
+				"repository:2#owner@user:3",
+			} {
+				var tup *base.Tuple
+				tup, err = tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+
+			_, err = relationshipService.WriteRelationships(ctx, "t1", tuples, v1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			v2, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity organization {
+				relation member @user
+			}
+
+			entity repository {
+				relation owner @user
+			}`, true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			violations, _, err := schemaService.AuditSchema(ctx, "t1", v2, "", false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			reasons := map[string]string{}
+			for _, violation := range violations {
+				reasons[tuple.ToString(violation.GetTuple())] = violation.GetReason()
+			}
+
+			Expect(reasons).Should(Equal(map[string]string{
+				"organization:1#admin@user:1":              base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String(),
+				"repository:1#parent@organization:1#...":   base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String(),
+				"repository:1#owner@organization:1#member": base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND.String(),
+			}))
+
+			violations, _, err = schemaService.AuditSchema(ctx, "t1", v1, "", false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(violations).Should(BeEmpty())
+		})
+
+		It("Case 2", func() {
+			ctx := context.Background()
+
+			v1, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity document {
+				relation viewer @user
+				relation editor @user
+			}`, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"document:1#viewer@user:1",
+				"document:1#editor@user:1",
+				"document:2#editor@user:2",
+			} {
+				var tup *base.Tuple
+				tup, err = tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+
+			_, err = relationshipService.WriteRelationships(ctx, "t1", tuples, v1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			v2, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity document {
+				relation viewer @user
+			}`, true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			violations, _, err := schemaService.AuditSchema(ctx, "t1", v2, "", true)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(violations).Should(HaveLen(2))
+
+			violations, _, err = schemaService.AuditSchema(ctx, "t1", v2, "", false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(violations).Should(BeEmpty())
+
+			collection, _, err := relationshipService.ReadRelationships(ctx, "t1", &base.TupleFilter{}, "", 10, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(collection.GetTuples()).Should(HaveLen(1))
+		})
+
+		It("Case 3", func() {
+			var violations []*base.SchemaAuditViolation
+			for _, t := range []string{
+				"document:1#editor@user:1",
+				"document:2#editor@user:2",
+				"document:3#editor@user:3",
+				"document:1#owner@team:1#member",
+				"group:1#member@group:1#member",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				violations = append(violations, &base.SchemaAuditViolation{Tuple: tup})
+			}
+
+			filters := auditDeleteFilters(violations, 2)
+			Expect(filters).Should(HaveLen(4))
+
+			// the tuple whose entity and subject are equal is removed on its own
+			Expect(filters[0].GetEntity().GetIds()).Should(Equal([]string{"1"}))
+			Expect(filters[0].GetEntity().GetType()).Should(Equal("group"))
+
+			// violations of the same shape are batched
+			Expect(filters[1].GetEntity().GetIds()).Should(Equal([]string{"1", "2"}))
+			Expect(filters[1].GetSubject().GetIds()).Should(Equal([]string{"1", "2"}))
+			Expect(filters[2].GetEntity().GetIds()).Should(Equal([]string{"3"}))
+			Expect(filters[3].GetRelation()).Should(Equal("owner"))
+			Expect(filters[3].GetSubject().GetRelation()).Should(Equal("member"))
+		})
+	})
+
+	Context("List", func() {
+		It("Case 1", func() {
+			ctx := context.Background()
+
+			head, schemas, _, err := schemaService.ListSchemas(ctx, "t1", 10, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(BeEmpty())
+			Expect(schemas).Should(BeEmpty())
+
+			var versions []string
+			for i := 0; i < 3; i++ {
+				var v string
+				v, _, err = schemaService.WriteSchema(ctx, "t1", `entity user {}`, false)
+				Expect(err).ShouldNot(HaveOccurred())
+				versions = append(versions, v)
+			}
+
+			var ct database.EncodedContinuousToken
+			head, schemas, ct, err = schemaService.ListSchemas(ctx, "t1", 2, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(versions[2]))
+			Expect(schemas).Should(HaveLen(2))
+			Expect(schemas[0].GetVersion()).Should(Equal(versions[2]))
+			Expect(schemas[0].GetCreatedAt()).ShouldNot(BeNil())
+			Expect(schemas[1].GetVersion()).Should(Equal(versions[1]))
+			Expect(ct.String()).ShouldNot(BeEmpty())
+
+			head, schemas, ct, err = schemaService.ListSchemas(ctx, "t1", 2, ct.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(versions[2]))
+			Expect(schemas).Should(HaveLen(1))
+			Expect(schemas[0].GetVersion()).Should(Equal(versions[0]))
+			Expect(ct.String()).Should(BeEmpty())
+		})
+	})
+
+	Context("Diff", func() {
+		It("Case 1", func() {
+			ctx := context.Background()
+
+			v1, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+			}`, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			v2, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity organization {
+				relation member @user
+			}`, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			response, err := schemaService.DiffSchema(ctx, "t1", v1, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetToVersion()).Should(Equal(v2))
+			Expect(response.GetChanges()).Should(HaveLen(2))
+			Expect(response.GetSummary()).Should(Equal("- relation organization#admin @user\n+ relation organization#member @user"))
+
+			_, err = schemaService.DiffSchema(ctx, "t1", "unknown", v2)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+		})
+	})
+
+	Context("Write", func() {
+		It("Case 1", func() {
+			ctx := context.Background()
+
+			v1, _, err := schemaService.WriteSchema(ctx, "t1", `
+			entity user {}
+
+			entity team {
+				relation member @user
+			}
+
+			entity organization {
+				relation admin @user
+				relation owner @user
+				relation member @user @team#member
+			}`, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"team:1#member@user:1",
+				"team:1#member@user:2",
+				"organization:1#admin@user:1",
+				"organization:1#member@user:2",
+				"organization:1#member@team:1#member",
+			} {
+				var tup *base.Tuple
+				tup, err = tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+
+			_, err = relationshipService.WriteRelationships(ctx, "t1", tuples, v1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			next := `
+			entity user {}
+
+			entity organization {
+				relation member @user
+			}`
+
+			version, breakingChanges, err := schemaService.WriteSchema(ctx, "t1", next, false)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_BREAKING_CHANGE.String()))
+			Expect(version).Should(BeEmpty())
+
+			// the owner relation has no tuples, so removing it is not reported
+			Expect(breakingChanges).Should(HaveLen(3))
+
+			Expect(breakingChanges[0].GetKind()).Should(Equal(base.SchemaChange_KIND_RELATION_REMOVED))
+			Expect(breakingChanges[0].GetEntity()).Should(Equal("organization"))
+			Expect(breakingChanges[0].GetRelation()).Should(Equal("admin"))
+			Expect(breakingChanges[0].GetTupleCount()).Should(Equal(uint64(1)))
+
+			Expect(breakingChanges[1].GetKind()).Should(Equal(base.SchemaChange_KIND_RELATION_TYPES_CHANGED))
+			Expect(breakingChanges[1].GetRelation()).Should(Equal("member"))
+			Expect(breakingChanges[1].GetSubject()).Should(Equal("team#member"))
+			Expect(breakingChanges[1].GetTupleCount()).Should(Equal(uint64(1)))
+
+			Expect(breakingChanges[2].GetKind()).Should(Equal(base.SchemaChange_KIND_ENTITY_REMOVED))
+			Expect(breakingChanges[2].GetEntity()).Should(Equal("team"))
+			Expect(breakingChanges[2].GetTupleCount()).Should(Equal(uint64(2)))
+
+			head, err := schemaService.sr.HeadVersion(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(v1))
+
+			version, breakingChanges, err = schemaService.WriteSchema(ctx, "t1", next, true)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).ShouldNot(BeEmpty())
+			Expect(breakingChanges).Should(HaveLen(3))
+		})
+
+		It("Case 2", func() {
+			ctx := context.Background()
+
+			version, _, err := schemaService.WriteSchemaFiles(ctx, "t1", map[string]string{
+				"main.perm": `
+				import "org.perm"
+
+				entity repository {
+					relation parent @organization
+					permission read = parent.member
+				}`,
+				"org.perm": `
+				import "user.perm"
+
+				entity organization {
+					relation member @user
+				}`,
+				"user.perm": `entity user {}`,
+			}, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sch, err := schemaService.ReadSchema(ctx, "t1", version)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sch.GetEntityDefinitions()).Should(HaveLen(3))
+			Expect(sch.GetEntityDefinitions()).Should(HaveKey("repository"))
+
+			_, _, err = schemaService.WriteSchemaFiles(ctx, "t1", map[string]string{
+				"main.perm": `
+				entity repository {
+					relation owner @user
+				}`,
+				"user.perm": `entity user {}`,
+			}, false)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HavePrefix("main.perm:3:"))
+			Expect(err.Error()).Should(HaveSuffix("entity not imported"))
+		})
+	})
+
+	Context("Read", func() {
+		It("Case 1", func() {
+			ctx := context.Background()
+
+			version, _, err := schemaService.WriteSchema(ctx, "t1", `
+			// A person that can sign in.
+			entity user {}
+
+			// A group of users.
+			entity organization {
+				// members of the organization
+				relation member @user
+
+				// Anyone who can view the organization.
+				permission view = member
+			}`, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sch, err := schemaService.ReadSchema(ctx, "t1", version)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(sch.GetEntityDefinitions()["user"].GetDescription()).Should(Equal("A person that can sign in."))
+
+			organization := sch.GetEntityDefinitions()["organization"]
+			Expect(organization.GetDescription()).Should(Equal("A group of users."))
+			Expect(organization.GetRelations()["member"].GetDescription()).Should(Equal("members of the organization"))
+			Expect(organization.GetPermissions()["view"].GetDescription()).Should(Equal("Anyone who can view the organization."))
+		})
+	})
+})
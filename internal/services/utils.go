@@ -0,0 +1,107 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"permify/internal/schema"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
+)
+
+const (
+	// _auditPageSize - number of tuples read at once by a schema audit
+	_auditPageSize = 1000
+	// _auditDeleteBatchSize - maximum number of violations removed with a single deletion filter
+	_auditDeleteBatchSize = 100
+)
+
+// validateTuple - Checks that the relation of the tuple is defined in the entity definition and that the subject
+// is one of the types the relation allows.
+func validateTuple(entity *base.EntityDefinition, tup *base.Tuple) (err error) {
+	if tuple.IsEntityAndSubjectEquals(tup) {
+		return errors.New(base.ErrorCode_ERROR_CODE_ENTITY_AND_SUBJECT_CANNOT_BE_EQUAL.String())
+	}
+
+	var rel *base.RelationDefinition
+	rel, err = schema.GetRelationByNameInEntityDefinition(entity, tup.GetRelation())
+	if err != nil {
+		return err
+	}
+
+	var vt []string
+	for _, t := range rel.GetRelationReferences() {
+		if t.GetRelation() != "" {
+			vt = append(vt, fmt.Sprintf("%s#%s", t.GetType(), t.GetRelation()))
+		} else {
+			vt = append(vt, t.GetType())
+		}
+	}
+
+	return tuple.ValidateSubjectType(tup.GetSubject(), vt)
+}
+
+// auditDeleteFilters - Groups the violations of a schema audit into deletion filters. Apart from a tuple whose entity
+// and subject are equal, whether a tuple conforms depends only on its entity type, relation, subject type and subject
+// relation, so a filter on the entity and subject ids of violations of the same shape matches violations only, even
+// for the combinations of ids that were not reported. Tuples whose entity and subject are equal get a filter each.
+func auditDeleteFilters(violations []*base.SchemaAuditViolation, batchSize int) (filters []*base.TupleFilter) {
+	type shape struct {
+		entityType, relation, subjectType, subjectRelation string
+	}
+
+	var order []shape
+	groups := map[shape][]*base.Tuple{}
+	for _, violation := range violations {
+		tup := violation.GetTuple()
+		if tuple.IsEntityAndSubjectEquals(tup) {
+			filters = append(filters, auditDeleteFilter(tup.GetEntity().GetType(), tup.GetRelation(), tup.GetSubject().GetType(), tup.GetSubject().GetRelation(), []*base.Tuple{tup}))
+			continue
+		}
+		key := shape{tup.GetEntity().GetType(), tup.GetRelation(), tup.GetSubject().GetType(), tup.GetSubject().GetRelation()}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], tup)
+	}
+
+	for _, key := range order {
+		tuples := groups[key]
+		for start := 0; start < len(tuples); start += batchSize {
+			end := start + batchSize
+			if end > len(tuples) {
+				end = len(tuples)
+			}
+			filters = append(filters, auditDeleteFilter(key.entityType, key.relation, key.subjectType, key.subjectRelation, tuples[start:end]))
+		}
+	}
+	return filters
+}
+
+// auditDeleteFilter - returns the filter of the tuples of a shape on the entity and subject ids of the given ones
+func auditDeleteFilter(entityType, relation, subjectType, subjectRelation string, tuples []*base.Tuple) *base.TupleFilter {
+	var entityIDs, subjectIDs []string
+	seenEntities, seenSubjects := map[string]struct{}{}, map[string]struct{}{}
+	for _, tup := range tuples {
+		if _, ok := seenEntities[tup.GetEntity().GetId()]; !ok {
+			seenEntities[tup.GetEntity().GetId()] = struct{}{}
+			entityIDs = append(entityIDs, tup.GetEntity().GetId())
+		}
+		if _, ok := seenSubjects[tup.GetSubject().GetId()]; !ok {
+			seenSubjects[tup.GetSubject().GetId()] = struct{}{}
+			subjectIDs = append(subjectIDs, tup.GetSubject().GetId())
+		}
+	}
+	return &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: entityType,
+			Ids:  entityIDs,
+		},
+		Relation: relation,
+		Subject: &base.SubjectFilter{
+			Type:     subjectType,
+			Ids:      subjectIDs,
+			Relation: subjectRelation,
+		},
+	}
+}
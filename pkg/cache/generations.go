@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Generations - Tracks the generation of the cached entries of every tenant. Entries whose keys include the
+// generation of their tenant become unreachable when it is incremented, so the entries of a tenant are invalidated
+// without scanning the cache and are evicted over time.
+type Generations struct {
+	// generation of every tenant, incremented when all tenants are invalidated
+	all     uint64
+	tenants sync.Map
+}
+
+// NewGenerations - Creates new generations, every tenant starts with the same generation
+func NewGenerations() *Generations {
+	return &Generations{}
+}
+
+// Get - Returns the generation of the tenant to be included in the keys of its entries
+func (g *Generations) Get(tenantID string) string {
+	var tenant uint64
+	if v, ok := g.tenants.Load(tenantID); ok {
+		tenant = atomic.LoadUint64(v.(*uint64))
+	}
+	return strconv.FormatUint(atomic.LoadUint64(&g.all), 10) + "." + strconv.FormatUint(tenant, 10)
+}
+
+// Invalidate - Increments the generation of the tenant, or of every tenant if the tenant id is empty
+func (g *Generations) Invalidate(tenantID string) {
+	if tenantID == "" {
+		atomic.AddUint64(&g.all, 1)
+		return
+	}
+	v, _ := g.tenants.LoadOrStore(tenantID, new(uint64))
+	atomic.AddUint64(v.(*uint64), 1)
+}
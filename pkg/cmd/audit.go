@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"permify/pkg/cmd/flags"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
+)
+
+// NewAuditCommand - Creates new audit command
+func NewAuditCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "audit",
+		Short: "report relation tuples that do not conform to the authorization model",
+		RunE:  audit(),
+		Args:  cobra.NoArgs,
+	}
+
+	// register flags for the server connection
+	flags.RegisterClientFlags(command)
+
+	command.Flags().String("schema-version", "", "schema version to audit against, the latest one if empty")
+	command.Flags().String("snap-token", "", "snapshot to audit, the latest one if empty")
+	command.Flags().Bool("delete", false, "delete the non-conforming tuples")
+	command.Flags().String("output-format", "verbose", "output format. one of: verbose, json")
+
+	return command
+}
+
+// audit returns a function that audits the relation tuples of a tenant on a running server
+func audit() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fl, err := getFlags(cmd, []string{"tenant-id", "schema-version", "snap-token", "output-format"})
+		if err != nil {
+			return err
+		}
+
+		del, err := cmd.Flags().GetBool("delete")
+		if err != nil {
+			return err
+		}
+
+		conn, err := newClientConn(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		res, err := base.NewSchemaClient(conn).Audit(context.Background(), &base.SchemaAuditRequest{
+			TenantId: fl["tenant-id"],
+			Metadata: &base.SchemaAuditRequestMetadata{
+				SchemaVersion: fl["schema-version"],
+				SnapToken:     fl["snap-token"],
+			},
+			Delete: del,
+		})
+		if err != nil {
+			return err
+		}
+
+		if fl["output-format"] == "json" {
+			var b []byte
+			b, err = protojson.Marshal(res)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+		} else {
+			for _, violation := range res.GetViolations() {
+				color.Danger.Printf("fail:       %s ", tuple.ToString(violation.GetTuple()))
+				color.Danger.Println(validationError(violation.GetReason()))
+			}
+			if len(res.GetViolations()) == 0 {
+				color.Success.Println("SUCCESS")
+			} else if del {
+				color.Warn.Printf("%v non-conforming tuple(s) deleted, snap token: %s\n", len(res.GetViolations()), res.GetSnapToken())
+			} else {
+				color.Danger.Printf("%v non-conforming tuple(s) found\n", len(res.GetViolations()))
+			}
+		}
+
+		if len(res.GetViolations()) != 0 && !del {
+			os.Exit(1)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// presharedCredentials - Attaches the preshared key to every call as a bearer token
+type presharedCredentials struct {
+	key    string
+	secure bool
+}
+
+// GetRequestMetadata - Returns the authorization header of the call
+func (c presharedCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.key}, nil
+}
+
+// RequireTransportSecurity - Preshared keys can be sent over plain connections when tls is not enabled
+func (c presharedCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// newClientConn - Dials the permify server described by the client flags of the command
+func newClientConn(cmd *cobra.Command) (*grpc.ClientConn, error) {
+	endpoint, err := cmd.Flags().GetString("endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := cmd.Flags().GetString("api-key")
+	if err != nil {
+		return nil, err
+	}
+
+	secure, err := cmd.Flags().GetBool("tls")
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []grpc.DialOption
+	if secure {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if key != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(presharedCredentials{key: key, secure: secure}))
+	}
+
+	return grpc.Dial(endpoint, opts...)
+}
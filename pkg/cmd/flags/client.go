@@ -0,0 +1,14 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// RegisterClientFlags registers the flags of the commands that talk to a running permify server.
+func RegisterClientFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.String("endpoint", "localhost:3478", "grpc endpoint of the permify server")
+	flags.String("api-key", "", "preshared key sent as a bearer token")
+	flags.Bool("tls", false, "connect to the endpoint using tls")
+	flags.String("tenant-id", "t1", "tenant of the request")
+}
@@ -223,6 +223,14 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.StringSlice("service-snap-token-signing-keys", conf.Service.SnapToken.SigningKeys, "keys of the postgres and memory snap token signatures, the first one signs the tokens and every one verifies them")
+	if err = viper.BindPFlag("service.snap_token.signing_keys", flags.Lookup("service-snap-token-signing-keys")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.snap_token.signing_keys", "PERMIFY_SERVICE_SNAP_TOKEN_SIGNING_KEYS"); err != nil {
+		panic(err)
+	}
+
 	flags.Int64("service-schema-cache-number-of-counters", conf.Service.Schema.Cache.NumberOfCounters, "schema service cache number of counters")
 	if err = viper.BindPFlag("service.schema.cache.number_of_counters", flags.Lookup("service-schema-cache-number-of-counters")); err != nil {
 		panic(err)
@@ -247,6 +255,14 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.Bool("service-permission-recursive-evaluation", conf.Service.Permission.RecursiveEvaluation, "resolve self-referential relations, such as nested groups, with a single recursive query where the database supports it")
+	if err = viper.BindPFlag("service.permission.recursive_evaluation", flags.Lookup("service-permission-recursive-evaluation")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.recursive_evaluation", "PERMIFY_SERVICE_PERMISSION_RECURSIVE_EVALUATION"); err != nil {
+		panic(err)
+	}
+
 	flags.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
 	if err = viper.BindPFlag("service.permission.cache.number_of_counters", flags.Lookup("service-permission-cache-number-of-counters")); err != nil {
 		panic(err)
@@ -263,8 +279,48 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.Int64("service-relationship-stats-cache-number-of-counters", conf.Service.Relationship.Stats.Cache.NumberOfCounters, "relationship statistics cache number of counters")
+	if err = viper.BindPFlag("service.relationship.stats.cache.number_of_counters", flags.Lookup("service-relationship-stats-cache-number-of-counters")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.relationship.stats.cache.number_of_counters", "PERMIFY_SERVICE_RELATIONSHIP_STATS_CACHE_NUMBER_OF_COUNTERS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-relationship-stats-cache-max-cost", conf.Service.Relationship.Stats.Cache.MaxCost, "relationship statistics cache max cost")
+	if err = viper.BindPFlag("service.relationship.stats.cache.max_cost", flags.Lookup("service-relationship-stats-cache-max-cost")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.relationship.stats.cache.max_cost", "PERMIFY_SERVICE_RELATIONSHIP_STATS_CACHE_MAX_COST"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-relationship-stats-staleness", conf.Service.Relationship.Stats.Staleness, "how long relationship statistics are served from the cache, caching is disabled if zero")
+	if err = viper.BindPFlag("service.relationship.stats.staleness", flags.Lookup("service-relationship-stats-staleness")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.relationship.stats.staleness", "PERMIFY_SERVICE_RELATIONSHIP_STATS_STALENESS"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-relationship-coalescing-window", conf.Service.Relationship.Coalescing.Window, "how long a relationship query waits for others of the same shape while one is running, coalescing is disabled if zero")
+	if err = viper.BindPFlag("service.relationship.coalescing.window", flags.Lookup("service-relationship-coalescing-window")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.relationship.coalescing.window", "PERMIFY_SERVICE_RELATIONSHIP_COALESCING_WINDOW"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-relationship-coalescing-max-batch-size", conf.Service.Relationship.Coalescing.MaxBatchSize, "number of entity ids a coalesced relationship query is sent at")
+	if err = viper.BindPFlag("service.relationship.coalescing.max_batch_size", flags.Lookup("service-relationship-coalescing-max-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.relationship.coalescing.max_batch_size", "PERMIFY_SERVICE_RELATIONSHIP_COALESCING_MAX_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+
 	// DATABASE
-	flags.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
+	flags.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, mysql, sqlite, memory")
 	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
 		panic(err)
 	}
@@ -280,6 +336,22 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.StringSlice("database-read-replica-uris", conf.Database.ReadReplicaURIs, "uris of the postgres read replicas, reads are routed to them once they have replayed the requested snapshot")
+	if err = viper.BindPFlag("database.read_replica_uris", flags.Lookup("database-read-replica-uris")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.read_replica_uris", "PERMIFY_DATABASE_READ_REPLICA_URIS"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-read-replica-max-lag", conf.Database.ReadReplicaMaxLag, "how far behind the primary a read replica may be to serve the reads that are not bound to a snapshot, such as tenant lists")
+	if err = viper.BindPFlag("database.read_replica_max_lag", flags.Lookup("database-read-replica-max-lag")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.read_replica_max_lag", "PERMIFY_DATABASE_READ_REPLICA_MAX_LAG"); err != nil {
+		panic(err)
+	}
+
 	flags.Bool("database-auto-migrate", conf.Database.AutoMigrate, "auto migrate database tables")
 	if err = viper.BindPFlag("database.auto_migrate", flags.Lookup("database-auto-migrate")); err != nil {
 		panic(err)
@@ -320,6 +392,38 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.String("database-memory-path", conf.Database.Memory.Path, "directory of the write-ahead log and snapshot files of the memory engine, data is lost on restart if empty")
+	if err = viper.BindPFlag("database.memory.path", flags.Lookup("database-memory-path")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.memory.path", "PERMIFY_DATABASE_MEMORY_PATH"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-memory-sync", conf.Database.Memory.Sync, "when the write-ahead log of the memory engine is synced to disk, one of always, interval or never")
+	if err = viper.BindPFlag("database.memory.sync", flags.Lookup("database-memory-sync")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.memory.sync", "PERMIFY_DATABASE_MEMORY_SYNC"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-memory-sync-interval", conf.Database.Memory.SyncInterval, "how often the write-ahead log of the memory engine is synced to disk with the interval policy")
+	if err = viper.BindPFlag("database.memory.sync_interval", flags.Lookup("database-memory-sync-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.memory.sync_interval", "PERMIFY_DATABASE_MEMORY_SYNC_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-memory-compaction-interval", conf.Database.Memory.CompactionInterval, "how often the write-ahead log of the memory engine is compacted into the snapshot file")
+	if err = viper.BindPFlag("database.memory.compaction_interval", flags.Lookup("database-memory-compaction-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.memory.compaction_interval", "PERMIFY_DATABASE_MEMORY_COMPACTION_INTERVAL"); err != nil {
+		panic(err)
+	}
+
 	flags.Bool("database-garbage-collection-enable", conf.Database.DatabaseGarbageCollection.Enable, "use database garbage collection for expired relation tuples")
 	if err = viper.BindPFlag("database.garbage_collection.enable", flags.Lookup("database-garbage-collection-enable")); err != nil {
 		panic(err)
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"permify/pkg/dsl/formatter"
+)
+
+// NewFmtCommand - Creates new fmt command
+func NewFmtCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "fmt <file>",
+		Short: "format a schema file in the canonical layout, - reads from stdin",
+		RunE:  format(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	command.Flags().Bool("check", false, "do not print the formatted schema, fail if the file is not formatted")
+	command.Flags().Bool("write", false, "write the formatted schema to the file instead of stdout")
+
+	return command
+}
+
+// format returns a function that prints, checks or rewrites a schema file in the canonical layout
+func format() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		check, err := cmd.Flags().GetBool("check")
+		if err != nil {
+			return err
+		}
+
+		write, err := cmd.Flags().GetBool("write")
+		if err != nil {
+			return err
+		}
+
+		path := args[0]
+		if write && path == "-" {
+			return errors.New("--write cannot be used with stdin")
+		}
+
+		var source []byte
+		if path == "-" {
+			source, err = io.ReadAll(os.Stdin)
+		} else {
+			source, err = os.ReadFile(path)
+		}
+		if err != nil {
+			return err
+		}
+
+		formatted, err := formatter.Format(string(source))
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case check:
+			if formatted != string(source) {
+				color.Danger.Printf("%s is not formatted\n", path)
+				os.Exit(1)
+			}
+		case write:
+			if formatted != string(source) {
+				var info os.FileInfo
+				info, err = os.Stat(path)
+				if err != nil {
+					return err
+				}
+				return os.WriteFile(path, []byte(formatted), info.Mode())
+			}
+		default:
+			fmt.Print(formatted)
+		}
+
+		return nil
+	}
+}
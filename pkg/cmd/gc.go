@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"permify/pkg/cmd/flags"
+	base "permify/pkg/pb/base/v1"
+)
+
+// NewGarbageCollectCommand - Creates new gc command
+func NewGarbageCollectCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "gc",
+		Short: "run a garbage collection pass on a running server",
+		RunE:  garbageCollect(),
+		Args:  cobra.NoArgs,
+	}
+
+	// register flags for the server connection
+	flags.RegisterClientFlags(command)
+
+	// every tenant is collected unless one is given
+	tenant := command.Flags().Lookup("tenant-id")
+	tenant.DefValue = ""
+	tenant.Usage = "tenant to collect, every tenant if empty"
+	if err := tenant.Value.Set(""); err != nil {
+		panic(err)
+	}
+
+	command.Flags().Duration("window", 0, "remove the tuples expired and the transactions recorded before the window, the window of the server if not set")
+	command.Flags().Bool("dry-run", false, "report the rows that would be removed without removing them")
+	command.Flags().String("output-format", "verbose", "output format. one of: verbose, json")
+
+	return command
+}
+
+// garbageCollect returns a function that runs a garbage collection pass on a running server
+func garbageCollect() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fl, err := getFlags(cmd, []string{"tenant-id", "output-format"})
+		if err != nil {
+			return err
+		}
+
+		window, err := cmd.Flags().GetDuration("window")
+		if err != nil {
+			return err
+		}
+
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+
+		conn, err := newClientConn(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		request := &base.GarbageCollectRequest{
+			TenantId: fl["tenant-id"],
+			DryRun:   dryRun,
+		}
+		if window > 0 {
+			request.Window = durationpb.New(window)
+		}
+
+		res, err := base.NewAdminClient(conn).GarbageCollect(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		if fl["output-format"] == "json" {
+			var b []byte
+			b, err = protojson.Marshal(res)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			return nil
+		}
+
+		verb := "removed"
+		if res.GetDryRun() {
+			verb = "would be removed"
+		}
+
+		var tuples, transactions uint64
+		for _, result := range res.GetResults() {
+			fmt.Printf("%s: %v relation tuple(s), %v transaction(s) %s\n", result.GetTenantId(), result.GetRelationTuples(), result.GetTransactions(), verb)
+			tuples += result.GetRelationTuples()
+			transactions += result.GetTransactions()
+		}
+		color.Success.Printf("%v relation tuple(s) and %v transaction(s) %s from %v tenant(s)\n", tuples, transactions, verb, len(res.GetResults()))
+
+		return nil
+	}
+}
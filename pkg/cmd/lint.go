@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"permify/pkg/dsl/ast"
+	"permify/pkg/dsl/linter"
+	"permify/pkg/dsl/parser"
+	base "permify/pkg/pb/base/v1"
+)
+
+// NewLintCommand - Creates new lint command
+func NewLintCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "lint <file>",
+		Short: "report problems of a schema file that compiles, - reads from stdin",
+		RunE:  lint(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	command.Flags().String("output-format", "verbose", "output format. one of: verbose, json")
+
+	return command
+}
+
+// lint returns a function that reports the lint warnings of a schema file, it exits with 1 if there are any
+func lint() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fl, err := getFlags(cmd, []string{"output-format"})
+		if err != nil {
+			return err
+		}
+
+		path := args[0]
+
+		var sch *ast.Schema
+		if path == "-" {
+			var source []byte
+			source, err = io.ReadAll(os.Stdin)
+			if err != nil {
+				return err
+			}
+			sch, err = parser.NewParser(string(source)).Parse()
+		} else {
+			var source []byte
+			source, err = os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			// the name of the file is kept in the positions of the warnings
+			sch, err = parser.ParseFiles(map[string]string{path: string(source)})
+		}
+		if err != nil {
+			return err
+		}
+
+		warnings, err := linter.NewLinter(sch).Lint()
+		if err != nil {
+			return err
+		}
+
+		if fl["output-format"] == "json" {
+			var b []byte
+			b, err = protojson.Marshal(&base.SchemaLintWarnings{Warnings: warnings.ToSchemaLintWarnings()})
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+		} else {
+			for _, warning := range warnings {
+				color.Warn.Println(warning.String())
+			}
+			if len(warnings) == 0 {
+				color.Success.Println("SUCCESS")
+			} else {
+				color.Warn.Printf("%v warning(s) found\n", len(warnings))
+			}
+		}
+
+		if len(warnings) != 0 {
+			os.Exit(1)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"permify/pkg/lsp"
+)
+
+// NewLSPCommand - Creates new lsp command
+func NewLSPCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lsp",
+		Short: "run a language server for the permify schema language over stdio",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return lsp.NewServer(os.Stdin, os.Stdout).Serve()
+		},
+	}
+}
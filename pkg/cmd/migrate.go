@@ -117,7 +117,7 @@ func migrateUp() func(cmd *cobra.Command, args []string) error {
 		}
 
 		if p == 0 {
-			if err := goose.Up(db, "/app/internal/repositories/postgres/migrations"); err != nil {
+			if err := goose.Up(db, migrationsDir(flags[databaseEngine])); err != nil {
 				color.Warn.Println("migration failed: up error " + err.Error())
 				return nil
 			}
@@ -126,7 +126,7 @@ func migrateUp() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		if err := goose.UpTo(db, "/app/internal/repositories/postgres/migrations", p); err != nil {
+		if err := goose.UpTo(db, migrationsDir(flags[databaseEngine]), p); err != nil {
 			color.Warn.Println("migration failed: Goose Up Error")
 			return nil
 		}
@@ -163,7 +163,7 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 
 		if p == 0 {
 			var count int
-			err = filepath.Walk("/app/internal/repositories/postgres/migrations", func(path string, info os.FileInfo, err error) error {
+			err = filepath.Walk(migrationsDir(flags[databaseEngine]), func(path string, info os.FileInfo, err error) error {
 				if !info.IsDir() && strings.HasSuffix(info.Name(), ".sql") {
 					count++
 				}
@@ -175,7 +175,7 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 			}
 
 			for i := 0; i < count; i++ {
-				if err := goose.Down(db, "/app/internal/repositories/postgres/migrations"); err != nil {
+				if err := goose.Down(db, migrationsDir(flags[databaseEngine])); err != nil {
 					color.Warn.Println("migration failed: down error " + err.Error())
 					return nil
 				}
@@ -185,7 +185,7 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		if err := goose.DownTo(db, "/app/internal/repositories/postgres/migrations", p); err != nil {
+		if err := goose.DownTo(db, migrationsDir(flags[databaseEngine]), p); err != nil {
 			color.Warn.Println("migration failed: down error " + err.Error())
 
 			return nil
@@ -216,7 +216,7 @@ func migrateStatus() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		if err := goose.Status(db, "/app/internal/repositories/postgres/migrations"); err != nil {
+		if err := goose.Status(db, migrationsDir(flags[databaseEngine])); err != nil {
 			color.Warn.Println("migration failed: check status error " + err.Error())
 			return nil
 		}
@@ -225,6 +225,18 @@ func migrateStatus() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// migrationsDir - returns the directory of the migrations of a database driver
+func migrationsDir(driver string) string {
+	switch driver {
+	case "mysql":
+		return "/app/internal/repositories/mysql/migrations"
+	case "sqlite":
+		return "/app/internal/repositories/sqlite/migrations"
+	default:
+		return "/app/internal/repositories/postgres/migrations"
+	}
+}
+
 func getFlags(cmd *cobra.Command, flags []string) (map[string]string, error) {
 	resp := make(map[string]string, len(flags))
 
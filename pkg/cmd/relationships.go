@@ -0,0 +1,540 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	validation "github.com/envoyproxy/protoc-gen-validate/validate"
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"permify/pkg/cmd/flags"
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
+)
+
+var (
+	// writeBatchSize - number of tuples sent per write request, the max_items limit the server validates
+	// RelationshipWriteRequest with
+	writeBatchSize = int(validationLimit(&base.RelationshipWriteRequest{}, "tuples", 100))
+	// readPageSize - number of tuples fetched per read request, the page_size limit the server validates
+	// RelationshipReadRequest with
+	readPageSize = uint32(validationLimit(&base.RelationshipReadRequest{}, "page_size", 100))
+	// deleteBatchSize - number of tuples of a file read before their deletion filters are sent
+	deleteBatchSize = writeBatchSize
+)
+
+// validationLimit - returns the upper bound of the validation rules of a request field, the max_items of a repeated
+// field or the lte of an uint32 one, so the batches follow the limits of the server. The fallback is returned if the
+// field has no such rule.
+func validationLimit(msg proto.Message, name protoreflect.Name, fallback uint64) uint64 {
+	field := msg.ProtoReflect().Descriptor().Fields().ByName(name)
+	if field == nil {
+		return fallback
+	}
+	rules, ok := proto.GetExtension(field.Options(), validation.E_Rules).(*validation.FieldRules)
+	if !ok {
+		return fallback
+	}
+	switch {
+	case rules.GetRepeated().GetMaxItems() > 0:
+		return rules.GetRepeated().GetMaxItems()
+	case rules.GetUint32().GetLte() > 0:
+		return uint64(rules.GetUint32().GetLte())
+	default:
+		return fallback
+	}
+}
+
+// NewRelationshipsCommand - Creates new relationships command
+func NewRelationshipsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relationships",
+		Short: "import, export and delete relation tuples of a running server",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewRelationshipsImportCommand())
+	cmd.AddCommand(NewRelationshipsExportCommand())
+	cmd.AddCommand(NewRelationshipsDeleteCommand())
+
+	return cmd
+}
+
+// NewRelationshipsImportCommand - Creates new relationships import command
+func NewRelationshipsImportCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "import <file>",
+		Short: "write the relation tuples of a file, - reads from stdin",
+		RunE:  importRelationships(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	flags.RegisterClientFlags(command)
+	command.Flags().String("format", "", "format of the file. one of: text, csv, jsonl. guessed from the extension if empty")
+	command.Flags().String("schema-version", "", "schema version the tuples are validated against, the latest one if empty")
+
+	return command
+}
+
+// NewRelationshipsExportCommand - Creates new relationships export command
+func NewRelationshipsExportCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "export [file]",
+		Short: "read the relation tuples into a file, stdout if no file is given",
+		RunE:  exportRelationships(),
+		Args:  cobra.MaximumNArgs(1),
+	}
+
+	flags.RegisterClientFlags(command)
+	registerTupleFilterFlags(command)
+	command.Flags().String("format", "", "format of the file. one of: text, csv, jsonl. guessed from the extension if empty")
+	command.Flags().String("snap-token", "", "snapshot to export, the latest one if empty")
+
+	return command
+}
+
+// NewRelationshipsDeleteCommand - Creates new relationships delete command
+func NewRelationshipsDeleteCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "delete [file]",
+		Short: "delete the relation tuples of a file, or the ones matching the filter flags if no file is given",
+		RunE:  deleteRelationships(),
+		Args:  cobra.MaximumNArgs(1),
+	}
+
+	flags.RegisterClientFlags(command)
+	registerTupleFilterFlags(command)
+	command.Flags().String("format", "", "format of the file. one of: text, csv, jsonl. guessed from the extension if empty")
+
+	return command
+}
+
+// registerTupleFilterFlags - registers the flags building a tuple filter
+func registerTupleFilterFlags(command *cobra.Command) {
+	command.Flags().String("entity-type", "", "entity type of the tuples")
+	command.Flags().StringSlice("entity-ids", nil, "entity ids of the tuples")
+	command.Flags().String("relation", "", "relation of the tuples")
+	command.Flags().String("subject-type", "", "subject type of the tuples")
+	command.Flags().StringSlice("subject-ids", nil, "subject ids of the tuples")
+	command.Flags().String("subject-relation", "", "subject relation of the tuples")
+}
+
+// tupleFilterFromFlags - builds the tuple filter described by the filter flags
+func tupleFilterFromFlags(cmd *cobra.Command) (*base.TupleFilter, error) {
+	fl, err := getFlags(cmd, []string{"entity-type", "relation", "subject-type", "subject-relation"})
+	if err != nil {
+		return nil, err
+	}
+
+	entityIds, err := cmd.Flags().GetStringSlice("entity-ids")
+	if err != nil {
+		return nil, err
+	}
+
+	subjectIds, err := cmd.Flags().GetStringSlice("subject-ids")
+	if err != nil {
+		return nil, err
+	}
+
+	return &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: fl["entity-type"],
+			Ids:  entityIds,
+		},
+		Relation: fl["relation"],
+		Subject: &base.SubjectFilter{
+			Type:     fl["subject-type"],
+			Ids:      subjectIds,
+			Relation: fl["subject-relation"],
+		},
+	}, nil
+}
+
+// tupleFormat - returns the format given with the format flag, or the one guessed from the path
+func tupleFormat(cmd *cobra.Command, path string) (tuple.Format, error) {
+	name, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		return tuple.FormatFromPath(path), nil
+	}
+	return tuple.ParseFormat(name)
+}
+
+// openTupleDecoder - opens the file, or stdin for -, and creates a decoder reading it
+func openTupleDecoder(cmd *cobra.Command, path string) (*tuple.Decoder, io.Closer, error) {
+	format, err := tupleFormat(cmd, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var r io.ReadCloser = os.Stdin
+	if path != "-" {
+		r, err = os.Open(path)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	decoder, err := tuple.NewDecoder(format, r)
+	if err != nil {
+		r.Close()
+		return nil, nil, err
+	}
+	return decoder, r, nil
+}
+
+// importRelationships returns a function that writes the tuples of a file in batches
+func importRelationships() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fl, err := getFlags(cmd, []string{"tenant-id", "schema-version"})
+		if err != nil {
+			return err
+		}
+
+		decoder, closer, err := openTupleDecoder(cmd, args[0])
+		if err != nil {
+			return err
+		}
+		defer closer.Close()
+
+		conn, err := newClientConn(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		client := base.NewRelationshipClient(conn)
+		ctx := context.Background()
+
+		var count int
+		var snapToken string
+		batch := make([]*base.Tuple, 0, writeBatchSize)
+
+		// write sends the pending batch and reports the lines it was read from on failure
+		write := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			res, err := client.Write(ctx, &base.RelationshipWriteRequest{
+				TenantId: fl["tenant-id"],
+				Metadata: &base.RelationshipWriteRequestMetadata{
+					SchemaVersion: fl["schema-version"],
+				},
+				Tuples: batch,
+			})
+			if err != nil {
+				return fmt.Errorf("batch ending at line %d: %w", decoder.Line(), err)
+			}
+			count += len(batch)
+			snapToken = res.GetSnapToken()
+			batch = batch[:0]
+			return nil
+		}
+
+		for {
+			var tup *base.Tuple
+			tup, err = decoder.Decode()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			batch = append(batch, tup)
+			if len(batch) == writeBatchSize {
+				if err = write(); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err = write(); err != nil {
+			return err
+		}
+
+		color.Success.Printf("%v tuple(s) imported, snap token: %s\n", count, snapToken)
+		return nil
+	}
+}
+
+// exportRelationships returns a function that reads the tuples matching the filter flags page by page
+func exportRelationships() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fl, err := getFlags(cmd, []string{"tenant-id", "snap-token"})
+		if err != nil {
+			return err
+		}
+
+		filter, err := tupleFilterFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		path := "-"
+		if len(args) > 0 {
+			path = args[0]
+		}
+
+		format, err := tupleFormat(cmd, path)
+		if err != nil {
+			return err
+		}
+
+		var w io.WriteCloser = os.Stdout
+		if path != "-" {
+			w, err = os.Create(path)
+			if err != nil {
+				return err
+			}
+			defer w.Close()
+		}
+
+		encoder, err := tuple.NewEncoder(format, w)
+		if err != nil {
+			return err
+		}
+
+		conn, err := newClientConn(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		client := base.NewRelationshipClient(conn)
+		ctx := context.Background()
+
+		// every page is read at the same snapshot, the continuous token alone does not pin it, so the head snap token
+		// is taken up front when none is given
+		snapToken := fl["snap-token"]
+		if snapToken == "" {
+			var stats *base.RelationshipStatsResponse
+			stats, err = client.Stats(ctx, &base.RelationshipStatsRequest{
+				TenantId: fl["tenant-id"],
+				Metadata: &base.RelationshipStatsRequestMetadata{},
+				Top:      1,
+			})
+			if err != nil {
+				return err
+			}
+			snapToken = stats.GetSnapToken()
+		}
+
+		var count int
+		var ct string
+		for {
+			res, err := client.Read(ctx, &base.RelationshipReadRequest{
+				TenantId: fl["tenant-id"],
+				Metadata: &base.RelationshipReadRequestMetadata{
+					SnapToken: snapToken,
+				},
+				Filter:          filter,
+				PageSize:        readPageSize,
+				ContinuousToken: ct,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, tup := range res.GetTuples() {
+				if err = encoder.Encode(tup); err != nil {
+					return err
+				}
+			}
+			count += len(res.GetTuples())
+
+			ct = res.GetContinuousToken()
+			if ct == "" {
+				break
+			}
+		}
+
+		if err = encoder.Flush(); err != nil {
+			return err
+		}
+
+		if path != "-" {
+			color.Success.Printf("%v tuple(s) exported\n", count)
+		}
+		return nil
+	}
+}
+
+// deleteGroup - Tuples of a file that only differ in their subject ids, they are deleted with a single filter
+type deleteGroup struct {
+	entityType, entityID, relation, subjectType, subjectRelation string
+}
+
+// newDeleteGroup - returns the group of the tuple. Subjects other than users without a relation are stored with
+// the ellipsis relation, and the filters ignore an empty relation, so it is set explicitly.
+func newDeleteGroup(tup *base.Tuple) deleteGroup {
+	relation := tup.GetSubject().GetRelation()
+	if !tuple.IsSubjectUser(tup.GetSubject()) && relation == "" {
+		relation = tuple.ELLIPSIS
+	}
+	return deleteGroup{
+		entityType:      tup.GetEntity().GetType(),
+		entityID:        tup.GetEntity().GetId(),
+		relation:        tup.GetRelation(),
+		subjectType:     tup.GetSubject().GetType(),
+		subjectRelation: relation,
+	}
+}
+
+// filter - returns the filter matching the tuples of the group with the given subject ids only
+func (g deleteGroup) filter(subjectIDs []string) *base.TupleFilter {
+	return &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: g.entityType,
+			Ids:  []string{g.entityID},
+		},
+		Relation: g.relation,
+		Subject: &base.SubjectFilter{
+			Type:     g.subjectType,
+			Ids:      subjectIDs,
+			Relation: g.subjectRelation,
+		},
+	}
+}
+
+// deleteBatch - Tuples of a file that are deleted together, grouped into the filters they are deleted with
+type deleteBatch struct {
+	order  []deleteGroup
+	groups map[deleteGroup][]string
+	size   int
+}
+
+// newDeleteBatch - Creates an empty delete batch
+func newDeleteBatch() *deleteBatch {
+	return &deleteBatch{groups: map[deleteGroup][]string{}}
+}
+
+// add - adds the tuple to the subject ids of its group
+func (b *deleteBatch) add(tup *base.Tuple) {
+	group := newDeleteGroup(tup)
+	if _, ok := b.groups[group]; !ok {
+		b.order = append(b.order, group)
+	}
+	b.groups[group] = append(b.groups[group], tup.GetSubject().GetId())
+	b.size++
+}
+
+// filters - returns a filter per group, in the order the groups are first seen in the file
+func (b *deleteBatch) filters() []*base.TupleFilter {
+	filters := make([]*base.TupleFilter, 0, len(b.order))
+	for _, group := range b.order {
+		filters = append(filters, group.filter(b.groups[group]))
+	}
+	return filters
+}
+
+// deleteBatches - reads tuples until io.EOF and sends them in batches of at most size tuples, so the subject ids of
+// a filter stay in the limits of the server
+func deleteBatches(next func() (*base.Tuple, error), size int, send func(*deleteBatch) error) error {
+	batch := newDeleteBatch()
+	for {
+		tup, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		batch.add(tup)
+		if batch.size == size {
+			if err = send(batch); err != nil {
+				return err
+			}
+			batch = newDeleteBatch()
+		}
+	}
+
+	if batch.size == 0 {
+		return nil
+	}
+	return send(batch)
+}
+
+// deleteRelationships returns a function that deletes the tuples of a file in batches, or the ones matching the filter flags
+func deleteRelationships() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fl, err := getFlags(cmd, []string{"tenant-id"})
+		if err != nil {
+			return err
+		}
+
+		conn, err := newClientConn(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		client := base.NewRelationshipClient(conn)
+		ctx := context.Background()
+
+		if len(args) == 0 {
+			var filter *base.TupleFilter
+			filter, err = tupleFilterFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			// an empty filter would delete every tuple of the tenant
+			if filter.GetEntity().GetType() == "" {
+				return errors.New("entity-type flag is required when no file is given")
+			}
+
+			var res *base.RelationshipDeleteResponse
+			res, err = client.Delete(ctx, &base.RelationshipDeleteRequest{
+				TenantId: fl["tenant-id"],
+				Filter:   filter,
+			})
+			if err != nil {
+				return err
+			}
+
+			color.Success.Printf("tuples deleted, snap token: %s\n", res.GetSnapToken())
+			return nil
+		}
+
+		decoder, closer, err := openTupleDecoder(cmd, args[0])
+		if err != nil {
+			return err
+		}
+		defer closer.Close()
+
+		var count int
+		var snapToken string
+
+		// every batch is sent as a filter per group and the lines it was read from are reported on failure
+		err = deleteBatches(decoder.Decode, deleteBatchSize, func(batch *deleteBatch) error {
+			for _, filter := range batch.filters() {
+				res, err := client.Delete(ctx, &base.RelationshipDeleteRequest{
+					TenantId: fl["tenant-id"],
+					Filter:   filter,
+				})
+				if err != nil {
+					return fmt.Errorf("batch ending at line %d: %w", decoder.Line(), err)
+				}
+				snapToken = res.GetSnapToken()
+			}
+			count += batch.size
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		color.Success.Printf("%v tuple(s) deleted, snap token: %s\n", count, snapToken)
+		return nil
+	}
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	base "permify/pkg/pb/base/v1"
+	"permify/pkg/tuple"
+)
+
+var _ = Describe("relationships", func() {
+	// tuples - parses the tuples, failing the spec on an invalid one
+	tuples := func(values ...string) []*base.Tuple {
+		result := make([]*base.Tuple, 0, len(values))
+		for _, v := range values {
+			tup, err := tuple.Tuple(v)
+			Expect(err).ShouldNot(HaveOccurred())
+			result = append(result, tup)
+		}
+		return result
+	}
+
+	// reader - returns the tuples one by one and io.EOF after them
+	reader := func(tups []*base.Tuple) func() (*base.Tuple, error) {
+		return func() (*base.Tuple, error) {
+			if len(tups) == 0 {
+				return nil, io.EOF
+			}
+			tup := tups[0]
+			tups = tups[1:]
+			return tup, nil
+		}
+	}
+
+	Context("validationLimit", func() {
+		It("should follow the validation rules of the requests", func() {
+			tests := []struct {
+				msg      proto.Message
+				field    protoreflect.Name
+				fallback uint64
+				expected uint64
+			}{
+				{&base.RelationshipWriteRequest{}, "tuples", 7, 100},
+				{&base.RelationshipReadRequest{}, "page_size", 7, 100},
+				{&base.RelationshipWriteRequest{}, "tenant_id", 7, 7},
+				{&base.RelationshipWriteRequest{}, "missing", 7, 7},
+			}
+
+			for _, tt := range tests {
+				Expect(validationLimit(tt.msg, tt.field, tt.fallback)).Should(Equal(tt.expected))
+			}
+
+			Expect(writeBatchSize).Should(Equal(100))
+			Expect(readPageSize).Should(Equal(uint32(100)))
+			Expect(deleteBatchSize).Should(Equal(writeBatchSize))
+		})
+	})
+
+	Context("deleteGroup", func() {
+		It("should group the tuples that only differ in their subject ids", func() {
+			tests := []struct {
+				tuples   []string
+				expected []*base.TupleFilter
+			}{
+				{
+					tuples: []string{
+						"doc:1#owner@user:1",
+						"doc:1#owner@user:2",
+						"doc:2#owner@user:1",
+					},
+					expected: []*base.TupleFilter{
+						{
+							Entity:   &base.EntityFilter{Type: "doc", Ids: []string{"1"}},
+							Relation: "owner",
+							Subject:  &base.SubjectFilter{Type: "user", Ids: []string{"1", "2"}},
+						},
+						{
+							Entity:   &base.EntityFilter{Type: "doc", Ids: []string{"2"}},
+							Relation: "owner",
+							Subject:  &base.SubjectFilter{Type: "user", Ids: []string{"1"}},
+						},
+					},
+				},
+				{
+					// subjects other than users without a relation are matched by the ellipsis relation
+					tuples: []string{
+						"doc:1#parent@folder:1",
+						"doc:1#parent@folder:2#...",
+						"doc:1#viewer@team:1#member",
+						"doc:1#viewer@team:2",
+					},
+					expected: []*base.TupleFilter{
+						{
+							Entity:   &base.EntityFilter{Type: "doc", Ids: []string{"1"}},
+							Relation: "parent",
+							Subject:  &base.SubjectFilter{Type: "folder", Ids: []string{"1", "2"}, Relation: tuple.ELLIPSIS},
+						},
+						{
+							Entity:   &base.EntityFilter{Type: "doc", Ids: []string{"1"}},
+							Relation: "viewer",
+							Subject:  &base.SubjectFilter{Type: "team", Ids: []string{"1"}, Relation: "member"},
+						},
+						{
+							Entity:   &base.EntityFilter{Type: "doc", Ids: []string{"1"}},
+							Relation: "viewer",
+							Subject:  &base.SubjectFilter{Type: "team", Ids: []string{"2"}, Relation: tuple.ELLIPSIS},
+						},
+					},
+				},
+			}
+
+			for _, tt := range tests {
+				batch := newDeleteBatch()
+				for _, tup := range tuples(tt.tuples...) {
+					batch.add(tup)
+				}
+				Expect(batch.size).Should(Equal(len(tt.tuples)))
+				Expect(batch.filters()).Should(Equal(tt.expected))
+			}
+		})
+	})
+
+	Context("deleteBatches", func() {
+		It("should split the tuples into batches of the given size", func() {
+			tests := []struct {
+				tuples   int
+				size     int
+				expected []int
+			}{
+				{0, 2, []int{}},
+				{1, 2, []int{1}},
+				{4, 2, []int{2, 2}},
+				{5, 2, []int{2, 2, 1}},
+				{3, 100, []int{3}},
+			}
+
+			for _, tt := range tests {
+				tups := make([]*base.Tuple, 0, tt.tuples)
+				for i := 0; i < tt.tuples; i++ {
+					tups = append(tups, &base.Tuple{
+						Entity:   &base.Entity{Type: "doc", Id: "1"},
+						Relation: "owner",
+						Subject:  &base.Subject{Type: "user", Id: string(rune('a' + i))},
+					})
+				}
+
+				sizes := []int{}
+				err := deleteBatches(reader(tups), tt.size, func(batch *deleteBatch) error {
+					sizes = append(sizes, batch.size)
+					for _, filter := range batch.filters() {
+						Expect(len(filter.GetSubject().GetIds())).Should(BeNumerically("<=", tt.size))
+					}
+					return nil
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(sizes).Should(Equal(tt.expected))
+			}
+		})
+
+		It("should stop at the first error", func() {
+			sent := 0
+			err := deleteBatches(reader(tuples("doc:1#owner@user:1", "doc:1#owner@user:2", "doc:1#owner@user:3")), 1, func(batch *deleteBatch) error {
+				sent++
+				return errors.New("unavailable")
+			})
+			Expect(err).Should(MatchError("unavailable"))
+			Expect(sent).Should(Equal(1))
+
+			err = deleteBatches(func() (*base.Tuple, error) {
+				return nil, errors.New("invalid line")
+			}, 1, func(batch *deleteBatch) error {
+				return nil
+			})
+			Expect(err).Should(MatchError("invalid line"))
+		})
+	})
+})
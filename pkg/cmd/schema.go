@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"permify/internal/schema"
+	"permify/pkg/cmd/flags"
+	"permify/pkg/development/graph"
+	base "permify/pkg/pb/base/v1"
+)
+
+// NewSchemaCommand - Creates new schema command
+func NewSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "manage the authorization model of a running server",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewSchemaPullCommand())
+	cmd.AddCommand(NewSchemaGraphCommand())
+
+	return cmd
+}
+
+// NewSchemaPullCommand - Creates new schema pull command
+func NewSchemaPullCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "pull [file]",
+		Short: "read the authorization model into a schema file, stdout if no file is given",
+		RunE:  pullSchema(),
+		Args:  cobra.MaximumNArgs(1),
+	}
+
+	flags.RegisterClientFlags(command)
+	command.Flags().String("schema-version", "", "schema version to pull, the latest one if empty")
+
+	return command
+}
+
+// pullSchema returns a function that reads the schema of a tenant from a running server as the source of the Permify DSL
+func pullSchema() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fl, err := getFlags(cmd, []string{"tenant-id", "schema-version"})
+		if err != nil {
+			return err
+		}
+
+		conn, err := newClientConn(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		res, err := base.NewSchemaClient(conn).Read(context.Background(), &base.SchemaReadRequest{
+			TenantId: fl["tenant-id"],
+			Metadata: &base.SchemaReadRequestMetadata{
+				SchemaVersion: fl["schema-version"],
+				Format:        "dsl",
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 || args[0] == "-" {
+			_, err = os.Stdout.WriteString(res.GetDsl())
+			return err
+		}
+
+		return os.WriteFile(args[0], []byte(res.GetDsl()), 0o644)
+	}
+}
+
+// NewSchemaGraphCommand - Creates new schema graph command
+func NewSchemaGraphCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "graph <file>",
+		Short: "draw the entities, relations and permissions of a schema file, - reads from stdin",
+		RunE:  graphSchema(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	command.Flags().String("format", "dot", "format of the graph. one of: dot, mermaid, json")
+
+	return command
+}
+
+// graphSchema returns a function that prints the graph of a schema file
+func graphSchema() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		format, err := cmd.Flags().GetString("format")
+		if err != nil {
+			return err
+		}
+		if format != "dot" && format != "mermaid" && format != "json" {
+			return fmt.Errorf("unknown graph format %q, expected one of: dot, mermaid, json", format)
+		}
+
+		var source []byte
+		if args[0] == "-" {
+			source, err = io.ReadAll(os.Stdin)
+		} else {
+			source, err = os.ReadFile(args[0])
+		}
+		if err != nil {
+			return err
+		}
+
+		sch, err := schema.NewSchemaFromStringDefinitions(false, string(source))
+		if err != nil {
+			return err
+		}
+
+		g, err := graph.SchemaToGraph(sch)
+		if err != nil {
+			return err
+		}
+
+		switch format {
+		case "mermaid":
+			fmt.Print(g.Mermaid())
+		case "json":
+			var b []byte
+			b, err = g.JSON()
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+		default:
+			fmt.Print(g.DOT())
+		}
+
+		return nil
+	}
+}
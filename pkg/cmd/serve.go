@@ -3,8 +3,6 @@ package cmd
 import (
 	"context"
 	"os/signal"
-	"permify/internal/repositories/postgres"
-	PQDatabase "permify/pkg/database/postgres"
 	"syscall"
 
 	"go.opentelemetry.io/otel/sdk/metric"
@@ -32,6 +30,7 @@ import (
 	"permify/pkg/telemetry"
 	"permify/pkg/telemetry/meterexporters"
 	"permify/pkg/telemetry/tracerexporters"
+	"permify/pkg/token"
 )
 
 // NewServeCommand returns a new Cobra command that can be used to run the "permify serve" command.
@@ -103,21 +102,6 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			}()
 		}
 
-		// Garbage collection
-		if cfg.DatabaseGarbageCollection.Enable && cfg.Database.Engine != "memory" {
-			l.Info("🗑️ starting database garbage collection...")
-			gc := postgres.NewGarbageCollector(ctx, db.(*PQDatabase.Postgres), l, cfg.DatabaseGarbageCollection)
-
-			err := gc.Start()
-			if err != nil {
-				l.Fatal(err)
-			}
-
-			defer func() {
-				gc.Stop()
-			}()
-		}
-
 		// Meter
 		// meter := telemetry.NewNoopMeter()
 		if cfg.Meter.Enabled {
@@ -133,6 +117,21 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			}
 		}
 
+		// Garbage collection, the collector also serves the admin api when the periodic collection is disabled
+		gc := factories.GarbageCollectorFactory(ctx, db, l, cfg.DatabaseGarbageCollection)
+		if gc != nil && cfg.DatabaseGarbageCollection.Enable {
+			l.Info("🗑️ starting database garbage collection...")
+
+			err = gc.Start()
+			if err != nil {
+				l.Fatal(err)
+			}
+
+			defer func() {
+				gc.Stop()
+			}()
+		}
+
 		// schema cache
 		var schemaCache cache.Cache
 		schemaCache, err = ristretto.New(ristretto.NumberOfCounters(cfg.Schema.Cache.NumberOfCounters), ristretto.MaxCost(cfg.Schema.Cache.MaxCost))
@@ -147,31 +146,81 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			l.Fatal(err)
 		}
 
+		// snap tokens of the postgres and in-memory databases are bound to their tenant and signed, the tokens of the
+		// other databases are not signed
+		var snapTokenKeys *token.SigningKeys
+		if engine := db.GetEngineType(); engine == database.POSTGRES.String() || engine == database.MEMORY.String() {
+			signingKeys := cfg.Service.SnapToken.SigningKeys
+			if len(signingKeys) == 0 {
+				var key string
+				key, err = token.GenerateSigningKey()
+				if err != nil {
+					l.Fatal(err)
+				}
+				signingKeys = []string{key}
+				l.Warn("snap token signing keys are not configured (service.snap_token.signing_keys), snap tokens are signed with a key generated for this process: they are rejected after a restart and by other instances, set a key shared by all instances")
+			}
+			snapTokenKeys, err = token.NewSigningKeys(signingKeys...)
+			if err != nil {
+				l.Fatal(err)
+			}
+		}
+
 		// Repositories
-		relationshipReader := factories.RelationshipReaderFactory(db, l)
-		relationshipWriter := factories.RelationshipWriterFactory(db, l)
+		relationshipReader := factories.RelationshipReaderFactory(db, snapTokenKeys, l)
+		// the recursive reader only sends single membership queries, it gets the circuit breaker of the relationship
+		// reader below but nothing to coalesce or cache
+		recursiveReader, recursive := relationshipReader.(repositories.RecursiveRelationshipReader)
+		relationshipWriter := factories.RelationshipWriterFactory(db, snapTokenKeys, l)
 		schemaReader := factories.SchemaReaderFactory(db, l)
 		schemaWriter := factories.SchemaWriterFactory(db, l)
 		tenantReader := factories.TenantReaderFactory(db, l)
 		tenantWriter := factories.TenantWriterFactory(db, l)
 
 		// decorators
-		schemaReader = decorators.NewSchemaReaderWithCache(schemaReader, schemaCache)
+		schemaReaderWithCache := decorators.NewSchemaReaderWithCache(schemaReader, schemaCache)
+		schemaReader = schemaReaderWithCache
 
 		// Service
 		if cfg.Service.CircuitBreaker {
 			relationshipWriter = decorators.NewRelationshipWriterWithCircuitBreaker(relationshipWriter)
 			relationshipReader = decorators.NewRelationshipReaderWithCircuitBreaker(relationshipReader)
+			if recursive {
+				recursiveReader = decorators.NewRecursiveRelationshipReaderWithCircuitBreaker(recursiveReader)
+			}
 
 			schemaWriter = decorators.NewSchemaWriterWithCircuitBreaker(schemaWriter)
 			schemaReader = decorators.NewSchemaReaderWithCircuitBreaker(schemaReader)
 		}
 
+		// relationship statistics cache
+		if cfg.Service.Relationship.Stats.Staleness > 0 {
+			var statsCache cache.Cache
+			statsCache, err = ristretto.New(ristretto.NumberOfCounters(cfg.Service.Relationship.Stats.Cache.NumberOfCounters), ristretto.MaxCost(cfg.Service.Relationship.Stats.Cache.MaxCost))
+			if err != nil {
+				l.Fatal(err)
+			}
+			relationshipReader = decorators.NewRelationshipReaderWithStatsCache(relationshipReader, statsCache, cfg.Service.Relationship.Stats.Staleness)
+		}
+
+		// relationship query coalescing
+		if cfg.Service.Relationship.Coalescing.Window > 0 {
+			relationshipReader = decorators.NewRelationshipReaderWithCoalescing(relationshipReader, cfg.Service.Relationship.Coalescing.Window, cfg.Service.Relationship.Coalescing.MaxBatchSize)
+		}
+
 		// key managers
 		checkKeyManager := keys.NewCheckEngineKeys(engineKeyCache)
 
 		// engines
-		checkEngine := engines.NewCheckEngine(checkKeyManager, schemaReader, relationshipReader, engines.CheckConcurrencyLimit(cfg.Permission.ConcurrencyLimit))
+		checkOptions := []engines.CheckOption{engines.CheckConcurrencyLimit(cfg.Permission.ConcurrencyLimit)}
+		if cfg.Permission.RecursiveEvaluation {
+			if recursive {
+				checkOptions = append(checkOptions, engines.CheckRecursiveReader(recursiveReader))
+			} else {
+				l.Warn("recursive evaluation is not supported by the " + cfg.Database.Engine + " database, self-referential relations are checked level by level")
+			}
+		}
+		checkEngine := engines.NewCheckEngine(checkKeyManager, schemaReader, relationshipReader, checkOptions...)
 		linkedEntityEngine := engines.NewLinkedEntityEngine(schemaReader, relationshipReader)
 		lookupEntityEngine := engines.NewLookupEntityEngine(checkEngine, linkedEntityEngine, engines.LookupEntityConcurrencyLimit(cfg.Permission.BulkLimit))
 		expandEngine := engines.NewExpandEngine(schemaReader, relationshipReader)
@@ -179,15 +228,17 @@ func serve() func(cmd *cobra.Command, args []string) error {
 
 		// Services
 		relationshipService := services.NewRelationshipService(relationshipReader, relationshipWriter, schemaReader)
-		permissionService := services.NewPermissionService(checkEngine, expandEngine, schemaLookupEngine, lookupEntityEngine)
-		schemaService := services.NewSchemaService(schemaWriter, schemaReader)
+		permissionService := services.NewPermissionService(relationshipReader, checkEngine, expandEngine, schemaLookupEngine, lookupEntityEngine)
+		schemaService := services.NewSchemaService(schemaWriter, schemaReader, relationshipReader, relationshipWriter)
 		tenancyService := services.NewTenancyService(tenantWriter, tenantReader)
+		adminService := services.NewAdminService(gc)
 
 		container := servers.ServiceContainer{
 			RelationshipService: relationshipService,
 			PermissionService:   permissionService,
 			SchemaService:       schemaService,
 			TenancyService:      tenancyService,
+			AdminService:        adminService,
 		}
 
 		var g *errgroup.Group
@@ -197,6 +248,18 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			return container.Run(ctx, &cfg.Server, &cfg.Authn, &cfg.Profiler, l)
 		})
 
+		// cache invalidation, the changes written through the other instances invalidate the cached entries of their tenants
+		if listener := factories.ChangeListenerFactory(db, l); listener != nil {
+			g.Go(func() error {
+				return listener.Listen(ctx, func(change repositories.Change) {
+					if change.Kind != repositories.RelationshipChange {
+						schemaReaderWithCache.Invalidate(change.TenantID)
+					}
+					checkKeyManager.InvalidateTenant(change.TenantID)
+				})
+			})
+		}
+
 		if err = g.Wait(); err != nil {
 			l.Error(err)
 		}
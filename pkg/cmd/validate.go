@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -12,10 +13,12 @@ import (
 
 	"github.com/gookit/color"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"permify/pkg/cmd/flags"
 	"permify/pkg/development"
 	"permify/pkg/development/validation"
+	"permify/pkg/dsl/ast"
 	base "permify/pkg/pb/base/v1"
 	"permify/pkg/token"
 	"permify/pkg/tuple"
@@ -116,11 +119,33 @@ func validate() func(cmd *cobra.Command, args []string) error {
 
 		// write the schema
 		var version string
-		version, err = devContainer.S.WriteSchema(ctx, "t1", s.Schema)
+		if len(s.SchemaFiles) > 0 {
+			version, _, err = devContainer.S.WriteSchemaFiles(ctx, "t1", s.SchemaFiles, false)
+		} else {
+			version, _, err = devContainer.S.WriteSchema(ctx, "t1", s.Schema, false)
+		}
 		if err != nil {
-			list.Add(err.Error())
-			if debug {
-				color.Danger.Printf("fail:       %s\n", validationError(err.Error()))
+			// all the errors of the schema are reported together, with their positions and codes
+			var schemaErrs ast.Errors
+			if errors.As(err, &schemaErrs) {
+				if !debug {
+					var b []byte
+					b, err = protojson.Marshal(&base.SchemaErrors{Errors: schemaErrs.ToSchemaErrors()})
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(b))
+					os.Exit(1)
+				}
+				for _, e := range schemaErrs {
+					list.Add(e.Error())
+					color.Danger.Printf("fail:       %s\n", validationError(e.Error()))
+				}
+			} else {
+				list.Add(err.Error())
+				if debug {
+					color.Danger.Printf("fail:       %s\n", validationError(err.Error()))
+				}
 			}
 			if len(list.Errors) != 0 {
 				list.Print()
@@ -5,6 +5,8 @@ type Engine string
 
 const (
 	POSTGRES Engine = "postgres"
+	MYSQL    Engine = "mysql"
+	SQLITE   Engine = "sqlite"
 	MEMORY   Engine = "memory"
 )
 
@@ -1 +1,29 @@
 package memory
+
+import (
+	"time"
+)
+
+const (
+	// SyncAlways - syncs the write-ahead log to disk on every commit
+	SyncAlways = "always"
+	// SyncInterval - syncs the write-ahead log to disk every sync interval
+	SyncInterval = "interval"
+	// SyncNever - leaves syncing the write-ahead log to the operating system
+	SyncNever = "never"
+)
+
+const (
+	_defaultSyncPolicy         = SyncAlways
+	_defaultSyncInterval       = time.Second
+	_defaultCompactionInterval = 5 * time.Minute
+
+	_walFile      = "wal"
+	_snapshotFile = "snapshot"
+
+	// number of objects written to a single frame of the snapshot file
+	_snapshotFrameSize = 1000
+
+	// number of notifications buffered for a listener before notifying blocks
+	_listenerBufferSize = 100
+)
@@ -2,7 +2,11 @@ package memory
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-memdb"
 )
@@ -12,14 +16,97 @@ type Memory struct {
 	sync.RWMutex
 
 	DB *memdb.MemDB
+
+	schema *memdb.DBSchema
+
+	// durability options, the write-ahead log is not used if the path is empty
+	path               string
+	syncPolicy         string
+	syncInterval       time.Duration
+	compactionInterval time.Duration
+
+	wal *os.File
+	// set when a failed append could not be removed from the write-ahead log, writes are refused after it
+	failed error
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+
+	// listeners of the notification channels
+	listenersMu sync.Mutex
+	listeners   map[string][]*listener
 }
 
-// New - Creates new database schema in memory
-func New(schema *memdb.DBSchema) (*Memory, error) {
+// New - Creates new database schema in memory, with a path the state is restored from the snapshot and
+// write-ahead log files and every committed write transaction is appended to the log
+func New(schema *memdb.DBSchema, opts ...Option) (*Memory, error) {
 	db, err := memdb.NewMemDB(schema)
-	return &Memory{
-		DB: db,
-	}, err
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Memory{
+		DB:                 db,
+		schema:             schema,
+		syncPolicy:         _defaultSyncPolicy,
+		syncInterval:       _defaultSyncInterval,
+		compactionInterval: _defaultCompactionInterval,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.path == "" {
+		return m, nil
+	}
+
+	switch m.syncPolicy {
+	case SyncAlways, SyncInterval, SyncNever:
+	default:
+		return nil, fmt.Errorf("%s sync policy is unsupported", m.syncPolicy)
+	}
+
+	if err = os.MkdirAll(m.path, 0o755); err != nil {
+		return nil, err
+	}
+
+	if err = m.restore(); err != nil {
+		return nil, err
+	}
+
+	m.wal, err = os.OpenFile(filepath.Join(m.path, _walFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.done.Add(1)
+	go m.run(ctx)
+
+	return m, nil
+}
+
+// Txn - Starts a transaction, write transactions of a durable database track their changes for the write-ahead log
+func (m *Memory) Txn(write bool) *memdb.Txn {
+	txn := m.DB.Txn(write)
+	if write && m.path != "" {
+		txn.TrackChanges()
+	}
+	return txn
+}
+
+// Commit - Appends the changes of the write transaction to the write-ahead log and commits it. The transaction
+// holds the writer lock of the database, so the log is in commit order.
+func (m *Memory) Commit(txn *memdb.Txn) error {
+	if m.path != "" {
+		if err := m.append(txn.Changes()); err != nil {
+			return err
+		}
+	}
+	txn.Commit()
+	return nil
 }
 
 // GetEngineType - Gets engine type, returns as string
@@ -29,13 +116,54 @@ func (m *Memory) GetEngineType() string {
 
 // Close - Closing the in memory instance
 func (m *Memory) Close() error {
+	if m.cancel != nil {
+		m.cancel()
+		m.done.Wait()
+	}
+
 	m.Lock()
 	defer m.Unlock()
 	m.DB = nil
-	return nil
+	if m.wal == nil {
+		return nil
+	}
+	if err := m.wal.Sync(); err != nil {
+		return err
+	}
+	err := m.wal.Close()
+	m.wal = nil
+	return err
 }
 
 // IsReady - Check if database is ready
 func (m *Memory) IsReady(ctx context.Context) (bool, error) {
 	return true, nil
 }
+
+// run - syncs the write-ahead log with the interval policy and compacts it until the context is cancelled
+func (m *Memory) run(ctx context.Context) {
+	defer m.done.Done()
+
+	compaction := time.NewTicker(m.compactionInterval)
+	defer compaction.Stop()
+
+	var syncs <-chan time.Time
+	if m.syncPolicy == SyncInterval {
+		ticker := time.NewTicker(m.syncInterval)
+		defer ticker.Stop()
+		syncs = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-syncs:
+			m.Lock()
+			_ = m.wal.Sync()
+			m.Unlock()
+		case <-compaction.C:
+			_ = m.Compact()
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+)
+
+// listener - Structure for a listener of a channel
+type listener struct {
+	payloads chan string
+	done     <-chan struct{}
+}
+
+// Notify - Delivers the payload to the listeners of the channel, it is the in memory equivalent of the NOTIFY of
+// postgres for the instances sharing the database in a process. It blocks until every listener received it.
+func (m *Memory) Notify(channel, payload string) {
+	m.listenersMu.Lock()
+	defer m.listenersMu.Unlock()
+
+	for _, l := range m.listeners[channel] {
+		select {
+		case l.payloads <- payload:
+		case <-l.done:
+		}
+	}
+}
+
+// Listen - Returns the payloads notified on the channel until the context is cancelled, the returned channel is
+// closed afterwards
+func (m *Memory) Listen(ctx context.Context, channel string) <-chan string {
+	l := &listener{payloads: make(chan string, _listenerBufferSize), done: ctx.Done()}
+
+	m.listenersMu.Lock()
+	if m.listeners == nil {
+		m.listeners = map[string][]*listener{}
+	}
+	m.listeners[channel] = append(m.listeners[channel], l)
+	m.listenersMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		// notifications are sent holding the lock, so none is sent to the closed channel
+		m.unlisten(channel, l)
+		close(l.payloads)
+	}()
+	return l.payloads
+}
+
+// unlisten - removes the listener from the channel
+func (m *Memory) unlisten(channel string, l *listener) {
+	m.listenersMu.Lock()
+	defer m.listenersMu.Unlock()
+
+	listeners := m.listeners[channel]
+	for i := range listeners {
+		if listeners[i] == l {
+			m.listeners[channel] = append(listeners[:i], listeners[i+1:]...)
+			return
+		}
+	}
+}
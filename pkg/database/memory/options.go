@@ -1 +1,42 @@
 package memory
+
+import (
+	"time"
+)
+
+// Option - Option type
+type Option func(*Memory)
+
+// Path - Defines the directory of the write-ahead log and snapshot files, the database is only kept in memory if empty
+func Path(dir string) Option {
+	return func(m *Memory) {
+		m.path = dir
+	}
+}
+
+// Sync - Defines when the write-ahead log is synced to disk, one of SyncAlways, SyncInterval or SyncNever
+func Sync(policy string) Option {
+	return func(m *Memory) {
+		if policy != "" {
+			m.syncPolicy = policy
+		}
+	}
+}
+
+// SyncEvery - Defines how often the write-ahead log is synced to disk with the SyncInterval policy
+func SyncEvery(d time.Duration) Option {
+	return func(m *Memory) {
+		if d > 0 {
+			m.syncInterval = d
+		}
+	}
+}
+
+// CompactionInterval - Defines how often the write-ahead log is compacted into the snapshot file
+func CompactionInterval(d time.Duration) Option {
+	return func(m *Memory) {
+		if d > 0 {
+			m.compactionInterval = d
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package memory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// record - A change of a single object, the objects are gob encoded so their types have to be registered with gob.Register
+type record struct {
+	Table   string
+	Object  interface{}
+	Deleted bool
+}
+
+// append - Writes the changes of a transaction to the write-ahead log as a single frame
+func (m *Memory) append(changes memdb.Changes) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	records := make([]record, 0, len(changes))
+	for _, change := range changes {
+		if change.Deleted() {
+			records = append(records, record{Table: change.Table, Object: change.Before, Deleted: true})
+			continue
+		}
+		records = append(records, record{Table: change.Table, Object: change.After})
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.failed != nil {
+		return m.failed
+	}
+
+	info, err := m.wal.Stat()
+	if err != nil {
+		return err
+	}
+
+	err = writeFrame(m.wal, records)
+	if err == nil && m.syncPolicy == SyncAlways {
+		err = m.wal.Sync()
+	}
+	if err != nil {
+		m.rollback(info.Size(), err)
+		return err
+	}
+	return nil
+}
+
+// rollback - Removes the frame of a failed append from the write-ahead log, the transaction is not committed so a
+// frame that was written partially or fully must not be replayed, and a torn frame would end the log before the
+// frames appended after it. If the log can not be truncated back, the database refuses writes from then on. The
+// lock is held by the caller.
+func (m *Memory) rollback(offset int64, cause error) {
+	err := m.wal.Truncate(offset)
+	if err == nil {
+		err = m.wal.Sync()
+	}
+	if err != nil {
+		m.failed = fmt.Errorf("write-ahead log can not be written after a failed append: %w", errors.Join(cause, err))
+	}
+}
+
+// restore - Loads the snapshot file and replays the write-ahead log on top of it. A frame that was only partially
+// written before a crash ends the log, it is truncated so new frames are appended after the last complete one.
+func (m *Memory) restore() error {
+	txn := m.DB.Txn(true)
+	defer txn.Abort()
+
+	if _, err := replay(txn, filepath.Join(m.path, _snapshotFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	walPath := filepath.Join(m.path, _walFile)
+	offset, err := replay(txn, walPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err == nil {
+		if err = os.Truncate(walPath, offset); err != nil {
+			return err
+		}
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// Compact - Writes the state of the database to the snapshot file and empties the write-ahead log. Writers are
+// blocked while the snapshot is written.
+func (m *Memory) Compact() error {
+	if m.path == "" {
+		return nil
+	}
+
+	txn := m.DB.Txn(true)
+	defer txn.Abort()
+
+	tables := make([]string, 0, len(m.schema.Tables))
+	for table := range m.schema.Tables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	tmp := filepath.Join(m.path, _snapshotFile+".tmp")
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, table := range tables {
+		var it memdb.ResultIterator
+		it, err = txn.Get(table, "id")
+		if err != nil {
+			return err
+		}
+		records := make([]record, 0, _snapshotFrameSize)
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			records = append(records, record{Table: table, Object: obj})
+			if len(records) == _snapshotFrameSize {
+				if err = writeFrame(w, records); err != nil {
+					return err
+				}
+				records = records[:0]
+			}
+		}
+		if len(records) > 0 {
+			if err = writeFrame(w, records); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, filepath.Join(m.path, _snapshotFile)); err != nil {
+		return err
+	}
+	// the rename is durable once the directory is synced, the log must not be truncated before that
+	if err = syncDir(m.path); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.wal == nil {
+		return nil
+	}
+
+	// replaying a log that is already contained in the snapshot gives the same state, so a crash before
+	// the truncation below does not lose or duplicate anything
+	if err = m.wal.Truncate(0); err != nil {
+		return err
+	}
+	if err = m.wal.Sync(); err != nil {
+		return err
+	}
+	// the snapshot holds every committed write, so an empty log can be appended to again
+	m.failed = nil
+	return nil
+}
+
+// syncDir - Flushes the entries of the directory, such as a renamed file, to the disk
+func syncDir(path string) error {
+	d, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// writeFrame - Writes the records as a frame of length, checksum and gob encoded payload. Every frame has its own
+// encoder so frames written by different processes can be decoded independently.
+func writeFrame(w io.Writer, records []record) error {
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(records); err != nil {
+		return err
+	}
+
+	header := make([]byte, 8)
+	binary.LittleEndian.PutUint32(header[0:4], uint32(payload.Len()))
+	binary.LittleEndian.PutUint32(header[4:8], crc32.ChecksumIEEE(payload.Bytes()))
+
+	if _, err := w.Write(append(header, payload.Bytes()...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// replay - Applies the frames of the file to the transaction, returns the offset after the last complete frame
+func replay(txn *memdb.Txn, path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	var offset int64
+	header := make([]byte, 8)
+	for {
+		if _, err = io.ReadFull(r, header); err != nil {
+			// a missing or partial header is the end of the log
+			return offset, nil
+		}
+
+		payload := make([]byte, binary.LittleEndian.Uint32(header[0:4]))
+		if _, err = io.ReadFull(r, payload); err != nil {
+			return offset, nil
+		}
+		if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(header[4:8]) {
+			return offset, nil
+		}
+
+		var records []record
+		if err = gob.NewDecoder(bytes.NewReader(payload)).Decode(&records); err != nil {
+			return offset, err
+		}
+
+		for _, rec := range records {
+			if rec.Deleted {
+				if err = txn.Delete(rec.Table, rec.Object); err != nil && !errors.Is(err, memdb.ErrNotFound) {
+					return offset, err
+				}
+				continue
+			}
+			if err = txn.Insert(rec.Table, rec.Object); err != nil {
+				return offset, err
+			}
+		}
+
+		offset += int64(len(header) + len(payload))
+	}
+}
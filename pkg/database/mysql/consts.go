@@ -0,0 +1,6 @@
+package mysql
+
+const (
+	_defaultMaxOpenConnections = 20
+	_defaultMaxIdleConnections = 2
+)
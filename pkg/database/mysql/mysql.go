@@ -0,0 +1,104 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL - Structure for MySQL instance
+type MySQL struct {
+	DB      *sql.DB
+	Builder squirrel.StatementBuilderType
+	// options
+	maxConnectionLifeTime time.Duration
+	maxConnectionIdleTime time.Duration
+	maxOpenConnections    int
+	maxIdleConnections    int
+}
+
+// New - Creates new mysql db instance. The uri is a data source name of the mysql driver,
+// e.g. "user:password@tcp(localhost:3306)/permify". Timestamps are always parsed into time.Time.
+func New(uri string, opts ...Option) (*MySQL, error) {
+	my := &MySQL{
+		maxOpenConnections: _defaultMaxOpenConnections,
+		maxIdleConnections: _defaultMaxIdleConnections,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(my)
+	}
+
+	my.Builder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question)
+
+	cfg, err := mysql.ParseDSN(uri)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ParseTime = true
+
+	var connector driver.Connector
+	connector, err = mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db := sql.OpenDB(connector)
+
+	if my.maxOpenConnections != 0 {
+		db.SetMaxOpenConns(my.maxOpenConnections)
+	}
+
+	if my.maxIdleConnections != 0 {
+		db.SetMaxIdleConns(my.maxIdleConnections)
+	}
+
+	if my.maxConnectionLifeTime != 0 {
+		db.SetConnMaxLifetime(my.maxConnectionLifeTime)
+	}
+
+	if my.maxConnectionIdleTime != 0 {
+		db.SetConnMaxIdleTime(my.maxConnectionIdleTime)
+	}
+
+	policy := backoff.NewExponentialBackOff()
+	policy.MaxElapsedTime = 1 * time.Minute
+	err = backoff.Retry(func() error {
+		return db.PingContext(context.Background())
+	}, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	my.DB = db
+	return my, nil
+}
+
+// GetEngineType - Get the engine type which is mysql in string
+func (m *MySQL) GetEngineType() string {
+	return "mysql"
+}
+
+// Close - Close mysql instance
+func (m *MySQL) Close() error {
+	if m.DB != nil {
+		return m.DB.Close()
+	}
+	return nil
+}
+
+// IsReady - Check if database is ready
+func (m *MySQL) IsReady(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := m.DB.PingContext(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
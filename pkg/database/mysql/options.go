@@ -0,0 +1,36 @@
+package mysql
+
+import (
+	"time"
+)
+
+// Option - Option type
+type Option func(*MySQL)
+
+// MaxOpenConnections - Defines maximum open connections for mysql db
+func MaxOpenConnections(size int) Option {
+	return func(c *MySQL) {
+		c.maxOpenConnections = size
+	}
+}
+
+// MaxIdleConnections - Defines maximum idle connections for mysql db
+func MaxIdleConnections(c int) Option {
+	return func(p *MySQL) {
+		p.maxIdleConnections = c
+	}
+}
+
+// MaxConnectionIdleTime - Defines maximum connection idle for mysql db
+func MaxConnectionIdleTime(d time.Duration) Option {
+	return func(p *MySQL) {
+		p.maxConnectionIdleTime = d
+	}
+}
+
+// MaxConnectionLifeTime - Defines maximum connection lifetime for mysql db
+func MaxConnectionLifeTime(d time.Duration) Option {
+	return func(p *MySQL) {
+		p.maxConnectionLifeTime = d
+	}
+}
@@ -1,6 +1,12 @@
 package postgres
 
+import "time"
+
 const (
 	_defaultMaxOpenConnections = 20
 	_defaultMaxIdleConnections = 2
+	// how often the replay positions of the replicas are read
+	_defaultReplicaPollInterval = 100 * time.Millisecond
+	// how far behind the primary a replica may be for the reads that are not bound to a snapshot
+	_defaultReplicaMaxLag = time.Second
 )
@@ -34,3 +34,25 @@ func MaxConnectionLifeTime(d time.Duration) Option {
 		p.maxConnectionLifeTime = d
 	}
 }
+
+// ReadReplicas - Defines the uris of the read replicas of the postgresql db
+func ReadReplicas(uris ...string) Option {
+	return func(p *Postgres) {
+		p.replicaURIs = uris
+	}
+}
+
+// ReplicaPollInterval - Defines how often the replay positions of the read replicas are read
+func ReplicaPollInterval(d time.Duration) Option {
+	return func(p *Postgres) {
+		p.replicaPollInterval = d
+	}
+}
+
+// ReplicaMaxLag - Defines how far behind the primary a read replica may be for the reads that are not bound to a
+// snapshot, such as tenant lists
+func ReplicaMaxLag(d time.Duration) Option {
+	return func(p *Postgres) {
+		p.replicaMaxLag = d
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -16,20 +17,35 @@ import (
 
 // Postgres - Structure for Postresql instance
 type Postgres struct {
-	DB      *sql.DB
-	Builder squirrel.StatementBuilderType
+	DB *sql.DB
+	// Replicas - read replicas of the primary, readers use them for the data that is already replicated
+	Replicas []*sql.DB
+	Builder  squirrel.StatementBuilderType
 	// options
 	maxConnectionLifeTime time.Duration
 	maxConnectionIdleTime time.Duration
 	maxOpenConnections    int
 	maxIdleConnections    int
+	replicaURIs           []string
+	replicaPollInterval   time.Duration
+	replicaMaxLag         time.Duration
+	// replay positions of the replicas, in the order of the replicas
+	positionsMu sync.RWMutex
+	positions   []replicaPosition
+	// next replica to start from, replicas are used in round robin order
+	next uint32
+	// stops the tracking of the replicas
+	cancel context.CancelFunc
+	done   sync.WaitGroup
 }
 
 // New - Creates new postgresql db instance
 func New(uri string, opts ...Option) (*Postgres, error) {
 	pg := &Postgres{
-		maxOpenConnections: _defaultMaxOpenConnections,
-		maxIdleConnections: _defaultMaxIdleConnections,
+		maxOpenConnections:  _defaultMaxOpenConnections,
+		maxIdleConnections:  _defaultMaxIdleConnections,
+		replicaPollInterval: _defaultReplicaPollInterval,
+		replicaMaxLag:       _defaultReplicaMaxLag,
 	}
 
 	// Custom options
@@ -53,37 +69,60 @@ func New(uri string, opts ...Option) (*Postgres, error) {
 		return nil, err
 	}
 
-	if pg.maxOpenConnections != 0 {
-		db.SetMaxOpenConns(pg.maxOpenConnections)
+	if err = pg.configure(db); err != nil {
+		return nil, err
+	}
+	pg.DB = db
+
+	for _, replicaURI := range pg.replicaURIs {
+		var replica *sql.DB
+		replica, err = sql.Open("pgx", fmt.Sprintf(replicaURI, socketDir))
+		if err != nil {
+			return nil, err
+		}
+		if err = pg.configure(replica); err != nil {
+			return nil, err
+		}
+		pg.Replicas = append(pg.Replicas, replica)
+	}
+
+	if len(pg.Replicas) > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		pg.cancel = cancel
+		pg.done.Add(1)
+		go pg.track(ctx)
+	}
+
+	return pg, nil
+}
+
+// configure - Applies the connection pool options and waits until the database is reachable
+func (p *Postgres) configure(db *sql.DB) (err error) {
+	if p.maxOpenConnections != 0 {
+		db.SetMaxOpenConns(p.maxOpenConnections)
 	}
 
-	if pg.maxIdleConnections != 0 {
-		db.SetMaxIdleConns(pg.maxIdleConnections)
+	if p.maxIdleConnections != 0 {
+		db.SetMaxIdleConns(p.maxIdleConnections)
 	}
 
-	if pg.maxConnectionLifeTime != 0 {
-		db.SetConnMaxLifetime(pg.maxConnectionLifeTime)
+	if p.maxConnectionLifeTime != 0 {
+		db.SetConnMaxLifetime(p.maxConnectionLifeTime)
 	}
 
-	if pg.maxConnectionIdleTime != 0 {
-		db.SetConnMaxIdleTime(pg.maxConnectionIdleTime)
+	if p.maxConnectionIdleTime != 0 {
+		db.SetConnMaxIdleTime(p.maxConnectionIdleTime)
 	}
 
 	policy := backoff.NewExponentialBackOff()
 	policy.MaxElapsedTime = 1 * time.Minute
-	err = backoff.Retry(func() error {
+	return backoff.Retry(func() error {
 		err = db.PingContext(context.Background())
 		if err != nil {
 			return err
 		}
 		return nil
 	}, policy)
-	if err != nil {
-		return nil, err
-	}
-
-	pg.DB = db
-	return pg, nil
 }
 
 // GetEngineType - Get the engine type which is postgresql in string
@@ -93,6 +132,15 @@ func (p *Postgres) GetEngineType() string {
 
 // Close - Close postgresql instance
 func (p *Postgres) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+		p.done.Wait()
+	}
+	for _, replica := range p.Replicas {
+		if err := replica.Close(); err != nil {
+			return err
+		}
+	}
 	if p.DB != nil {
 		return p.DB.Close()
 	}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+	"time"
+)
+
+// replicaPosition - Replay position of a read replica, read by the background tracker
+type replicaPosition struct {
+	// every transaction id below xmin is finished on the replica
+	xmin uint64
+	// the replica had replayed everything the primary had written at this time, zero if it never caught up
+	syncedAt time.Time
+	// set once the position is read, replicas whose position can not be read are not used
+	known bool
+}
+
+// track - reads the replay positions of the replicas every poll interval until the context is cancelled
+func (p *Postgres) track(ctx context.Context) {
+	defer p.done.Done()
+
+	ticker := time.NewTicker(p.replicaPollInterval)
+	defer ticker.Stop()
+
+	for {
+		p.SyncReplicas(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncReplicas - Reads the replay positions of the replicas. A replica is synced at the time the write-ahead log
+// position of the primary is read if it has replayed the log up to that position. Readers choose replicas with
+// the positions read here, so they do not query the replicas before they read from them.
+func (p *Postgres) SyncReplicas(ctx context.Context) {
+	if len(p.Replicas) == 0 {
+		return
+	}
+
+	now := time.Now()
+	var lsn string
+	primaryErr := p.DB.QueryRowContext(ctx, "SELECT pg_current_wal_lsn()::text").Scan(&lsn)
+
+	positions := make([]replicaPosition, len(p.Replicas))
+	p.positionsMu.RLock()
+	copy(positions, p.positions)
+	p.positionsMu.RUnlock()
+
+	for i, replica := range p.Replicas {
+		var xmin uint64
+		var caughtUp bool
+		var err error
+		if primaryErr == nil {
+			err = replica.QueryRowContext(ctx, "SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint, COALESCE(pg_last_wal_replay_lsn() >= $1::pg_lsn, false)", lsn).Scan(&xmin, &caughtUp)
+		} else {
+			err = replica.QueryRowContext(ctx, "SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint").Scan(&xmin)
+		}
+		if err != nil {
+			positions[i] = replicaPosition{}
+			continue
+		}
+		positions[i].xmin = xmin
+		positions[i].known = true
+		if caughtUp {
+			positions[i].syncedAt = now
+		}
+	}
+
+	p.positionsMu.Lock()
+	p.positions = positions
+	p.positionsMu.Unlock()
+}
+
+// ReplicaAt - Returns a read replica on which the transaction is finished, in round robin order, or nil if none
+// of the replicas has replayed it yet
+func (p *Postgres) ReplicaAt(xid uint64) *sql.DB {
+	return p.replica(func(position replicaPosition) bool {
+		return position.xmin > xid
+	})
+}
+
+// FreshReplica - Returns a read replica that has replayed everything the primary had written before the maximum
+// replica lag, in round robin order, or nil if none of the replicas is that fresh
+func (p *Postgres) FreshReplica() *sql.DB {
+	return p.replica(func(position replicaPosition) bool {
+		return !position.syncedAt.IsZero() && time.Since(position.syncedAt) <= p.replicaMaxLag
+	})
+}
+
+// replica - returns the next replica, in round robin order, whose known position satisfies the condition
+func (p *Postgres) replica(condition func(position replicaPosition) bool) *sql.DB {
+	p.positionsMu.RLock()
+	defer p.positionsMu.RUnlock()
+
+	if len(p.positions) == 0 {
+		return nil
+	}
+	start := int(atomic.AddUint32(&p.next, 1)-1) % len(p.positions)
+	for i := range p.positions {
+		j := (start + i) % len(p.positions)
+		if p.positions[j].known && condition(p.positions[j]) {
+			return p.Replicas[j]
+		}
+	}
+	return nil
+}
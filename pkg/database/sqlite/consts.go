@@ -0,0 +1,8 @@
+package sqlite
+
+const (
+	_defaultMaxOpenConnections = 20
+	_defaultMaxIdleConnections = 2
+	// milliseconds a connection waits for the locks of the others
+	_defaultBusyTimeout = "5000"
+)
@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	_ "modernc.org/sqlite"
+)
+
+// SQLite - Structure for SQLite instance
+type SQLite struct {
+	DB      *sql.DB
+	Builder squirrel.StatementBuilderType
+	// options
+	maxConnectionLifeTime time.Duration
+	maxConnectionIdleTime time.Duration
+	maxOpenConnections    int
+	maxIdleConnections    int
+}
+
+// New - Creates new sqlite db instance. The uri is the path of the database file, e.g. "/var/lib/permify/permify.db",
+// optionally followed by the query parameters of the driver. Every connection waits for the locks of the others
+// instead of failing, uses write-ahead logging so reads do not block writes, and begins its write transactions
+// immediately so they are serialized.
+func New(uri string, opts ...Option) (*SQLite, error) {
+	sq := &SQLite{
+		maxOpenConnections: _defaultMaxOpenConnections,
+		maxIdleConnections: _defaultMaxIdleConnections,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(sq)
+	}
+
+	sq.Builder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question)
+
+	db, err := sql.Open("sqlite", DSN(uri))
+	if err != nil {
+		return nil, err
+	}
+
+	if sq.maxOpenConnections != 0 {
+		db.SetMaxOpenConns(sq.maxOpenConnections)
+	}
+
+	if sq.maxIdleConnections != 0 {
+		db.SetMaxIdleConns(sq.maxIdleConnections)
+	}
+
+	if sq.maxConnectionLifeTime != 0 {
+		db.SetConnMaxLifetime(sq.maxConnectionLifeTime)
+	}
+
+	if sq.maxConnectionIdleTime != 0 {
+		db.SetConnMaxIdleTime(sq.maxConnectionIdleTime)
+	}
+
+	if err = db.PingContext(context.Background()); err != nil {
+		return nil, err
+	}
+
+	sq.DB = db
+	return sq, nil
+}
+
+// DSN - Appends the connection parameters permify needs to the uri of a database file
+func DSN(uri string) string {
+	separator := "?"
+	if strings.Contains(uri, "?") {
+		separator = "&"
+	}
+	return uri + separator + "_pragma=busy_timeout(" + _defaultBusyTimeout + ")&_pragma=journal_mode(wal)&_txlock=immediate&_time_format=sqlite"
+}
+
+// GetEngineType - Get the engine type which is sqlite in string
+func (s *SQLite) GetEngineType() string {
+	return "sqlite"
+}
+
+// Close - Close sqlite instance
+func (s *SQLite) Close() error {
+	if s.DB != nil {
+		return s.DB.Close()
+	}
+	return nil
+}
+
+// IsReady - Check if database is ready
+func (s *SQLite) IsReady(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := s.DB.PingContext(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -10,6 +10,7 @@ import (
 	"permify/internal/services"
 	"permify/pkg/database"
 	"permify/pkg/logger"
+	"permify/pkg/token"
 )
 
 // Container - Structure for container instance
@@ -31,9 +32,22 @@ func NewContainer() *Container {
 
 	l := logger.New("debug")
 
+	// snap tokens only live as long as the container, a generated key signs them
+	var key string
+	key, err = token.GenerateSigningKey()
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	var signingKeys *token.SigningKeys
+	signingKeys, err = token.NewSigningKeys(key)
+	if err != nil {
+		fmt.Println(err)
+	}
+
 	// Repositories
-	relationshipReader := factories.RelationshipReaderFactory(db, l)
-	relationshipWriter := factories.RelationshipWriterFactory(db, l)
+	relationshipReader := factories.RelationshipReaderFactory(db, signingKeys, l)
+	relationshipWriter := factories.RelationshipWriterFactory(db, signingKeys, l)
 
 	schemaReader := factories.SchemaReaderFactory(db, l)
 	schemaWriter := factories.SchemaWriterFactory(db, l)
@@ -46,8 +60,8 @@ func NewContainer() *Container {
 	lookupEntityEngine := engines.NewLookupEntityEngine(checkEngine, linkedEntityEngine)
 
 	return &Container{
-		P: services.NewPermissionService(checkEngine, expandEngine, lookupSchemaEngine, lookupEntityEngine),
+		P: services.NewPermissionService(relationshipReader, checkEngine, expandEngine, lookupSchemaEngine, lookupEntityEngine),
 		R: services.NewRelationshipService(relationshipReader, relationshipWriter, schemaReader),
-		S: services.NewSchemaService(schemaWriter, schemaReader),
+		S: services.NewSchemaService(schemaWriter, schemaReader, relationshipReader, relationshipWriter),
 	}
 }
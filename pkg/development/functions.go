@@ -57,9 +57,10 @@ func DeleteTuple(ctx context.Context, service services.IRelationshipService, fil
 	return service.DeleteRelationships(ctx, "t1", filter)
 }
 
-// WriteSchema - Creates new write schema request
+// WriteSchema - Creates new write schema request, breaking changes are always forced in the development environment
 func WriteSchema(ctx context.Context, service services.ISchemaService, schema string) (version string, err error) {
-	return service.WriteSchema(ctx, "t1", schema)
+	version, _, err = service.WriteSchema(ctx, "t1", schema, true)
+	return version, err
 }
 
 // ReadSchema - Creates new read schema request
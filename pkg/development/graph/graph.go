@@ -13,9 +13,10 @@ type Node struct {
 
 // Edge - Edge Structure
 type Edge struct {
-	Extra any   `json:"extra"`
-	From  *Node `json:"from"`
-	To    *Node `json:"to"`
+	Extra any    `json:"extra"`
+	From  *Node  `json:"from"`
+	To    *Node  `json:"to"`
+	Label string `json:"label"`
 }
 
 // Graph - Graph Structure
@@ -66,3 +67,15 @@ func (g *Graph) AddEdge(from, to *Node, extra any) {
 	})
 	g.lock.Unlock()
 }
+
+// AddLabeledEdge - Add edge with a label to graph
+func (g *Graph) AddLabeledEdge(from, to *Node, extra any, label string) {
+	g.lock.Lock()
+	g.edges = append(g.edges, &Edge{
+		Extra: extra,
+		From:  from,
+		To:    to,
+		Label: label,
+	})
+	g.lock.Unlock()
+}
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/internal/schema"
+)
+
+// TestGraph -
+func TestGraph(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "graph-suite")
+}
+
+var _ = Describe("graph", func() {
+	Context("SchemaToGraph", func() {
+		It("Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(false, `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+			}
+
+			entity folder {
+				relation org @organization
+			}
+
+			entity doc {
+				relation parent @folder
+				relation editor @user
+				relation banned @user
+
+				permission edit = editor but not (banned or parent.org.admin)
+			}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			g, err := SchemaToGraph(sch)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type edge struct {
+				from, to, label string
+				exclusion       bool
+			}
+
+			var edges []edge
+			for _, e := range g.Edges() {
+				exclusion, _ := e.Extra.(bool)
+				edges = append(edges, edge{from: e.From.ID, to: e.To.ID, label: e.Label, exclusion: exclusion})
+			}
+
+			Expect(edges).Should(ContainElements(
+				edge{from: "entity:doc:permission:edit", to: "entity:doc:permission:edit:rewrite:0"},
+				edge{from: "entity:doc:permission:edit:rewrite:0", to: "entity:doc:permission:editor"},
+				edge{from: "entity:doc:permission:edit:rewrite:0", to: "entity:doc:permission:edit:rewrite:0:rewrite:1", label: "but not", exclusion: true},
+				edge{from: "entity:doc:permission:edit:rewrite:0:rewrite:1", to: "entity:doc:permission:banned", label: "or"},
+				edge{from: "entity:doc:permission:edit:rewrite:0:rewrite:1", to: "entity:organization:permission:admin", label: "or via parent.org"},
+			))
+
+			// the graph of a schema is always the same
+			again, err := SchemaToGraph(sch)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again.DOT()).Should(Equal(g.DOT()))
+		})
+	})
+
+	Context("Render", func() {
+		It("Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(false, `
+			entity user {}
+
+			entity organization {
+				relation member @user
+				relation banned @user
+
+				permission view = member and not banned
+			}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			g, err := SchemaToGraph(sch)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(g.DOT()).Should(Equal(`digraph schema {
+    rankdir=LR
+    "entity:organization" [label="organization", shape=box]
+    "entity:organization:permission:banned" [label="banned", shape=ellipse]
+    "entity:organization:permission:member" [label="member", shape=ellipse]
+    "entity:organization:permission:view" [label="view", shape=hexagon]
+    "entity:organization:permission:view:rewrite:0" [label="OPERATION_INTERSECTION", shape=diamond]
+    "entity:user" [label="user", shape=box]
+    "entity:organization" -> "entity:organization:permission:banned"
+    "entity:organization" -> "entity:organization:permission:member"
+    "entity:organization" -> "entity:organization:permission:view"
+    "entity:organization:permission:view" -> "entity:organization:permission:view:rewrite:0"
+    "entity:organization:permission:view:rewrite:0" -> "entity:organization:permission:member" [label="and"]
+    "entity:organization:permission:view:rewrite:0" -> "entity:organization:permission:banned" [label="and not", style=dashed]
+}
+`))
+
+			Expect(g.Mermaid()).Should(Equal(`flowchart LR
+    n0["organization"]
+    n1("banned")
+    n2("member")
+    n3{{"view"}}
+    n4{"OPERATION_INTERSECTION"}
+    n5["user"]
+    n0 --> n1
+    n0 --> n2
+    n0 --> n3
+    n3 --> n4
+    n4 -->|"and"| n2
+    n4 -.->|"and not"| n1
+`))
+		})
+	})
+})
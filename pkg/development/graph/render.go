@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSON - Returns the nodes and edges of the graph as json, the format the playground reads
+func (g *Graph) JSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Nodes []*Node `json:"nodes"`
+		Edges []*Edge `json:"edges"`
+	}{Nodes: g.Nodes(), Edges: g.Edges()})
+}
+
+// DOT - Returns the graph in the Graphviz DOT language. Entities are boxes, permissions are hexagons and rewrite
+// operations are diamonds. Excluded edges are dashed.
+func (g *Graph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph schema {\n")
+	sb.WriteString("    rankdir=LR\n")
+
+	for _, n := range g.Nodes() {
+		sb.WriteString(fmt.Sprintf("    %s [label=%s, shape=%s]\n", strconv.Quote(n.ID), strconv.Quote(n.Label), dotShape(n.Type)))
+	}
+
+	for _, e := range g.Edges() {
+		var attributes []string
+		if e.Label != "" {
+			attributes = append(attributes, "label="+strconv.Quote(e.Label))
+		}
+		if excluded(e) {
+			attributes = append(attributes, "style=dashed")
+		}
+		sb.WriteString(fmt.Sprintf("    %s -> %s", strconv.Quote(e.From.ID), strconv.Quote(e.To.ID)))
+		if len(attributes) > 0 {
+			sb.WriteString(" [" + strings.Join(attributes, ", ") + "]")
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid - Returns the graph as a Mermaid flowchart, with the same shapes as the DOT output. Mermaid does not allow
+// the characters of the node ids in its ids, so nodes are numbered in the order they are added.
+func (g *Graph) Mermaid() string {
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+
+	ids := map[string]string{}
+	id := func(n *Node) (string, bool) {
+		if v, ok := ids[n.ID]; ok {
+			return v, false
+		}
+		v := "n" + strconv.Itoa(len(ids))
+		ids[n.ID] = v
+		return v, true
+	}
+
+	for _, n := range g.Nodes() {
+		v, _ := id(n)
+		sb.WriteString("    " + v + mermaidShape(n) + "\n")
+	}
+
+	for _, e := range g.Edges() {
+		from, _ := id(e.From)
+		// the target of a reference is declared where it is used if it is not a node of the graph
+		to, declare := id(e.To)
+		if declare {
+			to += mermaidShape(e.To)
+		}
+
+		arrow := "-->"
+		if excluded(e) {
+			arrow = "-.->"
+		}
+		if e.Label != "" {
+			arrow += "|" + mermaidText(e.Label) + "|"
+		}
+		sb.WriteString("    " + from + " " + arrow + " " + to + "\n")
+	}
+
+	return sb.String()
+}
+
+// dotShape - returns the DOT shape of a node type
+func dotShape(t string) string {
+	switch t {
+	case "entity":
+		return "box"
+	case "permission":
+		return "hexagon"
+	case "logic":
+		return "diamond"
+	default:
+		return "ellipse"
+	}
+}
+
+// mermaidShape - returns the Mermaid shape of a node with its label
+func mermaidShape(n *Node) string {
+	label := mermaidText(n.Label)
+	switch n.Type {
+	case "entity":
+		return "[" + label + "]"
+	case "permission":
+		return "{{" + label + "}}"
+	case "logic":
+		return "{" + label + "}"
+	default:
+		return "(" + label + ")"
+	}
+}
+
+// mermaidText - quotes a label for Mermaid, which does not allow quotes inside of quoted text
+func mermaidText(s string) string {
+	return "\"" + strings.ReplaceAll(s, "\"", "#quot;") + "\""
+}
+
+// excluded - checks if an edge leads to an excluded child
+func excluded(e *Edge) bool {
+	b, ok := e.Extra.(bool)
+	return ok && b
+}
@@ -3,19 +3,18 @@ package graph
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
-	"github.com/rs/xid"
-
 	"permify/internal/schema"
 	base "permify/pkg/pb/base/v1"
 	"permify/pkg/tuple"
 )
 
 // SchemaToGraph - Convert schema to graph
-func SchemaToGraph(schema *base.SchemaDefinition) (g Graph, err error) {
-	for _, en := range schema.GetEntityDefinitions() {
-		eg, err := EntityToGraph(en)
+func SchemaToGraph(sch *base.SchemaDefinition) (g Graph, err error) {
+	for _, name := range sortedKeys(sch.GetEntityDefinitions()) {
+		eg, err := EntityToGraph(sch, sch.GetEntityDefinitions()[name])
 		if err != nil {
 			return Graph{}, err
 		}
@@ -25,8 +24,8 @@ func SchemaToGraph(schema *base.SchemaDefinition) (g Graph, err error) {
 	return
 }
 
-// EntityToGraph - Convert entity to graph
-func EntityToGraph(entity *base.EntityDefinition) (g Graph, err error) {
+// EntityToGraph - Convert entity to graph, the schema is used to follow the relations of tuple to user sets
+func EntityToGraph(sch *base.SchemaDefinition, entity *base.EntityDefinition) (g Graph, err error) {
 	enNode := &Node{
 		Type:  "entity",
 		ID:    fmt.Sprintf("entity:%s", entity.GetName()),
@@ -34,7 +33,8 @@ func EntityToGraph(entity *base.EntityDefinition) (g Graph, err error) {
 	}
 	g.AddNode(enNode)
 
-	for _, re := range entity.GetRelations() {
+	for _, name := range sortedKeys(entity.GetRelations()) {
+		re := entity.GetRelations()[name]
 		reNode := &Node{
 			Type:  "relation",
 			ID:    fmt.Sprintf("entity:%s:permission:%s", entity.GetName(), re.GetName()),
@@ -44,7 +44,8 @@ func EntityToGraph(entity *base.EntityDefinition) (g Graph, err error) {
 		g.AddEdge(enNode, reNode, nil)
 	}
 
-	for _, permission := range entity.GetPermissions() {
+	for _, name := range sortedKeys(entity.GetPermissions()) {
+		permission := entity.GetPermissions()[name]
 		acNode := &Node{
 			Type:  "permission",
 			ID:    fmt.Sprintf("entity:%s:permission:%s", entity.GetName(), permission.GetName()),
@@ -52,7 +53,7 @@ func EntityToGraph(entity *base.EntityDefinition) (g Graph, err error) {
 		}
 		g.AddNode(acNode)
 		g.AddEdge(enNode, acNode, nil)
-		ag, err := buildActionGraph(entity, acNode, []*base.Child{permission.GetChild()})
+		ag, err := buildActionGraph(sch, entity, acNode, base.Rewrite_OPERATION_UNSPECIFIED, []*base.Child{permission.GetChild()})
 		if err != nil {
 			return Graph{}, err
 		}
@@ -62,20 +63,24 @@ func EntityToGraph(entity *base.EntityDefinition) (g Graph, err error) {
 	return
 }
 
-// buildActionGraph - creates permission graph
-func buildActionGraph(entity *base.EntityDefinition, from *Node, children []*base.Child) (g Graph, err error) {
-	for _, child := range children {
+// buildActionGraph - creates permission graph, the edges to the children are labeled with the operation of their parent
+func buildActionGraph(sch *base.SchemaDefinition, entity *base.EntityDefinition, from *Node, op base.Rewrite_Operation, children []*base.Child) (g Graph, err error) {
+	for i, child := range children {
+		// the children of an exclusion but the first one are excluded
+		excluded := op == base.Rewrite_OPERATION_EXCLUSION && i > 0
+		labels := []string{operationLabel(op, i)}
+
 		switch child.GetType().(type) {
 		case *base.Child_Rewrite:
 			rw := &Node{
 				Type:  "logic",
-				ID:    xid.New().String(),
+				ID:    fmt.Sprintf("%s:rewrite:%d", from.ID, i),
 				Label: child.GetRewrite().GetRewriteOperation().String(),
 			}
 
 			g.AddNode(rw)
-			g.AddEdge(from, rw, nil)
-			ag, err := buildActionGraph(entity, rw, child.GetRewrite().GetChildren())
+			g.AddLabeledEdge(from, rw, excluded, label(labels...))
+			ag, err := buildActionGraph(sch, entity, rw, child.GetRewrite().GetRewriteOperation(), child.GetRewrite().GetChildren())
 			if err != nil {
 				return Graph{}, err
 			}
@@ -83,23 +88,26 @@ func buildActionGraph(entity *base.EntityDefinition, from *Node, children []*bas
 			g.AddEdges(ag.Edges())
 		case *base.Child_Leaf:
 			leaf := child.GetLeaf()
+			if leaf.GetExclusion() {
+				labels = append(labels, "not")
+			}
 			switch leaf.GetType().(type) {
 			case *base.Leaf_TupleToUserSet:
-				re, err := schema.GetRelationByNameInEntityDefinition(entity, leaf.GetTupleToUserSet().GetTupleSet().GetRelation())
+				target, relation, hops, err := tupleToUserSetTarget(sch, entity, leaf.GetTupleToUserSet())
 				if err != nil {
-					return Graph{}, errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
+					return Graph{}, err
 				}
-				g.AddEdge(from, &Node{
+				g.AddLabeledEdge(from, &Node{
 					Type:  "relation",
-					ID:    fmt.Sprintf("entity:%s:permission:%s", GetMainReference(re), leaf.GetTupleToUserSet().GetComputed().GetRelation()),
-					Label: leaf.GetTupleToUserSet().GetComputed().GetRelation(),
-				}, leaf.GetExclusion())
+					ID:    fmt.Sprintf("entity:%s:permission:%s", target, relation),
+					Label: relation,
+				}, excluded || leaf.GetExclusion(), label(append(labels, "via "+strings.Join(hops, "."))...))
 			case *base.Leaf_ComputedUserSet:
-				g.AddEdge(from, &Node{
+				g.AddLabeledEdge(from, &Node{
 					Type:  "relation",
 					ID:    fmt.Sprintf("entity:%s:permission:%s", entity.GetName(), leaf.GetComputedUserSet().GetRelation()),
 					Label: leaf.GetComputedUserSet().GetRelation(),
-				}, leaf.GetExclusion())
+				}, excluded || leaf.GetExclusion(), label(labels...))
 			default:
 				break
 			}
@@ -108,12 +116,70 @@ func buildActionGraph(entity *base.EntityDefinition, from *Node, children []*bas
 	return
 }
 
-// GetMainReference -
+// tupleToUserSetTarget - follows the tuple set relations of a tuple to user set, returns the entity and the relation it
+// ends at and the tuple set relations it passes through
+func tupleToUserSetTarget(sch *base.SchemaDefinition, entity *base.EntityDefinition, ttu *base.TupleToUserSet) (target, relation string, hops []string, err error) {
+	for {
+		re, err := schema.GetRelationByNameInEntityDefinition(entity, ttu.GetTupleSet().GetRelation())
+		if err != nil {
+			return "", "", nil, errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
+		}
+		hops = append(hops, ttu.GetTupleSet().GetRelation())
+		target = GetMainReference(re)
+		if ttu.GetNext() == nil {
+			return target, ttu.GetComputed().GetRelation(), hops, nil
+		}
+		var ok bool
+		entity, ok = sch.GetEntityDefinitions()[target]
+		if !ok {
+			return "", "", nil, errors.New(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String())
+		}
+		ttu = ttu.GetNext()
+	}
+}
+
+// operationLabel - returns the label of the edge to the i-th child of a rewrite operation
+func operationLabel(op base.Rewrite_Operation, i int) string {
+	switch op {
+	case base.Rewrite_OPERATION_UNION:
+		return "or"
+	case base.Rewrite_OPERATION_INTERSECTION:
+		return "and"
+	case base.Rewrite_OPERATION_EXCLUSION:
+		if i > 0 {
+			return "but not"
+		}
+	}
+	return ""
+}
+
+// label - joins the non-empty parts of an edge label
+func label(parts ...string) string {
+	l := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			l = append(l, part)
+		}
+	}
+	return strings.Join(l, " ")
+}
+
+// GetMainReference - returns the entity type of the first direct entity reference of a relation
 func GetMainReference(definition *base.RelationDefinition) string {
 	for _, ref := range definition.GetRelationReferences() {
-		if !strings.Contains(ref.String(), "#") {
+		if ref.GetRelation() == "" {
 			return ref.GetType()
 		}
 	}
 	return tuple.USER
 }
+
+// sortedKeys - returns the keys of a map in ascending order, so graphs of the same schema are the same
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -5,6 +5,10 @@ type Shape struct {
 	// Schema - String of authorization model.
 	Schema string `yaml:"schema"`
 
+	// SchemaFiles - Authorization model split into named source files, used instead of Schema.
+	// Files can import each other with import "name".
+	SchemaFiles map[string]string `yaml:"schema_files"`
+
 	// Relationships - Authorization data
 	Relationships []string `yaml:"relationships"`
 
@@ -0,0 +1,51 @@
+package ast
+
+import (
+	"strings"
+
+	"permify/pkg/dsl/token"
+)
+
+// Description returns the text of the doc comment among the given leading comments of a statement. The doc comment
+// is made up of the comments after the last blank line. The comment markers, the leading asterisks of multi-line
+// comment lines and the surrounding whitespace of each line are removed.
+func Description(comments []token.Token) string {
+	// a blank line detaches the comments before it from the statement
+	for i := len(comments) - 1; i >= 0; i-- {
+		if comments[i].Type == token.NEWLINE {
+			comments = comments[i+1:]
+			break
+		}
+	}
+
+	var lines []string
+	for _, comment := range comments {
+		switch comment.Type {
+		case token.SINGLE_LINE_COMMENT:
+			lines = append(lines, strings.TrimSpace(comment.Literal))
+		case token.MULTI_LINE_COMMENT:
+			for _, line := range strings.Split(comment.Literal, "\n") {
+				line = strings.TrimSpace(line)
+				line = strings.TrimSpace(strings.TrimPrefix(line, "*"))
+				lines = append(lines, line)
+			}
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// writeDescription writes a description as single-line comments, one per line, each prefixed with the given indent.
+func writeDescription(sb *strings.Builder, indent, description string) {
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(description, "\n") {
+		sb.WriteString(indent)
+		sb.WriteString("//")
+		if line != "" {
+			sb.WriteString(" ")
+			sb.WriteString(line)
+		}
+		sb.WriteString("\n")
+	}
+}
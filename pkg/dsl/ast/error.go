@@ -0,0 +1,100 @@
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"permify/pkg/dsl/token"
+	base "permify/pkg/pb/base/v1"
+)
+
+// Error represents an error found in a schema by the parser, the validation or the compiler. Start is the position
+// of the first character of the source the error is about and End is the position right after its last character.
+type Error struct {
+	Start   token.PositionInfo
+	End     token.PositionInfo
+	Code    base.ErrorCode
+	Message string
+}
+
+// NewError creates an error about the given token. The message describes the error code if it is empty.
+func NewError(t token.Token, code base.ErrorCode, message string) *Error {
+	if message == "" {
+		message = ErrorMessage(code)
+	}
+	length := len(t.Literal)
+	// the literal of a string does not include its quotes
+	if t.Type == token.STRING {
+		length += 2
+	}
+	end := t.PositionInfo
+	end.ColumnPosition += length
+	return &Error{
+		Start:   t.PositionInfo,
+		End:     end,
+		Code:    code,
+		Message: message,
+	}
+}
+
+// ErrorMessage returns the human-readable description of an error code, e.g. "undefined relation reference".
+func ErrorMessage(code base.ErrorCode) string {
+	return strings.ToLower(strings.Replace(strings.Replace(code.String(), "ERROR_CODE_", "", -1), "_", " ", -1))
+}
+
+// Error returns the error prefixed with its position, e.g. "main.perm:4:21: entity not imported".
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Start.String(), e.Message)
+}
+
+// ToSchemaError converts the error into its API representation.
+func (e *Error) ToSchemaError() *base.SchemaError {
+	return &base.SchemaError{
+		Code:    e.Code,
+		Message: e.Message,
+		File:    e.Start.File,
+		Start: &base.SourcePosition{
+			Line:   uint32(e.Start.LinePosition),
+			Column: uint32(e.Start.ColumnPosition),
+		},
+		End: &base.SourcePosition{
+			Line:   uint32(e.End.LinePosition),
+			Column: uint32(e.End.ColumnPosition),
+		},
+	}
+}
+
+// Errors represents all the errors found in a schema, ordered by their positions.
+type Errors []*Error
+
+// Error returns the first error, so that callers expecting a single error keep getting the first problem of the schema.
+func (e Errors) Error() string {
+	if len(e) == 0 {
+		return ""
+	}
+	return e[0].Error()
+}
+
+// ToSchemaErrors converts the errors into their API representation.
+func (e Errors) ToSchemaErrors() []*base.SchemaError {
+	errs := make([]*base.SchemaError, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err.ToSchemaError())
+	}
+	return errs
+}
+
+// Sort orders the errors by file, line and column.
+func (e Errors) Sort() {
+	sort.SliceStable(e, func(i, j int) bool {
+		a, b := e[i].Start, e[j].Start
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.LinePosition != b.LinePosition {
+			return a.LinePosition < b.LinePosition
+		}
+		return a.ColumnPosition < b.ColumnPosition
+	})
+}
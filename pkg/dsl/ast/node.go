@@ -24,8 +24,9 @@ const (
 	IDENTIFIER ExpressionType = "identifier"
 	INFLIX     ExpressionType = "inflix"
 
-	AND Operator = "and"
-	OR  Operator = "or"
+	AND       Operator = "and"
+	OR        Operator = "or"
+	EXCLUSION Operator = "but not"
 
 	PERMISSION RelationalReferenceType = "permission"
 	RELATION   RelationalReferenceType = "relation"
@@ -50,12 +51,38 @@ type Statement interface {
 	statementNode()
 }
 
+// ImportStatement represents a statement that makes the entities of another source file visible to the file it is in.
+type ImportStatement struct {
+	Comments         []token.Token // Leading comments of the import
+	Import           token.Token   // token.IMPORT
+	Path             token.Token   // token.STRING
+	TrailingComments []token.Token // Comments on the same line as the import
+}
+
+// statementNode is a dummy method that satisfies the Statement interface.
+func (ls *ImportStatement) statementNode() {}
+
+// String returns a string representation of the ImportStatement.
+func (ls *ImportStatement) String() string {
+	var sb strings.Builder
+	sb.WriteString("import")
+	sb.WriteString(" ")
+	sb.WriteString("\"")
+	sb.WriteString(ls.Path.Literal)
+	sb.WriteString("\"")
+	sb.WriteString("\n")
+	return sb.String()
+}
+
 // EntityStatement represents a statement that refers to an entity.
 type EntityStatement struct {
-	Entity               token.Token // token.ENTITY
-	Name                 token.Token // token.IDENT
-	RelationStatements   []Statement // Statements that define relationships between entities
-	PermissionStatements []Statement // Statements that define actions performed on the entity
+	Comments             []token.Token // Leading comments of the entity, a token.NEWLINE among them stands for a blank line
+	Entity               token.Token   // token.ENTITY
+	Name                 token.Token   // token.IDENT
+	RelationStatements   []Statement   // Statements that define relationships between entities
+	PermissionStatements []Statement   // Statements that define actions performed on the entity
+	TrailingComments     []token.Token // Comments on the same line as the name of the entity
+	EndComments          []token.Token // Comments before the closing brace of the entity
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -64,6 +91,7 @@ func (ls *EntityStatement) statementNode() {}
 // String returns a string representation of the EntityStatement.
 func (ls *EntityStatement) String() string {
 	var sb strings.Builder
+	writeDescription(&sb, "", ls.Description())
 	sb.WriteString("entity")
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
@@ -92,11 +120,18 @@ func (ls *EntityStatement) String() string {
 	return sb.String()
 }
 
+// Description returns the text of the doc comment of the EntityStatement.
+func (ls *EntityStatement) Description() string {
+	return Description(ls.Comments)
+}
+
 // RelationStatement represents a statement that defines a relationship between two entities.
 type RelationStatement struct {
-	Relation      token.Token             // token.RELATION
-	Name          token.Token             // token.IDENT
-	RelationTypes []RelationTypeStatement // Statements that define the types of the relationship
+	Comments         []token.Token           // Leading comments of the relation, a token.NEWLINE among them stands for a blank line
+	Relation         token.Token             // token.RELATION
+	Name             token.Token             // token.IDENT
+	RelationTypes    []RelationTypeStatement // Statements that define the types of the relationship
+	TrailingComments []token.Token           // Comments on the same line as the relation
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -105,6 +140,7 @@ func (ls *RelationStatement) statementNode() {}
 // String returns a string representation of the RelationStatement.
 func (ls *RelationStatement) String() string {
 	var sb strings.Builder
+	writeDescription(&sb, "\t", ls.Description())
 	sb.WriteString("\t")
 	sb.WriteString("relation")
 	sb.WriteString(" ")
@@ -121,6 +157,11 @@ func (ls *RelationStatement) String() string {
 	return sb.String()
 }
 
+// Description returns the text of the doc comment of the RelationStatement.
+func (ls *RelationStatement) Description() string {
+	return Description(ls.Comments)
+}
+
 // RelationTypeStatement represents a statement that defines the type of a relationship.
 type RelationTypeStatement struct {
 	Sign     token.Token // token.SIGN
@@ -187,9 +228,11 @@ func (ls *Identifier) GetType() ExpressionType {
 // PermissionStatement represents an action statement, which consists of an action name and an optional expression statement.
 // It implements the Statement interface.
 type PermissionStatement struct {
-	Permission          token.Token // token.PERMISSION
-	Name                token.Token // token.IDENT
+	Comments            []token.Token // Leading comments of the permission, a token.NEWLINE among them stands for a blank line
+	Permission          token.Token   // token.PERMISSION
+	Name                token.Token   // token.IDENT
 	ExpressionStatement Statement
+	TrailingComments    []token.Token // Comments on the same line as the permission
 }
 
 // statementNode is a marker method used to implement the Statement interface.
@@ -198,6 +241,7 @@ func (ls *PermissionStatement) statementNode() {}
 // String returns a string representation of the action statement.
 func (ls *PermissionStatement) String() string {
 	var sb strings.Builder
+	writeDescription(&sb, "\t", ls.Description())
 	sb.WriteString("\t")
 	sb.WriteString("action")
 	sb.WriteString(" ")
@@ -209,6 +253,11 @@ func (ls *PermissionStatement) String() string {
 	return sb.String()
 }
 
+// Description returns the text of the doc comment of the PermissionStatement.
+func (ls *PermissionStatement) Description() string {
+	return Description(ls.Comments)
+}
+
 // ExpressionStatement struct represents an expression statement
 type ExpressionStatement struct {
 	Expression Expression
@@ -228,7 +277,7 @@ func (es *ExpressionStatement) String() string {
 
 // InfixExpression represents an expression with an operator between two sub-expressions.
 type InfixExpression struct {
-	Op       token.Token // The operator token, e.g. and, or.
+	Op       token.Token // The operator token, e.g. and, or, or but for but not.
 	Left     Expression  // The left-hand side sub-expression.
 	Operator Operator    // The operator as a string.
 	Right    Expression  // The right-hand side sub-expression.
@@ -1,5 +1,9 @@
 package ast
 
+import (
+	"permify/pkg/dsl/token"
+)
+
 // Schema represents the parsed schema, which contains all the statements
 // and extracted entity and relational references used by the schema. It
 // is used as an intermediate representation before generating the
@@ -7,6 +11,8 @@ package ast
 type Schema struct {
 	// The list of statements in the schema
 	Statements []Statement
+	// Comments after the last statement of the schema
+	EndComments []token.Token
 	// Map of entity references extracted from the schema
 	entityReferences map[string]struct{}
 	// Map of permission references extracted from the schema
@@ -15,6 +21,18 @@ type Schema struct {
 	relationReferences map[string][]RelationTypeStatement
 	// Map of all relational references extracted from the schema
 	relationalReferences map[string]RelationalReferenceType
+	// Names of the source files the schema is parsed from, empty for a single schema
+	files []string
+}
+
+// SetFiles sets the names of the source files the schema is parsed from
+func (sch *Schema) SetFiles(files []string) {
+	sch.files = files
+}
+
+// GetFiles returns the names of the source files the schema is parsed from
+func (sch *Schema) GetFiles() []string {
+	return sch.files
 }
 
 // SetEntityReferences sets the entity references in the schema
@@ -1,23 +1,24 @@
 package ast
 
 import (
-	"errors"
-	"fmt"
-	"strings"
+	"path"
 
 	"permify/pkg/dsl/token"
 	base "permify/pkg/pb/base/v1"
 	"permify/pkg/tuple"
 )
 
-// Validate - validates the schema to ensure that it meets certain requirements.
+// Validate - validates the schema to ensure that it meets certain requirements. All the problems of the schema are
+// returned together as Errors, ordered by their positions.
 func (sch *Schema) Validate() error {
+	var errs Errors
+
 	// Check that the schema has a definition for the USER entity.
 	if !sch.IsEntityReferenceExist(tuple.USER) {
-		return validationError(token.PositionInfo{
+		errs = append(errs, NewError(token.Token{PositionInfo: token.PositionInfo{
 			LinePosition:   1,
 			ColumnPosition: 1,
-		}, base.ErrorCode_ERROR_CODE_SCHEMA_MUST_HAVE_USER_ENTITY_DEFINITION.String())
+		}}, base.ErrorCode_ERROR_CODE_SCHEMA_MUST_HAVE_USER_ENTITY_DEFINITION, ""))
 	}
 
 	// Loop through all relation references in the schema.
@@ -26,39 +27,119 @@ func (sch *Schema) Validate() error {
 		// Loop through all relation type statements in the relation reference.
 		for _, s := range st {
 			// Check that the relation type statement is valid.
-			if sch.validateRelationTypeStatement(s) != nil {
-				return validationError(s.Type.PositionInfo, base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES.String())
+			if err := sch.validateRelationTypeStatement(s); err != nil {
+				errs = append(errs, err)
 			}
 			// Count the number of direct entity references in the relation type statement.
 			if IsDirectEntityReference(s) {
 				entityReferenceCount++
 			}
 			// Check that the relation type statement has only one direct entity reference.
-			if entityReferenceCount > 1 {
-				return validationError(s.Type.PositionInfo, base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_MUST_HAVE_ONE_ENTITY_REFERENCE.String())
+			if entityReferenceCount == 2 {
+				errs = append(errs, NewError(s.Type, base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_MUST_HAVE_ONE_ENTITY_REFERENCE, ""))
 			}
 		}
 	}
-	return nil
+
+	// Check that imports resolve and that entities of other files are imported before they are referenced.
+	errs = append(errs, sch.validateImports()...)
+
+	if len(errs) == 0 {
+		return nil
+	}
+	// Relation references are kept in a map, the errors are sorted so that they do not depend on its iteration order.
+	errs.Sort()
+	return errs
 }
 
 // validateRelationTypeStatement - validates a single relation type statement to ensure that it meets certain requirements.
-func (sch *Schema) validateRelationTypeStatement(ref RelationTypeStatement) error {
+func (sch *Schema) validateRelationTypeStatement(ref RelationTypeStatement) *Error {
 	// Check that the entity reference in the relation type statement is valid.
 	if !sch.IsEntityReferenceExist(ref.Type.Literal) {
-		return validationError(ref.Type.PositionInfo, base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES.String())
+		return NewError(ref.Type, base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES, "")
 	}
 	// If the relation type statement does not have a direct entity reference, check that the relation reference is valid.
 	if !IsDirectEntityReference(ref) {
 		if !sch.IsRelationReferenceExist(ref.Type.Literal + "#" + ref.Relation.Literal) {
-			return validationError(ref.Type.PositionInfo, base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES.String())
+			return NewError(ref.Type, base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES, "")
 		}
 	}
 	return nil
 }
 
-// validationError - returns a formatted error message.
-func validationError(info token.PositionInfo, message string) error {
-	msg := fmt.Sprintf("%v:%v: %s", info.LinePosition, info.ColumnPosition, strings.ToLower(strings.Replace(strings.Replace(message, "ERROR_CODE_", "", -1), "_", " ", -1)))
-	return errors.New(msg)
+// validateImports - validates that every import refers to a source file of the schema and, for schemas parsed from
+// several files, that relation types only refer to entities of their own file or of the files it imports, directly
+// or transitively.
+func (sch *Schema) validateImports() (errs Errors) {
+	// Index the source files by their cleaned names, so that "./a.perm" and "a.perm" refer to the same file.
+	files := map[string]string{}
+	for _, f := range sch.files {
+		files[path.Clean(f)] = f
+	}
+
+	imports := map[string][]string{}
+	entityFiles := map[string]string{}
+	for _, st := range sch.Statements {
+		switch s := st.(type) {
+		case *ImportStatement:
+			// Import paths are relative to the directory of the importing file.
+			file := s.Import.PositionInfo.File
+			target, ok := files[path.Clean(path.Join(path.Dir(file), s.Path.Literal))]
+			if !ok {
+				errs = append(errs, NewError(s.Path, base.ErrorCode_ERROR_CODE_IMPORT_NOT_FOUND, ""))
+				continue
+			}
+			imports[file] = append(imports[file], target)
+		case *EntityStatement:
+			entityFiles[s.Name.Literal] = s.Name.PositionInfo.File
+		}
+	}
+
+	// A single schema sees all of its entities.
+	if len(sch.files) == 0 {
+		return errs
+	}
+
+	visible := map[string]map[string]struct{}{}
+	for _, st := range sch.Statements {
+		es, ok := st.(*EntityStatement)
+		if !ok {
+			continue
+		}
+		file := es.Name.PositionInfo.File
+		if _, ok = visible[file]; !ok {
+			visible[file] = reachableFiles(file, imports)
+		}
+		for _, rs := range es.RelationStatements {
+			for _, rt := range rs.(*RelationStatement).RelationTypes {
+				// Undefined entities are reported by the relation reference validation.
+				typeFile, defined := entityFiles[rt.Type.Literal]
+				if !defined {
+					continue
+				}
+				if _, ok = visible[file][typeFile]; !ok {
+					errs = append(errs, NewError(rt.Type, base.ErrorCode_ERROR_CODE_ENTITY_NOT_IMPORTED, ""))
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// reachableFiles - returns the given file along with all the files it imports, directly or transitively.
+func reachableFiles(file string, imports map[string][]string) map[string]struct{} {
+	reachable := map[string]struct{}{file: {}}
+	stack := []string{file}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, imported := range imports[current] {
+			if _, ok := reachable[imported]; !ok {
+				reachable[imported] = struct{}{}
+				stack = append(stack, imported)
+			}
+		}
+	}
+	return reachable
 }
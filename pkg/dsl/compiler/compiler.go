@@ -2,8 +2,6 @@ package compiler
 
 import (
 	"errors"
-	"fmt"
-	"strings"
 
 	"permify/pkg/dsl/ast"
 	"permify/pkg/dsl/token"
@@ -27,40 +25,59 @@ func NewCompiler(w bool, sch *ast.Schema) *Compiler {
 	}
 }
 
-// Compile compiles the schema into a list of entity definitions.
+// Compile compiles the schema into a list of entity definitions. The errors of the validation and of all the entities
+// are returned together as ast.Errors, ordered by their positions.
 func (t *Compiler) Compile() (sch []*base.EntityDefinition, err error) {
+	var errs ast.Errors
 	if !t.withoutReferenceValidation {
 		err = t.schema.Validate()
 		if err != nil {
-			return nil, err
+			var validationErrs ast.Errors
+			if !errors.As(err, &validationErrs) {
+				return nil, err
+			}
+			// the entities are still compiled to find the errors of their permissions
+			errs = append(errs, validationErrs...)
 		}
 	}
 
 	entities := make([]*base.EntityDefinition, 0, len(t.schema.Statements))
 	for _, sc := range t.schema.Statements {
-		var en *base.EntityDefinition
+		// imports only affect the visibility of entities, which is checked by the validation
+		if _, ok := sc.(*ast.ImportStatement); ok {
+			continue
+		}
 		es, ok := sc.(*ast.EntityStatement)
 		if !ok {
-			return nil, compileError(es.Entity.PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			return nil, ast.Errors{ast.NewError(token.Token{}, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE, "")}
 		}
-		en, err = t.compile(es)
-		if err != nil {
-			return nil, err
+		en, entityErrs := t.compile(es)
+		if len(entityErrs) > 0 {
+			errs = append(errs, entityErrs...)
+			continue
 		}
 		entities = append(entities, en)
 	}
 
-	return entities, err
+	if len(errs) > 0 {
+		errs.Sort()
+		return nil, errs
+	}
+
+	return entities, nil
 }
 
-// compile - compiles an EntityStatement into an EntityDefinition
-func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, error) {
+// compile - compiles an EntityStatement into an EntityDefinition, along with the errors of all of its statements
+func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, ast.Errors) {
+	var errs ast.Errors
+
 	// Initialize the entity definition
 	entityDefinition := &base.EntityDefinition{
 		Name:        sc.Name.Literal,
 		Relations:   map[string]*base.RelationDefinition{},
 		Permissions: map[string]*base.PermissionDefinition{},
 		References:  map[string]base.EntityDefinition_RelationalReference{},
+		Description: sc.Description(),
 	}
 
 	// Compile relations
@@ -68,13 +85,15 @@ func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, err
 		// Cast the relation statement
 		relationSt, okRs := rs.(*ast.RelationStatement)
 		if !okRs {
-			return nil, compileError(relationSt.Relation.PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			errs = append(errs, ast.NewError(sc.Name, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE, ""))
+			continue
 		}
 
 		// Initialize the relation definition
 		relationDefinition := &base.RelationDefinition{
 			Name:               relationSt.Name.Literal,
 			RelationReferences: []*base.RelationReference{},
+			Description:        relationSt.Description(),
 		}
 
 		// Compile the relation types
@@ -95,24 +114,35 @@ func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, err
 		// Cast the permission statement
 		st, okAs := as.(*ast.PermissionStatement)
 		if !okAs {
-			return nil, compileError(st.Permission.PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			errs = append(errs, ast.NewError(sc.Name, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE, ""))
+			continue
 		}
 
-		// Compile the child expression
+		// Compile the child expression, the first error of each permission is collected
 		ch, err := t.compileExpressionStatement(entityDefinition.GetName(), st.ExpressionStatement.(*ast.ExpressionStatement))
 		if err != nil {
-			return nil, err
+			var compileErr *ast.Error
+			if !errors.As(err, &compileErr) {
+				compileErr = ast.NewError(st.Name, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE, err.Error())
+			}
+			errs = append(errs, compileErr)
+			continue
 		}
 
 		// Initialize the permission definition and reference
 		permissionDefinition := &base.PermissionDefinition{
-			Name:  st.Name.Literal,
-			Child: ch,
+			Name:        st.Name.Literal,
+			Child:       ch,
+			Description: st.Description(),
 		}
 		entityDefinition.Permissions[permissionDefinition.GetName()] = permissionDefinition
 		entityDefinition.References[permissionDefinition.GetName()] = base.EntityDefinition_RELATIONAL_REFERENCE_PERMISSION
 	}
 
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
 	return entityDefinition, nil
 }
 
@@ -132,7 +162,7 @@ func (t *Compiler) compileChildren(entityName string, expression ast.Expression)
 	return t.compileLeaf(entityName, expression)
 }
 
-// compileRewrite - Compiles an InfixExpression node of type OR or AND to a base.Child struct with a base.Rewrite struct
+// compileRewrite - Compiles an InfixExpression node of type OR, AND or BUT NOT to a base.Child struct with a base.Rewrite struct
 // representing the logical operation of the expression. Recursively calls compileChildren to compile the child nodes.
 // Parameters:
 // - entityName: The name of the entity being compiled
@@ -151,6 +181,9 @@ func (t *Compiler) compileRewrite(entityName string, exp *ast.InfixExpression) (
 		rewrite.RewriteOperation = base.Rewrite_OPERATION_UNION
 	case ast.AND:
 		rewrite.RewriteOperation = base.Rewrite_OPERATION_INTERSECTION
+	case ast.EXCLUSION:
+		// the left child is the base, the right child is excluded from it
+		rewrite.RewriteOperation = base.Rewrite_OPERATION_EXCLUSION
 	default:
 		rewrite.RewriteOperation = base.Rewrite_OPERATION_UNSPECIFIED
 	}
@@ -180,8 +213,8 @@ func (t *Compiler) compileRewrite(entityName string, exp *ast.InfixExpression) (
 // compileLeaf compiles a leaf expression into a child object. If the leaf expression is an identifier,
 // it checks whether it is a valid reference to a relational reference, and creates a leaf object accordingly.
 // If the identifier has one segment, it is treated as a reference to a relational reference.
-// If the identifier has two segments, it is treated as a reference to a tuple and its corresponding user set.
-// If the identifier has more than two segments, it is not supported and an error is returned.
+// If the identifier has two or more segments, it is treated as a path of tuple sets, each of them a relation of the
+// entity referenced by the previous one, followed by the relation evaluated on the last entity of the path.
 // The created child object will have a Leaf field, which will be a computed user set identifier for the reference.
 func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*base.Child, error) {
 	child := &base.Child{}
@@ -190,31 +223,31 @@ func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*b
 	if expression.GetType() == ast.IDENTIFIER {
 		ident = expression.(*ast.Identifier)
 	} else {
-		return nil, compileError(token.PositionInfo{
+		return nil, compileError(token.Token{PositionInfo: token.PositionInfo{
 			LinePosition:   1,
 			ColumnPosition: 1,
-		}, base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
+		}}, base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND)
 	}
 
 	// If the identifier has more than two segments, it is not supported
 	if len(ident.Idents) == 0 {
-		return nil, compileError(token.PositionInfo{
+		return nil, compileError(token.Token{PositionInfo: token.PositionInfo{
 			LinePosition:   1,
 			ColumnPosition: 1,
-		}, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}}, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE)
 	}
 
 	// If the identifier has one segment, it is treated as a reference to a relational reference.
 	if len(ident.Idents) == 1 {
 		if !t.withoutReferenceValidation {
 			if !t.schema.IsRelationalReferenceExist(utils.Key(entityName, ident.Idents[0].Literal)) {
-				return nil, compileError(ident.Idents[0].PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+				return nil, compileError(ident.Idents[0], base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE)
 			}
 		}
 
 		leaf, err := t.compileComputedUserSetIdentifier(ident.Idents[0].Literal)
 		if err != nil {
-			return nil, compileError(ident.Idents[0].PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			return nil, compileError(ident.Idents[0], base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE)
 		}
 
 		leaf.Exclusion = ident.IsPrefix()
@@ -222,29 +255,36 @@ func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*b
 		return child, nil
 	}
 
-	// If the identifier has two segments, it is treated as a reference to a tuple and its corresponding user set.
-	if len(ident.Idents) == 2 {
-		if !t.withoutReferenceValidation {
-			types, exist := t.schema.GetRelationReferenceIfExist(utils.Key(entityName, ident.Idents[0].Literal))
+	// If the identifier has two or more segments, it is treated as a path of tuple sets and the user set of its last entity.
+	last := len(ident.Idents) - 1
+	if !t.withoutReferenceValidation {
+		// Walk the path, every segment but the last one is a relation of the entity referenced by the previous segment.
+		entity := entityName
+		for _, segment := range ident.Idents[:last] {
+			types, exist := t.schema.GetRelationReferenceIfExist(utils.Key(entity, segment.Literal))
 			if !exist {
-				return nil, compileError(ident.Idents[0].PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
-			}
-			if !t.schema.IsRelationalReferenceExist(utils.Key(utils.GetBaseEntityRelationTypeStatement(types).Type.Literal, ident.Idents[1].Literal)) {
-				return nil, compileError(ident.Idents[1].PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+				return nil, compileError(segment, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE)
 			}
+			entity = utils.GetBaseEntityRelationTypeStatement(types).Type.Literal
 		}
-
-		leaf, err := t.compileTupleToUserSetIdentifier(ident.Idents[0].Literal, ident.Idents[1].Literal)
-		if err != nil {
-			return nil, compileError(ident.Idents[0].PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		if !t.schema.IsRelationalReferenceExist(utils.Key(entity, ident.Idents[last].Literal)) {
+			return nil, compileError(ident.Idents[last], base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE)
 		}
+	}
 
-		leaf.Exclusion = ident.IsPrefix()
-		child.Type = &base.Child_Leaf{Leaf: leaf}
-		return child, nil
+	tupleSets := make([]string, 0, last)
+	for _, segment := range ident.Idents[:last] {
+		tupleSets = append(tupleSets, segment.Literal)
 	}
 
-	return nil, compileError(ident.Idents[2].PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK.String())
+	leaf, err := t.compileTupleToUserSetIdentifier(tupleSets, ident.Idents[last].Literal)
+	if err != nil {
+		return nil, compileError(ident.Idents[0], base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE)
+	}
+
+	leaf.Exclusion = ident.IsPrefix()
+	child.Type = &base.Child_Leaf{Leaf: leaf}
+	return child, nil
 }
 
 // compileComputedUserSetIdentifier - compiles the computed user set identifier by creating a leaf with a ComputedUserSet type.
@@ -259,25 +299,37 @@ func (t *Compiler) compileComputedUserSetIdentifier(r string) (l *base.Leaf, err
 
 // compileTupleToUserSetIdentifier compiles a tuple to user set identifier to a leaf node in the IR tree.
 // The resulting leaf node is used in the child node of an permission definition in the final compiled schema.
-// It takes in the parameters p and r, which represent the parent and relation of the tuple, respectively.
+// It takes in the parameters p and r, which represent the path of tuple sets and the relation of the last entity of the
+// path, respectively. A path with more than one tuple set is lowered into nested tuple to user sets, e.g.
+// parent.organization.admin evaluates organization.admin on the subjects of parent.
 // It returns a pointer to a leaf node and an error.
-func (t *Compiler) compileTupleToUserSetIdentifier(p, r string) (l *base.Leaf, err error) {
-	leaf := &base.Leaf{}
-	computedUserSet := &base.ComputedUserSet{
-		Relation: r,
+func (t *Compiler) compileTupleToUserSetIdentifier(p []string, r string) (l *base.Leaf, err error) {
+	if len(p) == 0 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
 	}
+	leaf := &base.Leaf{}
 	tupleToUserSet := &base.TupleToUserSet{
 		TupleSet: &base.TupleSet{
-			Relation: p,
+			Relation: p[len(p)-1],
 		},
-		Computed: computedUserSet,
+		Computed: &base.ComputedUserSet{
+			Relation: r,
+		},
+	}
+	// nest the hops from the last one to the first one
+	for i := len(p) - 2; i >= 0; i-- {
+		tupleToUserSet = &base.TupleToUserSet{
+			TupleSet: &base.TupleSet{
+				Relation: p[i],
+			},
+			Next: tupleToUserSet,
+		}
 	}
 	leaf.Type = &base.Leaf_TupleToUserSet{TupleToUserSet: tupleToUserSet}
 	return leaf, nil
 }
 
-// compileError creates an error with the given message and position information.
-func compileError(info token.PositionInfo, message string) error {
-	msg := fmt.Sprintf("%v:%v: %s", info.LinePosition, info.ColumnPosition, strings.ToLower(strings.Replace(strings.Replace(message, "ERROR_CODE_", "", -1), "_", " ", -1)))
-	return errors.New(msg)
+// compileError creates an error about the given token with the description of the error code.
+func compileError(t token.Token, code base.ErrorCode) error {
+	return ast.NewError(t, code, "")
 }
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"permify/pkg/dsl/ast"
 	"permify/pkg/dsl/parser"
 	base "permify/pkg/pb/base/v1"
 )
@@ -356,7 +357,7 @@ var _ = Describe("compiler", func() {
 			c := NewCompiler(false, sch)
 
 			_, err = c.Compile()
-			Expect(err).Should(Equal(errors.New("9:26: undefined relation reference")))
+			Expect(err.Error()).Should(Equal("9:26: undefined relation reference"))
 		})
 
 		It("Case 6", func() {
@@ -377,6 +378,7 @@ var _ = Describe("compiler", func() {
 			entity repository {
 				
 				relation parent @organization
+				relation admin @user
 				permission update = parent.parent.admin or admin
 			}
 			`).Parse()
@@ -385,8 +387,79 @@ var _ = Describe("compiler", func() {
 
 			c := NewCompiler(false, sch)
 
-			_, err = c.Compile()
-			Expect(err).Should(Equal(errors.New("18:40: not supported relation walk")))
+			var is []*base.EntityDefinition
+			is, err = c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(is[3].GetPermissions()["update"].GetChild()).Should(Equal(&base.Child{
+				Type: &base.Child_Rewrite{
+					Rewrite: &base.Rewrite{
+						RewriteOperation: base.Rewrite_OPERATION_UNION,
+						Children: []*base.Child{
+							{
+								Type: &base.Child_Leaf{
+									Leaf: &base.Leaf{
+										Type: &base.Leaf_TupleToUserSet{
+											TupleToUserSet: &base.TupleToUserSet{
+												TupleSet: &base.TupleSet{
+													Relation: "parent",
+												},
+												Next: &base.TupleToUserSet{
+													TupleSet: &base.TupleSet{
+														Relation: "parent",
+													},
+													Computed: &base.ComputedUserSet{
+														Relation: "admin",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Type: &base.Child_Leaf{
+									Leaf: &base.Leaf{
+										Type: &base.Leaf_ComputedUserSet{
+											ComputedUserSet: &base.ComputedUserSet{
+												Relation: "admin",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}))
+
+			sch, err = parser.NewParser(`
+			entity user {}
+
+			entity parent {
+				relation admin @user
+			}
+
+			entity organization {
+				relation parent @parent
+			}
+
+			entity repository {
+				relation parent @organization
+				permission update = parent.parent.owner
+				permission delete = parent.admin.admin
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = NewCompiler(false, sch).Compile()
+
+			var errs ast.Errors
+			Expect(errors.As(err, &errs)).Should(BeTrue())
+			Expect(errs).Should(HaveLen(2))
+			Expect(errs[0].Error()).Should(Equal("14:40: undefined relation reference"))
+			Expect(errs[1].Error()).Should(Equal("15:33: undefined relation reference"))
 		})
 
 		It("Case 7", func() {
@@ -1206,5 +1279,233 @@ var _ = Describe("compiler", func() {
 
 			Expect(is).Should(Equal(i))
 		})
+
+		It("Case 13", func() {
+			sch, err := parser.ParseFiles(map[string]string{
+				"main.perm": `import "org.perm"
+
+entity repository {
+	relation parent @organization
+	relation owner @user
+	permission read = owner or parent.member
+}`,
+				"org.perm": `import "user.perm"
+
+entity organization {
+	relation member @user
+}`,
+				"user.perm": `entity user {}`,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			is, err := NewCompiler(false, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(is).Should(HaveLen(3))
+			Expect(is[0].GetName()).Should(Equal("repository"))
+			Expect(is[1].GetName()).Should(Equal("organization"))
+			Expect(is[2].GetName()).Should(Equal("user"))
+		})
+
+		It("Case 14", func() {
+			sch, err := parser.ParseFiles(map[string]string{
+				"main.perm": `import "org.perm"
+
+entity repository {
+	relation parent @organization
+	relation owner @user
+}`,
+				"org.perm":  `entity organization {}`,
+				"user.perm": `entity user {}`,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = NewCompiler(false, sch).Compile()
+			Expect(err.Error()).Should(Equal("main.perm:5:19: entity not imported"))
+
+			sch, err = parser.ParseFiles(map[string]string{
+				"main.perm": `import "missing.perm"
+entity user {}`,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = NewCompiler(false, sch).Compile()
+			Expect(err.Error()).Should(Equal("main.perm:1:9: import not found"))
+
+			sch, err = parser.ParseFiles(map[string]string{
+				"main.perm": `import "user.perm"
+
+entity repository {
+	relation owner @user
+	permission read = owner or admin
+}`,
+				"user.perm": `entity user {}`,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = NewCompiler(false, sch).Compile()
+			Expect(err.Error()).Should(Equal("main.perm:5:30: undefined relation reference"))
+		})
+
+		It("Case 15", func() {
+			sch, err := parser.NewParser(`
+			// A person that can sign in.
+			entity user {}
+
+			/* A group of users. */
+			entity organization {
+				// members of the organization
+				relation member @user
+
+				// Anyone who can view the organization.
+				permission view = member
+			}`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(false, sch)
+
+			is, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(is).Should(Equal([]*base.EntityDefinition{
+				{
+					Name:        "user",
+					Relations:   map[string]*base.RelationDefinition{},
+					Permissions: map[string]*base.PermissionDefinition{},
+					References:  map[string]base.EntityDefinition_RelationalReference{},
+					Description: "A person that can sign in.",
+				},
+				{
+					Name: "organization",
+					Relations: map[string]*base.RelationDefinition{
+						"member": {
+							Name: "member",
+							RelationReferences: []*base.RelationReference{
+								{
+									Type:     "user",
+									Relation: "",
+								},
+							},
+							Description: "members of the organization",
+						},
+					},
+					Permissions: map[string]*base.PermissionDefinition{
+						"view": {
+							Name: "view",
+							Child: &base.Child{
+								Type: &base.Child_Leaf{
+									Leaf: &base.Leaf{
+										Exclusion: false,
+										Type: &base.Leaf_ComputedUserSet{
+											ComputedUserSet: &base.ComputedUserSet{
+												Relation: "member",
+											},
+										},
+									},
+								},
+							},
+							Description: "Anyone who can view the organization.",
+						},
+					},
+					References: map[string]base.EntityDefinition_RelationalReference{
+						"member": base.EntityDefinition_RELATIONAL_REFERENCE_RELATION,
+						"view":   base.EntityDefinition_RELATIONAL_REFERENCE_PERMISSION,
+					},
+					Description: "A group of users.",
+				},
+			}))
+		})
+
+		It("Case 16", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity organization {
+				relation member @usr
+				relation admin @user
+
+				permission view = member or owner
+				permission edit = admin and parent.admin
+			}
+
+			entity repository {
+				relation owner @user
+				permission read = owner or reader
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(false, sch)
+
+			_, err = c.Compile()
+			Expect(err).Should(HaveOccurred())
+
+			var errs ast.Errors
+			Expect(errors.As(err, &errs)).Should(BeTrue())
+
+			messages := make([]string, 0, len(errs))
+			for _, e := range errs {
+				messages = append(messages, e.Error())
+			}
+			Expect(messages).Should(Equal([]string{
+				"5:23: relation reference not found in entity references",
+				"8:34: undefined relation reference",
+				"9:34: undefined relation reference",
+				"14:33: undefined relation reference",
+			}))
+			Expect(errs[0].Code).Should(Equal(base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES))
+		})
+
+		It("Case 17", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity repository {
+				relation owner @user
+				relation editor @user
+				relation suspended @user
+				relation banned @user
+
+				permission edit = owner or editor but not suspended or banned
+			}`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(false, sch)
+
+			is, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			leaf := func(relation string) *base.Child {
+				return &base.Child{
+					Type: &base.Child_Leaf{
+						Leaf: &base.Leaf{
+							Type: &base.Leaf_ComputedUserSet{
+								ComputedUserSet: &base.ComputedUserSet{
+									Relation: relation,
+								},
+							},
+						},
+					},
+				}
+			}
+
+			rewrite := func(op base.Rewrite_Operation, children ...*base.Child) *base.Child {
+				return &base.Child{
+					Type: &base.Child_Rewrite{
+						Rewrite: &base.Rewrite{
+							RewriteOperation: op,
+							Children:         children,
+						},
+					},
+				}
+			}
+
+			Expect(is[1].GetPermissions()["edit"].GetChild()).Should(Equal(
+				rewrite(base.Rewrite_OPERATION_EXCLUSION,
+					rewrite(base.Rewrite_OPERATION_UNION, leaf("owner"), leaf("editor")),
+					rewrite(base.Rewrite_OPERATION_UNION, leaf("suspended"), leaf("banned")),
+				),
+			))
+		})
 	})
 })
@@ -0,0 +1,200 @@
+package decompiler
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"permify/internal/schema"
+	"permify/pkg/dsl/ast"
+	"permify/pkg/dsl/formatter"
+	"permify/pkg/dsl/token"
+	base "permify/pkg/pb/base/v1"
+)
+
+// Decompiler renders a compiled schema back into the source of the Permify DSL. It is the inverse of the compiler.
+type Decompiler struct {
+	// The compiled schema to be decompiled
+	schema *base.SchemaDefinition
+}
+
+// NewDecompiler returns a new Decompiler instance with the given schema.
+func NewDecompiler(sch *base.SchemaDefinition) *Decompiler {
+	return &Decompiler{
+		schema: sch,
+	}
+}
+
+// Decompile returns the source of the schema in the canonical layout of the formatter. The compiled schema does not
+// keep the order of its definitions, so entities, relations and permissions are ordered by their names. Descriptions
+// are written as doc comments, and compiling the source gives back the same entity definitions.
+func (t *Decompiler) Decompile() (string, error) {
+	names := make([]string, 0, len(t.schema.GetEntityDefinitions()))
+	for name := range t.schema.GetEntityDefinitions() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sch := &ast.Schema{}
+	for _, name := range names {
+		st, err := t.decompile(t.schema.GetEntityDefinitions()[name])
+		if err != nil {
+			return "", err
+		}
+		sch.Statements = append(sch.Statements, st)
+	}
+
+	return formatter.NewFormatter(sch).Format(), nil
+}
+
+// decompile - decompiles an EntityDefinition into an EntityStatement
+func (t *Decompiler) decompile(en *base.EntityDefinition) (*ast.EntityStatement, error) {
+	st := &ast.EntityStatement{
+		Comments: comments(en.GetDescription()),
+		Entity:   token.Token{Type: token.ENTITY, Literal: "entity"},
+		Name:     ident(en.GetName()),
+	}
+
+	for _, name := range sortedKeys(en.GetRelations()) {
+		relation := en.GetRelations()[name]
+		rs := &ast.RelationStatement{
+			Comments: comments(relation.GetDescription()),
+			Relation: token.Token{Type: token.RELATION, Literal: "relation"},
+			Name:     ident(relation.GetName()),
+		}
+		for _, reference := range relation.GetRelationReferences() {
+			rt := ast.RelationTypeStatement{
+				Sign: token.Token{Type: token.SIGN, Literal: "@"},
+				Type: ident(reference.GetType()),
+			}
+			if reference.GetRelation() != "" {
+				rt.Relation = ident(reference.GetRelation())
+			}
+			rs.RelationTypes = append(rs.RelationTypes, rt)
+		}
+		st.RelationStatements = append(st.RelationStatements, rs)
+	}
+
+	for _, name := range sortedKeys(en.GetPermissions()) {
+		permission := en.GetPermissions()[name]
+		ex, err := t.decompileChild(permission.GetChild())
+		if err != nil {
+			return nil, err
+		}
+		st.PermissionStatements = append(st.PermissionStatements, &ast.PermissionStatement{
+			Comments:            comments(permission.GetDescription()),
+			Permission:          token.Token{Type: token.PERMISSION, Literal: "permission"},
+			Name:                ident(permission.GetName()),
+			ExpressionStatement: &ast.ExpressionStatement{Expression: ex},
+		})
+	}
+
+	return st, nil
+}
+
+// decompileChild - decompiles a child of a permission into an expression
+func (t *Decompiler) decompileChild(child *base.Child) (ast.Expression, error) {
+	switch child.GetType().(type) {
+	case *base.Child_Leaf:
+		return t.decompileLeaf(child.GetLeaf())
+	case *base.Child_Rewrite:
+		return t.decompileRewrite(child.GetRewrite())
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String())
+	}
+}
+
+// decompileRewrite - decompiles a rewrite into infix expressions of its children. The compiler gives the rewrite of
+// an infix expression two children, the children of a rewrite with more of them are joined from the left.
+func (t *Decompiler) decompileRewrite(rewrite *base.Rewrite) (ast.Expression, error) {
+	var op token.Token
+	var operator ast.Operator
+	switch rewrite.GetRewriteOperation() {
+	case base.Rewrite_OPERATION_UNION:
+		op, operator = token.Token{Type: token.OR, Literal: "or"}, ast.OR
+	case base.Rewrite_OPERATION_INTERSECTION:
+		op, operator = token.Token{Type: token.AND, Literal: "and"}, ast.AND
+	case base.Rewrite_OPERATION_EXCLUSION:
+		op, operator = token.Token{Type: token.BUT, Literal: "but"}, ast.EXCLUSION
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String())
+	}
+
+	if len(rewrite.GetChildren()) == 0 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_KIND.String())
+	}
+
+	var ex ast.Expression
+	for _, child := range rewrite.GetChildren() {
+		right, err := t.decompileChild(child)
+		if err != nil {
+			return nil, err
+		}
+		if ex == nil {
+			ex = right
+			continue
+		}
+		ex = &ast.InfixExpression{
+			Op:       op,
+			Left:     ex,
+			Operator: operator,
+			Right:    right,
+		}
+	}
+
+	return ex, nil
+}
+
+// decompileLeaf - decompiles a leaf into an identifier
+func (t *Decompiler) decompileLeaf(leaf *base.Leaf) (ast.Expression, error) {
+	var path string
+	switch leaf.GetType().(type) {
+	case *base.Leaf_ComputedUserSet:
+		path = leaf.GetComputedUserSet().GetRelation()
+	case *base.Leaf_TupleToUserSet:
+		path = schema.GetTupleToUserSetPath(leaf.GetTupleToUserSet())
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_KIND.String())
+	}
+
+	identifier := &ast.Identifier{}
+	if leaf.GetExclusion() {
+		identifier.Prefix = token.Token{Type: token.NOT, Literal: "not"}
+	}
+	for _, part := range strings.Split(path, ".") {
+		identifier.Idents = append(identifier.Idents, ident(part))
+	}
+
+	return identifier, nil
+}
+
+// comments - returns the single-line comments of a description, one for each of its lines
+func comments(description string) []token.Token {
+	if description == "" {
+		return nil
+	}
+	lines := strings.Split(description, "\n")
+	tokens := make([]token.Token, 0, len(lines))
+	for _, line := range lines {
+		if line != "" {
+			line = " " + line
+		}
+		tokens = append(tokens, token.Token{Type: token.SINGLE_LINE_COMMENT, Literal: line})
+	}
+	return tokens
+}
+
+// ident - returns an identifier token
+func ident(literal string) token.Token {
+	return token.Token{Type: token.IDENT, Literal: literal}
+}
+
+// sortedKeys - returns the keys of a map in ascending order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,150 @@
+package decompiler
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/pkg/dsl/compiler"
+	"permify/pkg/dsl/parser"
+	base "permify/pkg/pb/base/v1"
+)
+
+// TestDecompiler -
+func TestDecompiler(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "decompiler-suite")
+}
+
+// compile - compiles a schema source into a schema definition
+func compile(input string) *base.SchemaDefinition {
+	sch, err := parser.NewParser(input).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	entities, err := compiler.NewCompiler(false, sch).Compile()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	definition := &base.SchemaDefinition{EntityDefinitions: map[string]*base.EntityDefinition{}}
+	for _, entity := range entities {
+		definition.EntityDefinitions[entity.GetName()] = entity
+	}
+	return definition
+}
+
+var _ = Describe("decompiler", func() {
+	Context("Decompile", func() {
+		It("Case 1", func() {
+			// a canonical source ordered by names is given back as is
+			input := `// A group of users.
+entity organization {
+    relation admin @user
+    // members of the organization
+    relation member @user @team#member
+
+    permission create_repository = admin or member
+    permission delete = admin
+}
+
+entity repository {
+    relation maintainer @user @team#member
+    relation owner @user
+    relation parent @organization
+
+    permission delete = owner or parent.delete
+    // Anyone who can
+    //
+    // read the repository.
+    permission read = (owner or maintainer or parent.member) and not parent.admin
+}
+
+entity team {
+    relation member @user
+}
+
+entity user {}
+`
+
+			output, err := NewDecompiler(compile(input)).Decompile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(output).Should(Equal(input))
+		})
+
+		It("Case 2", func() {
+			input := `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+			}
+
+			entity folder {
+				relation org @organization
+			}
+
+			entity doc {
+				relation parent @folder
+				relation owner @user
+				relation editor @user
+				relation banned @user
+
+				action edit = owner or editor but not (banned or parent.org.admin)
+				action delete = owner and (editor or not banned)
+				action view = edit or (delete and parent.org.admin)
+			}`
+
+			definition := compile(input)
+
+			output, err := NewDecompiler(definition).Decompile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(output).Should(Equal(`entity doc {
+    relation banned @user
+    relation editor @user
+    relation owner @user
+    relation parent @folder
+
+    permission delete = owner and (editor or not banned)
+    permission edit = (owner or editor) but not (banned or parent.org.admin)
+    permission view = edit or (delete and parent.org.admin)
+}
+
+entity folder {
+    relation org @organization
+}
+
+entity organization {
+    relation admin @user
+}
+
+entity user {}
+`))
+
+			// compiling the output gives back the same definitions
+			Expect(compile(output)).Should(Equal(definition))
+		})
+
+		It("Case 3", func() {
+			_, err := NewDecompiler(&base.SchemaDefinition{
+				EntityDefinitions: map[string]*base.EntityDefinition{
+					"user": {
+						Name: "user",
+						Permissions: map[string]*base.PermissionDefinition{
+							"view": {
+								Name: "view",
+								Child: &base.Child{
+									Type: &base.Child_Rewrite{
+										Rewrite: &base.Rewrite{
+											RewriteOperation: base.Rewrite_OPERATION_UNSPECIFIED,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}).Decompile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
+		})
+	})
+})
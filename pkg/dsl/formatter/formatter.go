@@ -0,0 +1,211 @@
+package formatter
+
+import (
+	"strings"
+
+	"permify/pkg/dsl/ast"
+	"permify/pkg/dsl/parser"
+	"permify/pkg/dsl/token"
+)
+
+// indent is the indentation of the statements of an entity.
+const indent = "    "
+
+// Formatter prints a parsed schema in the canonical layout of the Permify DSL.
+type Formatter struct {
+	// The parsed schema to format
+	schema *ast.Schema
+	// The formatted output
+	sb strings.Builder
+}
+
+// NewFormatter creates a new Formatter for the given schema.
+func NewFormatter(sch *ast.Schema) *Formatter {
+	return &Formatter{
+		schema: sch,
+	}
+}
+
+// Format parses the given schema source and returns it in the canonical layout.
+func Format(input string) (string, error) {
+	sch, err := parser.NewParser(input).Parse()
+	if err != nil {
+		return "", err
+	}
+	return NewFormatter(sch).Format(), nil
+}
+
+// Format returns the schema in the canonical layout. Statements keep their order and their comments; relations
+// of an entity are followed by its permissions, each statement is on its own line and expressions use the minimal
+// parentheses that keep their structure. Single blank lines between statements of the same kind are kept.
+func (f *Formatter) Format() string {
+	f.sb.Reset()
+
+	var previous ast.Statement
+	for _, st := range f.schema.Statements {
+		if previous != nil {
+			// imports are grouped, any other statement is separated by a blank line
+			_, previousImport := previous.(*ast.ImportStatement)
+			_, currentImport := st.(*ast.ImportStatement)
+			if !previousImport || !currentImport {
+				f.sb.WriteString("\n")
+			}
+		}
+
+		switch st := st.(type) {
+		case *ast.ImportStatement:
+			f.writeComments("", st.Comments, previous == nil)
+			f.sb.WriteString("import \"")
+			f.sb.WriteString(st.Path.Literal)
+			f.sb.WriteString("\"")
+			f.writeTrailingComments(st.TrailingComments)
+			f.sb.WriteString("\n")
+		case *ast.EntityStatement:
+			f.writeEntity(st)
+		default:
+			continue
+		}
+		previous = st
+	}
+
+	if comments := trimNewlines(f.schema.EndComments); len(comments) > 0 {
+		if previous != nil {
+			f.sb.WriteString("\n")
+		}
+		f.writeComments("", comments, true)
+	}
+
+	return f.sb.String()
+}
+
+// writeEntity writes an entity statement and its relation and permission statements.
+func (f *Formatter) writeEntity(st *ast.EntityStatement) {
+	f.writeComments("", st.Comments, true)
+	f.sb.WriteString("entity ")
+	f.sb.WriteString(st.Name.Literal)
+
+	endComments := trimTrailingNewlines(st.EndComments)
+	if len(st.RelationStatements) == 0 && len(st.PermissionStatements) == 0 && len(endComments) == 0 {
+		f.sb.WriteString(" {}")
+		f.writeTrailingComments(st.TrailingComments)
+		f.sb.WriteString("\n")
+		return
+	}
+
+	f.sb.WriteString(" {")
+	f.writeTrailingComments(st.TrailingComments)
+	f.sb.WriteString("\n")
+
+	for i, rs := range st.RelationStatements {
+		rs := rs.(*ast.RelationStatement)
+		f.writeComments(indent, rs.Comments, i == 0)
+		f.sb.WriteString(indent)
+		f.sb.WriteString("relation ")
+		f.sb.WriteString(rs.Name.Literal)
+		for _, rt := range rs.RelationTypes {
+			f.sb.WriteString(" ")
+			f.sb.WriteString(rt.String())
+		}
+		f.writeTrailingComments(rs.TrailingComments)
+		f.sb.WriteString("\n")
+	}
+
+	// relations and permissions are separated by a blank line
+	if len(st.RelationStatements) > 0 && len(st.PermissionStatements) > 0 {
+		f.sb.WriteString("\n")
+	}
+
+	for i, ps := range st.PermissionStatements {
+		ps := ps.(*ast.PermissionStatement)
+		f.writeComments(indent, ps.Comments, i == 0)
+		f.sb.WriteString(indent)
+		f.sb.WriteString("permission ")
+		f.sb.WriteString(ps.Name.Literal)
+		f.sb.WriteString(" = ")
+		if es, ok := ps.ExpressionStatement.(*ast.ExpressionStatement); ok {
+			f.sb.WriteString(Expression(es.Expression))
+		}
+		f.writeTrailingComments(ps.TrailingComments)
+		f.sb.WriteString("\n")
+	}
+
+	empty := len(st.RelationStatements) == 0 && len(st.PermissionStatements) == 0
+	f.writeComments(indent, endComments, empty)
+	f.sb.WriteString("}\n")
+}
+
+// writeComments writes leading comments, each on its own line, and a blank line for each newline token among them.
+// The blank lines before the first comment are left out if first is true.
+func (f *Formatter) writeComments(prefix string, comments []token.Token, first bool) {
+	if first {
+		comments = trimLeadingNewlines(comments)
+	}
+	for _, comment := range comments {
+		if comment.Type == token.NEWLINE {
+			f.sb.WriteString("\n")
+			continue
+		}
+		f.sb.WriteString(prefix)
+		f.sb.WriteString(Comment(comment))
+		f.sb.WriteString("\n")
+	}
+}
+
+// writeTrailingComments writes the comments that follow a statement on the same line.
+func (f *Formatter) writeTrailingComments(comments []token.Token) {
+	for _, comment := range comments {
+		f.sb.WriteString(" ")
+		f.sb.WriteString(Comment(comment))
+	}
+}
+
+// Comment returns the source of a comment token. The content of multi-line comments is kept as is.
+func Comment(comment token.Token) string {
+	if comment.Type == token.MULTI_LINE_COMMENT {
+		return "/*" + comment.Literal + "*/"
+	}
+	return "//" + strings.TrimRight(comment.Literal, " \t\r")
+}
+
+// Expression returns the source of an expression. Infix expressions are left-associative, so the left operand is
+// only parenthesized if its operator differs from the operator of the expression, and an infix right operand is
+// always parenthesized.
+func Expression(ex ast.Expression) string {
+	infix, ok := ex.(*ast.InfixExpression)
+	if !ok {
+		return ex.String()
+	}
+
+	left := Expression(infix.Left)
+	if l, ok := infix.Left.(*ast.InfixExpression); ok && l.Operator != infix.Operator {
+		left = "(" + left + ")"
+	}
+
+	right := Expression(infix.Right)
+	if infix.Right.IsInfix() {
+		right = "(" + right + ")"
+	}
+
+	return left + " " + infix.Operator.String() + " " + right
+}
+
+// trimNewlines removes the newline tokens at both ends of the comments.
+func trimNewlines(comments []token.Token) []token.Token {
+	return trimTrailingNewlines(trimLeadingNewlines(comments))
+}
+
+// trimLeadingNewlines removes the newline tokens before the first comment.
+func trimLeadingNewlines(comments []token.Token) []token.Token {
+	for len(comments) > 0 && comments[0].Type == token.NEWLINE {
+		comments = comments[1:]
+	}
+	return comments
+}
+
+// trimTrailingNewlines removes the newline tokens after the last comment.
+func trimTrailingNewlines(comments []token.Token) []token.Token {
+	for len(comments) > 0 && comments[len(comments)-1].Type == token.NEWLINE {
+		comments = comments[:len(comments)-1]
+	}
+	return comments
+}
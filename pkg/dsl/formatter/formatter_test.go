@@ -0,0 +1,185 @@
+package formatter
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/pkg/dsl/compiler"
+	"permify/pkg/dsl/parser"
+)
+
+// TestFormatter -
+func TestFormatter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "formatter-suite")
+}
+
+var _ = Describe("formatter", func() {
+	Context("Format", func() {
+		It("Case 1", func() {
+			formatted, err := Format(`
+entity user {}
+entity organization {
+	  relation admin   @user
+relation member @user
+		action create_repository = admin    or member
+	action delete = admin
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(Equal(`entity user {}
+
+entity organization {
+    relation admin @user
+    relation member @user
+
+    permission create_repository = admin or member
+    permission delete = admin
+}
+`))
+		})
+
+		It("Case 2", func() {
+			formatted, err := Format(`// the users
+entity user {} // trailing
+
+
+/* detached */
+
+// the organizations
+entity organization { // members and admins
+	// admins
+
+	relation admin @user
+	relation member @user @organization#member   // members
+
+	relation banned @user
+	permission view = (member or admin) and not banned
+	permission edit = admin and (member or admin)
+	permission delete = ((admin))
+
+	// end of organization
+}
+
+// end of file
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(Equal(`// the users
+entity user {} // trailing
+
+/* detached */
+
+// the organizations
+entity organization { // members and admins
+    // admins
+
+    relation admin @user
+    relation member @user @organization#member // members
+
+    relation banned @user
+
+    permission view = (member or admin) and not banned
+    permission edit = admin and (member or admin)
+    permission delete = admin
+
+    // end of organization
+}
+
+// end of file
+`))
+
+			// formatting is idempotent
+			again, err := Format(formatted)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again).Should(Equal(formatted))
+		})
+
+		It("Case 3", func() {
+			input := `
+			import "user.perm"
+
+			entity organization {
+				relation admin @user
+				relation member @user
+				relation parent @organization
+
+				action a = admin or member and parent.admin
+				action b = admin and (member or parent.admin) or not member
+				action c = (admin or member) and (parent.admin or parent.member) and admin
+				action d = admin or (member and (parent.admin or parent.member))
+			}`
+
+			formatted, err := Format(input)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(Equal(`import "user.perm"
+
+entity organization {
+    relation admin @user
+    relation member @user
+    relation parent @organization
+
+    permission a = (admin or member) and parent.admin
+    permission b = admin and (member or parent.admin or not member)
+    permission c = (admin or member) and ((parent.admin or parent.member) and admin)
+    permission d = admin or (member and (parent.admin or parent.member))
+}
+`))
+
+			// formatting keeps the compiled schema
+			before, err := parser.NewParser(input + "\nentity user {}").Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			after, err := parser.NewParser(formatted + "\nentity user {}").Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			b, err := compiler.NewCompiler(true, before).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			a, err := compiler.NewCompiler(true, after).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(a).Should(Equal(b))
+		})
+
+		It("Case 4", func() {
+			_, err := Format(`entity user {`)
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 5", func() {
+			input := `
+			entity repository {
+				relation owner @user
+				relation editor @user
+				relation banned @user
+
+				permission a = owner or editor but not banned
+				permission b = editor but not (owner or banned)
+				permission c = editor but not owner but not banned
+			}`
+
+			formatted, err := Format(input)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(Equal(`entity repository {
+    relation owner @user
+    relation editor @user
+    relation banned @user
+
+    permission a = (owner or editor) but not banned
+    permission b = editor but not (owner or banned)
+    permission c = editor but not owner but not banned
+}
+`))
+
+			// formatting keeps the compiled schema
+			before, err := parser.NewParser(input + "\nentity user {}").Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			after, err := parser.NewParser(formatted + "\nentity user {}").Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			b, err := compiler.NewCompiler(true, before).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			a, err := compiler.NewCompiler(true, after).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(a).Should(Equal(b))
+		})
+	})
+})
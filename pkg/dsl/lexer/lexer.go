@@ -6,6 +6,8 @@ import (
 
 // Lexer - represents a lexical analyzer for the input source code.
 type Lexer struct {
+	// The name of the source file the input is read from, empty for a single schema.
+	file string
 	// The input source code to be analyzed.
 	input string
 	// The current position in the input source code.
@@ -22,11 +24,22 @@ type Lexer struct {
 
 // NewLexer - creates a new Lexer instance with the given input source code.
 func NewLexer(input string) (l *Lexer) {
-	l = &Lexer{input: input, linePosition: 1, columnPosition: 1}
+	return NewFileLexer("", input)
+}
+
+// NewFileLexer - creates a new Lexer instance with the given input source code of a named file.
+// The file name is carried in the position info of every token.
+func NewFileLexer(file, input string) (l *Lexer) {
+	l = &Lexer{file: file, input: input, linePosition: 1, columnPosition: 1}
 	l.readChar()
 	return
 }
 
+// GetFile - returns the name of the source file the Lexer reads from.
+func (l *Lexer) GetFile() string {
+	return l.file
+}
+
 // GetLinePosition - returns the current line position of the Lexer in the input source code.
 func (l *Lexer) GetLinePosition() int {
 	return l.linePosition
@@ -62,41 +75,52 @@ func (l *Lexer) NextToken() (tok token.Token) {
 	// switch statement to determine the type of token based on the current character
 	switch l.ch {
 	case '\t':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.TAB, l.ch)
+		tok = token.New(l.positionInfo(), token.TAB, l.ch)
 	case ' ':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.SPACE, l.ch)
+		tok = token.New(l.positionInfo(), token.SPACE, l.ch)
 	case '\n':
 		l.newLine()
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.NEWLINE, l.ch)
+		tok = token.New(l.positionInfo(), token.NEWLINE, l.ch)
 	case '\r':
 		l.newLine()
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.NEWLINE, l.ch)
+		tok = token.New(l.positionInfo(), token.NEWLINE, l.ch)
 	case ';':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.NEWLINE, l.ch)
+		tok = token.New(l.positionInfo(), token.NEWLINE, l.ch)
 	case '=':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.ASSIGN, l.ch)
+		tok = token.New(l.positionInfo(), token.ASSIGN, l.ch)
 	case '@':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.SIGN, l.ch)
+		tok = token.New(l.positionInfo(), token.SIGN, l.ch)
 	case '(':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.LPAREN, l.ch)
+		tok = token.New(l.positionInfo(), token.LPAREN, l.ch)
 	case ')':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.RPAREN, l.ch)
+		tok = token.New(l.positionInfo(), token.RPAREN, l.ch)
 	case '{':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.LBRACE, l.ch)
+		tok = token.New(l.positionInfo(), token.LBRACE, l.ch)
 	case '}':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.RBRACE, l.ch)
+		tok = token.New(l.positionInfo(), token.RBRACE, l.ch)
 	case ',':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.COMMA, l.ch)
+		tok = token.New(l.positionInfo(), token.COMMA, l.ch)
 	case '#':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.HASH, l.ch)
+		tok = token.New(l.positionInfo(), token.HASH, l.ch)
 	case '.':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.DOT, l.ch)
+		tok = token.New(l.positionInfo(), token.DOT, l.ch)
+	case '"':
+		// lex a string literal, the literal of the token does not include the quotes
+		tok.PositionInfo = l.positionInfo()
+		if str, ok := l.lexString(); ok {
+			tok.Type = token.STRING
+			tok.Literal = str
+		} else {
+			tok.Type = token.ILLEGAL
+			tok.Literal = str
+			return
+		}
 	case 0:
-		tok = token.Token{PositionInfo: positionInfo(l.linePosition, l.columnPosition), Type: token.EOF, Literal: ""}
+		tok = token.Token{PositionInfo: l.positionInfo(), Type: token.EOF, Literal: ""}
 	default:
 		// check if the character is a letter, and if so, lex the identifier and look up the keyword
 		if isLetter(l.ch) {
-			tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+			tok.PositionInfo = l.positionInfo()
 			tok.Literal = l.lexIdent()
 			tok.Type = token.LookupKeywords(tok.Literal)
 			return
@@ -104,19 +128,19 @@ func (l *Lexer) NextToken() (tok token.Token) {
 
 		if l.ch == '/' && l.peekChar() == '/' {
 			// check if the character is the start of a single-line comment
-			tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+			tok.PositionInfo = l.positionInfo()
 			tok.Literal = l.lexSingleLineComment()
 			tok.Type = token.SINGLE_LINE_COMMENT
 			return
 		} else if l.ch == '/' && l.peekChar() == '*' {
 			// check if the character is the start of a multi-line comment
-			tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+			tok.PositionInfo = l.positionInfo()
 			tok.Literal = l.lexMultiLineComment()
 			tok.Type = token.MULTI_LINE_COMMENT
 			return
 		} else {
 			// if none of the above cases match, create an illegal token with the current character
-			tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.ILLEGAL, l.ch)
+			tok = token.New(l.positionInfo(), token.ILLEGAL, l.ch)
 		}
 	}
 	// read the next character and return the token
@@ -165,6 +189,10 @@ func (l *Lexer) lexMultiLineComment() string {
 		if l.ch == 0 {
 			return l.input[position:l.position]
 		}
+		// keep the line position of the following tokens right
+		if isNewline(l.ch) {
+			l.newLine()
+		}
 		l.readChar()
 	}
 	l.readChar()
@@ -172,6 +200,21 @@ func (l *Lexer) lexMultiLineComment() string {
 	return l.input[position : l.position-2]
 }
 
+// lexString - reads a string literal enclosed in double quotes and returns its content. A string literal cannot span
+// lines; false is returned if the closing quote is missing.
+func (l *Lexer) lexString() (string, bool) {
+	position := l.position + 1
+	for {
+		l.readChar()
+		if l.ch == '"' {
+			return l.input[position:l.position], true
+		}
+		if l.ch == 0 || isNewline(l.ch) {
+			return l.input[position:l.position], false
+		}
+	}
+}
+
 // isNewline - returns true if the given byte is a newline character (\r or \n).
 func isNewline(r byte) bool {
 	return r == '\r' || r == '\n'
@@ -182,10 +225,11 @@ func isLetter(ch byte) bool {
 	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
 }
 
-// positionInfo - returns a token.PositionInfo struct with the current line and column position.
-func positionInfo(line, column int) token.PositionInfo {
+// positionInfo - returns a token.PositionInfo struct with the current file, line and column position.
+func (l *Lexer) positionInfo() token.PositionInfo {
 	return token.PositionInfo{
-		LinePosition:   line,
-		ColumnPosition: column,
+		File:           l.file,
+		LinePosition:   l.linePosition,
+		ColumnPosition: l.columnPosition,
 	}
 }
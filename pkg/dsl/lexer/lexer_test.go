@@ -650,5 +650,42 @@ entity organization {
 				Expect(index + lexeme.Literal).Should(Equal(index + tt.expectedLiteral))
 			}
 		})
+
+		It("Case 8", func() {
+			str := `import "teams/org.perm"
+import "broken
+entity user {}`
+
+			tests := []struct {
+				expectedType    token.Type
+				expectedLiteral string
+			}{
+				{token.IMPORT, "import"},
+				{token.SPACE, " "},
+				{token.STRING, "teams/org.perm"},
+				{token.NEWLINE, "\n"},
+				{token.IMPORT, "import"},
+				{token.SPACE, " "},
+				{token.ILLEGAL, "broken"},
+				{token.NEWLINE, "\n"},
+				{token.ENTITY, "entity"},
+				{token.SPACE, " "},
+				{token.IDENT, "user"},
+				{token.SPACE, " "},
+				{token.LBRACE, "{"},
+				{token.RBRACE, "}"},
+				{token.EOF, ""},
+			}
+
+			l := NewFileLexer("main.perm", str)
+
+			for i, tt := range tests {
+				lexeme := l.NextToken()
+				index := strconv.Itoa(i) + ": "
+				Expect(index + lexeme.Type.String()).Should(Equal(index + tt.expectedType.String()))
+				Expect(index + lexeme.Literal).Should(Equal(index + tt.expectedLiteral))
+				Expect(index + lexeme.PositionInfo.File).Should(Equal(index + "main.perm"))
+			}
+		})
 	})
 })
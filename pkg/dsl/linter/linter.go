@@ -0,0 +1,447 @@
+package linter
+
+import (
+	"fmt"
+	"sort"
+
+	"permify/internal/schema"
+	"permify/pkg/dsl/ast"
+	"permify/pkg/dsl/compiler"
+	"permify/pkg/dsl/token"
+	base "permify/pkg/pb/base/v1"
+)
+
+// anySubject stands for all the subjects in a subject set, it is in the set of a permission that excludes subjects
+// without granting any
+const anySubject = "*"
+
+// subjects is a set of subject types, e.g. "user" or "team#member"
+type subjects map[string]struct{}
+
+// Linter finds the problems of a schema that compiles, such as unused relations and permissions that can never be
+// granted. It works on the compiled entity definitions and the linked schema graph, the AST gives the positions of
+// the warnings.
+type Linter struct {
+	// The AST schema to be linted
+	schema *ast.Schema
+	// The compiled schema
+	definition *base.SchemaDefinition
+	// The name tokens of the definitions, ["entity"] for entities and ["entity#name"] for relations and permissions
+	names map[string]token.Token
+	// The subject types each relation and permission can be granted to, ["entity#name"]
+	subjects map[string]subjects
+}
+
+// NewLinter returns a new Linter instance with the given schema.
+func NewLinter(sch *ast.Schema) *Linter {
+	return &Linter{
+		schema:   sch,
+		names:    map[string]token.Token{},
+		subjects: map[string]subjects{},
+	}
+}
+
+// Lint compiles the schema and returns its warnings, ordered by their positions. The errors of the compiler are
+// returned if the schema does not compile.
+func (l *Linter) Lint() (Warnings, error) {
+	entities, err := compiler.NewCompiler(false, l.schema).Compile()
+	if err != nil {
+		return nil, err
+	}
+	l.definition = schema.NewSchemaFromEntityDefinitions(entities...)
+
+	for _, st := range l.schema.Statements {
+		es, ok := st.(*ast.EntityStatement)
+		if !ok {
+			continue
+		}
+		l.names[es.Name.Literal] = es.Name
+		for _, rs := range es.RelationStatements {
+			rs := rs.(*ast.RelationStatement)
+			l.names[key(es.Name.Literal, rs.Name.Literal)] = rs.Name
+		}
+		for _, ps := range es.PermissionStatements {
+			ps := ps.(*ast.PermissionStatement)
+			l.names[key(es.Name.Literal, ps.Name.Literal)] = ps.Name
+		}
+	}
+
+	l.resolveSubjects()
+
+	var warnings Warnings
+	warnings = append(warnings, l.unusedRelations()...)
+	warnings = append(warnings, l.permissions()...)
+	warnings = append(warnings, l.unreachableEntities()...)
+	warnings.Sort()
+
+	return warnings, nil
+}
+
+// resolveSubjects - finds the subject types each relation and permission can be granted to. The sets only grow while
+// the definitions are evaluated, so they are evaluated again until none of them changes. Definitions that are only
+// granted through themselves are left with an empty set.
+func (l *Linter) resolveSubjects() {
+	for changed := true; changed; {
+		changed = false
+		for _, en := range l.entities() {
+			for _, name := range sortedKeys(en.GetRelations()) {
+				s := subjects{}
+				for _, ref := range en.GetRelations()[name].GetRelationReferences() {
+					if ref.GetRelation() == "" {
+						s[ref.GetType()] = struct{}{}
+						continue
+					}
+					s[key(ref.GetType(), ref.GetRelation())] = struct{}{}
+					s = union(s, l.subjects[key(ref.GetType(), ref.GetRelation())])
+				}
+				changed = l.set(key(en.GetName(), name), s) || changed
+			}
+			for _, name := range sortedKeys(en.GetPermissions()) {
+				changed = l.set(key(en.GetName(), name), l.childSubjects(en, en.GetPermissions()[name].GetChild())) || changed
+			}
+		}
+	}
+}
+
+// set - sets the subjects of a definition, reports whether they changed
+func (l *Linter) set(k string, s subjects) bool {
+	if equal(l.subjects[k], s) {
+		return false
+	}
+	l.subjects[k] = s
+	return true
+}
+
+// childSubjects - returns the subject types a child of a permission can be granted to with the current sets
+func (l *Linter) childSubjects(en *base.EntityDefinition, child *base.Child) subjects {
+	switch child.GetType().(type) {
+	case *base.Child_Rewrite:
+		rewrite := child.GetRewrite()
+		var result subjects
+		for i, c := range rewrite.GetChildren() {
+			s := l.childSubjects(en, c)
+			switch {
+			case i == 0:
+				result = s
+			case rewrite.GetRewriteOperation() == base.Rewrite_OPERATION_UNION:
+				result = union(result, s)
+			case rewrite.GetRewriteOperation() == base.Rewrite_OPERATION_INTERSECTION:
+				result = intersection(result, s)
+			}
+			// the excluded children of an exclusion only remove subjects
+		}
+		return result
+	case *base.Child_Leaf:
+		leaf := child.GetLeaf()
+		if leaf.GetExclusion() {
+			return subjects{anySubject: {}}
+		}
+		switch leaf.GetType().(type) {
+		case *base.Leaf_ComputedUserSet:
+			return l.subjects[key(en.GetName(), leaf.GetComputedUserSet().GetRelation())]
+		case *base.Leaf_TupleToUserSet:
+			return l.tupleToUserSetSubjects(en, leaf.GetTupleToUserSet())
+		}
+	}
+	return subjects{}
+}
+
+// tupleToUserSetSubjects - returns the subject types a tuple to user set can be granted to, through each of the
+// entity types of its tuple set relation
+func (l *Linter) tupleToUserSetSubjects(en *base.EntityDefinition, ttu *base.TupleToUserSet) subjects {
+	s := subjects{}
+	for _, t := range l.tupleSetTypes(en, ttu) {
+		if ttu.GetNext() != nil {
+			s = union(s, l.tupleToUserSetSubjects(l.definition.GetEntityDefinitions()[t], ttu.GetNext()))
+			continue
+		}
+		s = union(s, l.subjects[key(t, ttu.GetComputed().GetRelation())])
+	}
+	return s
+}
+
+// tupleSetTypes - returns the entity types of the tuple set relation of a tuple to user set
+func (l *Linter) tupleSetTypes(en *base.EntityDefinition, ttu *base.TupleToUserSet) []string {
+	var types []string
+	seen := map[string]struct{}{}
+	for _, ref := range en.GetRelations()[ttu.GetTupleSet().GetRelation()].GetRelationReferences() {
+		if _, ok := seen[ref.GetType()]; ok {
+			continue
+		}
+		if _, ok := l.definition.GetEntityDefinitions()[ref.GetType()]; !ok {
+			continue
+		}
+		seen[ref.GetType()] = struct{}{}
+		types = append(types, ref.GetType())
+	}
+	return types
+}
+
+// unusedRelations - reports the relations that no permission and no relation type refers to
+func (l *Linter) unusedRelations() (warnings Warnings) {
+	used := map[string]struct{}{}
+	for _, en := range l.entities() {
+		for _, relation := range en.GetRelations() {
+			for _, ref := range relation.GetRelationReferences() {
+				if ref.GetRelation() != "" {
+					used[key(ref.GetType(), ref.GetRelation())] = struct{}{}
+				}
+			}
+		}
+		for _, permission := range en.GetPermissions() {
+			for _, d := range l.dependencies(en, permission.GetChild()) {
+				used[d.key] = struct{}{}
+			}
+		}
+	}
+
+	for _, en := range l.entities() {
+		for _, name := range sortedKeys(en.GetRelations()) {
+			if _, ok := used[key(en.GetName(), name)]; ok {
+				continue
+			}
+			warnings = append(warnings, l.warning(key(en.GetName(), name), UnusedRelation, fmt.Sprintf("relation %s of %s is not used by any permission or relation", name, en.GetName())))
+		}
+	}
+	return
+}
+
+// permissions - reports the permissions that can never be granted, that recurse without a base case and that only
+// exclude subjects
+func (l *Linter) permissions() (warnings Warnings) {
+	for _, en := range l.entities() {
+		for _, name := range sortedKeys(en.GetPermissions()) {
+			k := key(en.GetName(), name)
+			s := l.subjects[k]
+			switch {
+			case len(s) == 0 && l.recursive(k):
+				warnings = append(warnings, l.warning(k, RecursionWithoutBase, fmt.Sprintf("permission %s of %s is only granted through itself, the tuple to user set recursion has no base case", name, en.GetName())))
+			case len(s) == 0:
+				warnings = append(warnings, l.warning(k, NeverGranted, fmt.Sprintf("permission %s of %s can never be granted, no subject type satisfies it", name, en.GetName())))
+			case has(s, anySubject):
+				warnings = append(warnings, l.warning(k, PureNegation, fmt.Sprintf("permission %s of %s reduces to pure negation, it is granted to any subject that is not excluded", name, en.GetName())))
+			}
+		}
+	}
+	return
+}
+
+// dependency is a relation or permission a permission refers to
+type dependency struct {
+	key string
+	// whether the dependency is reached through a tuple to user set
+	ttu bool
+}
+
+// dependencies - returns the relations and permissions a child of a permission refers to, including the tuple set
+// relations of its tuple to user sets
+func (l *Linter) dependencies(en *base.EntityDefinition, child *base.Child) (deps []dependency) {
+	switch child.GetType().(type) {
+	case *base.Child_Rewrite:
+		for _, c := range child.GetRewrite().GetChildren() {
+			deps = append(deps, l.dependencies(en, c)...)
+		}
+	case *base.Child_Leaf:
+		leaf := child.GetLeaf()
+		switch leaf.GetType().(type) {
+		case *base.Leaf_ComputedUserSet:
+			deps = append(deps, dependency{key: key(en.GetName(), leaf.GetComputedUserSet().GetRelation())})
+		case *base.Leaf_TupleToUserSet:
+			deps = append(deps, l.tupleToUserSetDependencies(en, leaf.GetTupleToUserSet())...)
+		}
+	}
+	return
+}
+
+// tupleToUserSetDependencies - returns the tuple set relations and the relations or permissions a tuple to user set ends at
+func (l *Linter) tupleToUserSetDependencies(en *base.EntityDefinition, ttu *base.TupleToUserSet) (deps []dependency) {
+	deps = append(deps, dependency{key: key(en.GetName(), ttu.GetTupleSet().GetRelation())})
+	for _, t := range l.tupleSetTypes(en, ttu) {
+		if ttu.GetNext() != nil {
+			deps = append(deps, l.tupleToUserSetDependencies(l.definition.GetEntityDefinitions()[t], ttu.GetNext())...)
+			continue
+		}
+		deps = append(deps, dependency{key: key(t, ttu.GetComputed().GetRelation()), ttu: true})
+	}
+	return
+}
+
+// recursive - checks if a permission refers back to itself through a tuple to user set
+func (l *Linter) recursive(k string) bool {
+	type state struct {
+		key string
+		ttu bool
+	}
+	visited := map[state]struct{}{}
+	queue := []state{{key: k}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		en, permission, ok := l.permission(current.key)
+		if !ok {
+			continue
+		}
+		for _, d := range l.dependencies(en, permission.GetChild()) {
+			next := state{key: d.key, ttu: current.ttu || d.ttu}
+			if next.key == k && next.ttu {
+				return true
+			}
+			if _, ok := visited[next]; ok {
+				continue
+			}
+			visited[next] = struct{}{}
+			queue = append(queue, next)
+		}
+	}
+	return false
+}
+
+// unreachableEntities - reports the entities that are not a subject type of a relation of another entity and that
+// none of the subject types can be related to, according to the linked schema graph. References of an entity to
+// itself do not make it reachable, an island such as a folder tree that nothing else refers to is reported.
+func (l *Linter) unreachableEntities() (warnings Warnings) {
+	referenced := map[string]struct{}{}
+	for _, en := range l.entities() {
+		for _, relation := range en.GetRelations() {
+			for _, ref := range relation.GetRelationReferences() {
+				if ref.GetType() == en.GetName() {
+					continue
+				}
+				referenced[ref.GetType()] = struct{}{}
+			}
+		}
+	}
+
+	var sources []string
+	for t := range referenced {
+		sources = append(sources, t)
+	}
+	sort.Strings(sources)
+
+	g := schema.NewLinkedGraph(l.definition)
+	for _, en := range l.entities() {
+		if _, ok := referenced[en.GetName()]; ok {
+			continue
+		}
+		if l.reachable(g, en, sources) {
+			continue
+		}
+		warnings = append(warnings, l.warning(en.GetName(), UnreachableEntity, fmt.Sprintf("entity %s is unreachable from any subject", en.GetName())))
+	}
+	return
+}
+
+// reachable - checks if any of the subject types can be related to a relation or permission of an entity
+func (l *Linter) reachable(g *schema.LinkedSchemaGraph, en *base.EntityDefinition, sources []string) bool {
+	var targets []string
+	targets = append(targets, sortedKeys(en.GetRelations())...)
+	targets = append(targets, sortedKeys(en.GetPermissions())...)
+	for _, target := range targets {
+		for _, source := range sources {
+			entrances, err := g.RelationshipLinkedEntrances(&base.RelationReference{
+				Type:     en.GetName(),
+				Relation: target,
+			}, &base.RelationReference{
+				Type:     source,
+				Relation: "",
+			})
+			if err == nil && len(entrances) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// permission - returns the entity and the definition of the permission of a key
+func (l *Linter) permission(k string) (*base.EntityDefinition, *base.PermissionDefinition, bool) {
+	for _, en := range l.definition.GetEntityDefinitions() {
+		for name, permission := range en.GetPermissions() {
+			if key(en.GetName(), name) == k {
+				return en, permission, true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+// entities - returns the entities of the schema ordered by their names
+func (l *Linter) entities() []*base.EntityDefinition {
+	entities := make([]*base.EntityDefinition, 0, len(l.definition.GetEntityDefinitions()))
+	for _, name := range sortedKeys(l.definition.GetEntityDefinitions()) {
+		entities = append(entities, l.definition.GetEntityDefinitions()[name])
+	}
+	return entities
+}
+
+// warning - creates a warning about the name of a definition
+func (l *Linter) warning(k, rule, message string) *Warning {
+	return newWarning(l.names[k], rule, message)
+}
+
+// key - returns the key of a relation or permission of an entity
+func key(entity, name string) string {
+	return entity + "#" + name
+}
+
+// union - returns the subject types of either of the sets
+func union(a, b subjects) subjects {
+	result := subjects{}
+	for k := range a {
+		result[k] = struct{}{}
+	}
+	for k := range b {
+		result[k] = struct{}{}
+	}
+	if has(result, anySubject) {
+		return subjects{anySubject: {}}
+	}
+	return result
+}
+
+// intersection - returns the subject types of both of the sets, any subject is in both of the sets with any other set
+func intersection(a, b subjects) subjects {
+	if has(a, anySubject) {
+		return b
+	}
+	if has(b, anySubject) {
+		return a
+	}
+	result := subjects{}
+	for k := range a {
+		if has(b, k) {
+			result[k] = struct{}{}
+		}
+	}
+	return result
+}
+
+// equal - checks if the sets contain the same subject types
+func equal(a, b subjects) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k := range a {
+		if !has(b, k) {
+			return false
+		}
+	}
+	return true
+}
+
+// has - checks if a set contains a subject type
+func has(s subjects, k string) bool {
+	_, ok := s[k]
+	return ok
+}
+
+// sortedKeys - returns the keys of a map in ascending order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
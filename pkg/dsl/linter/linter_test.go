@@ -0,0 +1,161 @@
+package linter
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"permify/pkg/dsl/ast"
+	"permify/pkg/dsl/parser"
+)
+
+// TestLinter -
+func TestLinter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "linter-suite")
+}
+
+// lint - lints a schema source and returns its warnings as strings
+func lint(input string) []string {
+	sch, err := parser.NewParser(input).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	warnings, err := NewLinter(sch).Lint()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	result := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		result = append(result, w.String())
+	}
+	return result
+}
+
+var _ = Describe("linter", func() {
+	Context("Lint", func() {
+		It("Case 1", func() {
+			Expect(lint(`
+entity user {}
+
+entity organization {
+    relation member @user
+    relation admin @user
+
+    permission view = member or admin
+}
+
+entity repository {
+    relation parent @organization
+    relation owner @user
+
+    permission read = owner or parent.view
+}
+`)).Should(BeEmpty())
+		})
+
+		It("Case 2", func() {
+			Expect(lint(`
+entity user {}
+
+entity team {
+    relation member @user
+}
+
+entity organization {
+    relation member @user
+    relation admin @user
+    relation owner @team
+
+    permission view = member and owner
+    permission delete = not admin
+    permission edit = admin or not member
+}
+`)).Should(Equal([]string{
+				"5:15: relation member of team is not used by any permission or relation (unused-relation)",
+				"13:17: permission view of organization can never be granted, no subject type satisfies it (never-granted)",
+				"14:17: permission delete of organization reduces to pure negation, it is granted to any subject that is not excluded (pure-negation)",
+				"15:17: permission edit of organization reduces to pure negation, it is granted to any subject that is not excluded (pure-negation)",
+			}))
+		})
+
+		It("Case 3", func() {
+			Expect(lint(`
+entity user {}
+
+entity folder {
+    relation parent @folder
+    relation owner @user
+
+    permission view = parent.view
+    permission read = owner or parent.read
+}
+`)).Should(Equal([]string{
+				"8:17: permission view of folder is only granted through itself, the tuple to user set recursion has no base case (recursion-without-base)",
+			}))
+		})
+
+		It("Case 4", func() {
+			Expect(lint(`
+entity user {}
+
+entity organization {
+    relation admin @user
+}
+
+entity folder {
+    relation org @organization
+}
+
+entity doc {
+    relation parent @folder
+    relation editor @user
+    relation banned @user
+
+    permission edit = editor but not banned
+    permission delete = parent.org.admin
+}
+
+entity orphan {}
+`)).Should(Equal([]string{
+				"21:9: entity orphan is unreachable from any subject (unreachable-entity)",
+			}))
+		})
+
+		It("Case 5", func() {
+			sch, err := parser.NewParser(`
+entity user {}
+
+entity organization {
+    relation member @usr
+}
+`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = NewLinter(sch).Lint()
+			Expect(err).Should(HaveOccurred())
+
+			var errs ast.Errors
+			Expect(errors.As(err, &errs)).Should(BeTrue())
+		})
+
+		It("Case 6", func() {
+			Expect(lint(`
+entity user {}
+
+entity organization {
+    relation member @user
+
+    permission view = member
+}
+
+entity folder {
+    relation parent @folder
+}
+`)).Should(Equal([]string{
+				"10:9: entity folder is unreachable from any subject (unreachable-entity)",
+				"11:15: relation parent of folder is not used by any permission or relation (unused-relation)",
+			}))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package linter
+
+import (
+	"fmt"
+	"sort"
+
+	"permify/pkg/dsl/token"
+	base "permify/pkg/pb/base/v1"
+)
+
+const (
+	// UnusedRelation - a relation that no permission and no relation type refers to
+	UnusedRelation = "unused-relation"
+	// NeverGranted - a permission that no subject can ever be granted
+	NeverGranted = "never-granted"
+	// PureNegation - a permission that only excludes subjects, so it is granted to any subject that is not excluded
+	PureNegation = "pure-negation"
+	// RecursionWithoutBase - a permission that is only granted through itself by following tuple to user sets
+	RecursionWithoutBase = "recursion-without-base"
+	// UnreachableEntity - an entity that no subject can be related to
+	UnreachableEntity = "unreachable-entity"
+)
+
+// Warning represents a problem found in a schema that compiles. Start is the position of the first character of the
+// name of the definition the warning is about and End is the position right after its last character.
+type Warning struct {
+	Rule    string
+	Start   token.PositionInfo
+	End     token.PositionInfo
+	Message string
+}
+
+// newWarning creates a warning about the name of a definition.
+func newWarning(name token.Token, rule, message string) *Warning {
+	end := name.PositionInfo
+	end.ColumnPosition += len(name.Literal)
+	return &Warning{
+		Rule:    rule,
+		Start:   name.PositionInfo,
+		End:     end,
+		Message: message,
+	}
+}
+
+// String returns the warning prefixed with its position and followed by its rule,
+// e.g. "main.perm:4:14: relation member of team is not used (unused-relation)".
+func (w *Warning) String() string {
+	return fmt.Sprintf("%s: %s (%s)", w.Start.String(), w.Message, w.Rule)
+}
+
+// ToSchemaLintWarning converts the warning into its API representation.
+func (w *Warning) ToSchemaLintWarning() *base.SchemaLintWarning {
+	return &base.SchemaLintWarning{
+		Rule:    w.Rule,
+		Message: w.Message,
+		File:    w.Start.File,
+		Start: &base.SourcePosition{
+			Line:   uint32(w.Start.LinePosition),
+			Column: uint32(w.Start.ColumnPosition),
+		},
+		End: &base.SourcePosition{
+			Line:   uint32(w.End.LinePosition),
+			Column: uint32(w.End.ColumnPosition),
+		},
+	}
+}
+
+// Warnings represents all the warnings found in a schema, ordered by their positions.
+type Warnings []*Warning
+
+// ToSchemaLintWarnings converts the warnings into their API representation.
+func (w Warnings) ToSchemaLintWarnings() []*base.SchemaLintWarning {
+	warnings := make([]*base.SchemaLintWarning, 0, len(w))
+	for _, warning := range w {
+		warnings = append(warnings, warning.ToSchemaLintWarning())
+	}
+	return warnings
+}
+
+// Sort orders the warnings by file, line and column.
+func (w Warnings) Sort() {
+	sort.SliceStable(w, func(i, j int) bool {
+		a, b := w[i].Start, w[j].Start
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.LinePosition != b.LinePosition {
+			return a.LinePosition < b.LinePosition
+		}
+		return a.ColumnPosition < b.ColumnPosition
+	})
+}
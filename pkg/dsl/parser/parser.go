@@ -1,13 +1,14 @@
 package parser
 
 import (
-	"errors"
 	"fmt"
+	"sort"
 
 	"permify/pkg/dsl/ast"
 	"permify/pkg/dsl/lexer"
 	"permify/pkg/dsl/token"
 	"permify/pkg/dsl/utils"
+	base "permify/pkg/pb/base/v1"
 )
 
 const (
@@ -16,6 +17,9 @@ const (
 
 	// LOWEST precedence level for lowest precedence
 	LOWEST
+	// EXCLUSION precedence level for the exclusion operator (BUT NOT), it binds looser than the logical operators,
+	// so "a or b but not c and d" is parsed as "(a or b) but not (c and d)"
+	EXCLUSION
 	// LOGIC precedence level for logical operators (AND, OR)
 	LOGIC
 	// PREFIX precedence level for prefix operators (NOT)
@@ -25,6 +29,7 @@ const (
 var precedences = map[token.Type]int{ // a map that assigns precedence levels to different token types
 	token.AND: LOGIC,
 	token.OR:  LOGIC,
+	token.BUT: EXCLUSION,
 }
 
 // Parser is a struct that contains information and functions related to parsing
@@ -35,8 +40,18 @@ type Parser struct {
 	currentToken token.Token
 	// the next token after currentToken
 	peekToken token.Token
-	// a slice of error messages that are generated during parsing
-	errors []string
+	// the leading comments of currentToken
+	currentComments []token.Token
+	// the leading comments of peekToken
+	peekComments []token.Token
+	// the leading comments that are collected for the next token that is not ignored
+	comments []token.Token
+	// the number of line breaks since the last token or collected comment
+	newlines int
+	// the comments that follow other tokens on the same line, keyed by line
+	trailingComments map[int][]token.Token
+	// the errors that are found during parsing, parsing recovers from an error at the next statement
+	errors []*ast.Error
 	// a map that associates prefix parsing functions with token types
 	prefixParseFns map[token.Type]prefixParseFn
 	// a map that associates infix parsing functions with token types
@@ -75,11 +90,12 @@ func NewParser(str string) (p *Parser) {
 	// initialize a new Parser object with the given input string and default values for other fields
 	p = &Parser{
 		l:                    lexer.NewLexer(str),                      // create a new Lexer object with the input string
-		errors:               []string{},                               // initialize an empty slice of error messages
+		errors:               []*ast.Error{},                           // initialize an empty slice of errors
 		entityReferences:     map[string]struct{}{},                    // initialize an empty map for entity references
 		relationReferences:   map[string][]ast.RelationTypeStatement{}, // initialize an empty map for relation references
 		actionReferences:     map[string]struct{}{},                    // initialize an empty map for action references
 		relationalReferences: map[string]ast.RelationalReferenceType{}, // initialize an empty map for relational references
+		trailingComments:     map[int][]token.Token{},                  // initialize an empty map for trailing comments
 	}
 
 	// register prefix parsing functions for token types IDENT and NOT
@@ -87,74 +103,69 @@ func NewParser(str string) (p *Parser) {
 	p.registerPrefix(token.IDENT, p.parseIdentifier)      // associate the parseIdentifier function with the IDENT token type
 	p.registerPrefix(token.NOT, p.parsePrefixExpression)  // associate the parsePrefixExpression function with the NOT token type
 
-	// register infix parsing functions for token types AND and OR
-	p.infixParseFunc = make(map[token.Type]infixParseFn) // initialize an empty map for infix parsing functions
-	p.registerInfix(token.AND, p.parseInfixExpression)   // associate the parseInfixExpression function with the AND token type
-	p.registerInfix(token.OR, p.parseInfixExpression)    // associate the parseInfixExpression function with the OR token type
+	// register infix parsing functions for token types AND, OR and BUT
+	p.infixParseFunc = make(map[token.Type]infixParseFn)   // initialize an empty map for infix parsing functions
+	p.registerInfix(token.AND, p.parseInfixExpression)     // associate the parseInfixExpression function with the AND token type
+	p.registerInfix(token.OR, p.parseInfixExpression)      // associate the parseInfixExpression function with the OR token type
+	p.registerInfix(token.BUT, p.parseExclusionExpression) // associate the parseExclusionExpression function with the BUT token type
 
 	return // return the newly created Parser object
 }
 
-// setEntityReference adds a new entity reference to the Parser's entityReferences map
-func (p *Parser) setEntityReference(key string) error {
+// setEntityReference adds a new entity reference to the Parser's entityReferences map.
+// A duplicated entity is recorded as an error, which does not stop the parsing of the rest of the schema.
+func (p *Parser) setEntityReference(name token.Token) {
 	// if the entityReferences map is nil, initialize it
 	if p.entityReferences == nil {
 		p.entityReferences = map[string]struct{}{}
 	}
-	// check if the entity type has already been referenced, and return an error if it has
-	if _, ok := p.entityReferences[key]; ok {
-		p.duplicationError(key) // generate an error message indicating a duplication error
-		return p.Error()        // return the error message
+	// check if the entity type has already been referenced, and record an error if it has
+	if _, ok := p.entityReferences[name.Literal]; ok {
+		p.duplicationError(name, base.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE)
+		return
 	}
 	// add the entity type to the entityReferences map
-	p.entityReferences[key] = struct{}{}
-	return nil // return nil to indicate that there was no error
+	p.entityReferences[name.Literal] = struct{}{}
 }
 
-// setRelationReference adds a new relation reference to the Parser's relationReferences and relationalReferences maps
-func (p *Parser) setRelationReference(key string, types []ast.RelationTypeStatement) error {
+// setRelationReference adds a new relation reference to the Parser's relationReferences and relationalReferences maps.
+// A duplicated relation is recorded as an error, which does not stop the parsing of the rest of the schema.
+func (p *Parser) setRelationReference(key string, name token.Token, types []ast.RelationTypeStatement) {
 	// if the relationReferences map is nil, initialize it
 	if p.relationReferences == nil {
 		p.relationReferences = map[string][]ast.RelationTypeStatement{}
 	}
-	// check if the relation type has already been referenced, and return an error if it has
-	if _, ok := p.relationReferences[key]; ok {
-		p.duplicationError(key) // generate an error message indicating a duplication error
-		return p.Error()        // return the error message
-	}
-	// check if the relation type has already been added to the relationalReferences map, and return an error if it has
-	if _, ok := p.relationalReferences[key]; ok {
-		p.duplicationError(key) // generate an error message indicating a duplication error
-		return p.Error()        // return the error message
+	// check if the relation type has already been referenced as a relation or a permission, and record an error if it has
+	_, relation := p.relationReferences[key]
+	_, relational := p.relationalReferences[key]
+	if relation || relational {
+		p.duplicationError(name, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE)
+		return
 	}
 	// add the relation type and its associated RelationTypeStatements to the relationReferences map
 	p.relationReferences[key] = types
 	// add the relation type to the relationalReferences map, with a value of RELATION to indicate that it is a relation reference
 	p.relationalReferences[key] = ast.RELATION
-	return nil // return nil to indicate that there was no error
 }
 
-// setPermissionReference adds a new action reference to the Parser's actionReferences and relationalReferences maps
-func (p *Parser) setPermissionReference(key string) error {
+// setPermissionReference adds a new action reference to the Parser's actionReferences and relationalReferences maps.
+// A duplicated permission is recorded as an error, which does not stop the parsing of the rest of the schema.
+func (p *Parser) setPermissionReference(key string, name token.Token) {
 	// if the actionReferences map is nil, initialize it
 	if p.actionReferences == nil {
 		p.actionReferences = map[string]struct{}{}
 	}
-	// check if the action type has already been referenced, and return an error if it has
-	if _, ok := p.actionReferences[key]; ok {
-		p.duplicationError(key) // generate an error message indicating a duplication error
-		return p.Error()        // return the error message
-	}
-	// check if the action type has already been added to the relationalReferences map, and return an error if it has
-	if _, ok := p.relationalReferences[key]; ok {
-		p.duplicationError(key) // generate an error message indicating a duplication error
-		return p.Error()        // return the error message
+	// check if the action type has already been referenced as a permission or a relation, and record an error if it has
+	_, action := p.actionReferences[key]
+	_, relational := p.relationalReferences[key]
+	if action || relational {
+		p.duplicationError(name, base.ErrorCode_ERROR_CODE_DUPLICATED_PERMISSION_REFERENCE)
+		return
 	}
 	// add the action type to the actionReferences map
 	p.actionReferences[key] = struct{}{}
 	// add the action type to the relationalReferences map, with a value of PERMISSION to indicate that it is an action reference
 	p.relationalReferences[key] = ast.PERMISSION
-	return nil // return nil to indicate that there was no error
 }
 
 // next retrieves the next non-ignored token from the Parser's lexer and updates the Parser's currentToken and peekToken fields
@@ -166,12 +177,55 @@ func (p *Parser) next() {
 		if !token.IsIgnores(peek.Type) {
 			// set the currentToken field to the previous peekToken value
 			p.currentToken = p.peekToken
+			p.currentComments = p.peekComments
 			// set the peekToken field to the new peek value
 			p.peekToken = peek
+			p.peekComments = p.takeComments(peek)
 			// exit the loop
 			break
 		}
+		// if the token is a comment, collect it as a leading or trailing comment
+		if peek.Type == token.SINGLE_LINE_COMMENT || peek.Type == token.MULTI_LINE_COMMENT {
+			p.collectComment(peek)
+		}
+	}
+}
+
+// collectComment collects a comment as a leading comment of the next token that is not ignored. A comment that
+// follows other tokens on the same line is collected as a trailing comment of that line instead.
+func (p *Parser) collectComment(comment token.Token) {
+	if !p.peekTokenIs("", token.NEWLINE) {
+		p.trailingComments[comment.PositionInfo.LinePosition] = append(p.trailingComments[comment.PositionInfo.LinePosition], comment)
+		return
+	}
+	p.comments = append(p.comments, comment)
+	p.newlines = 0
+}
+
+// takeComments returns the leading comments collected for the given token and resets the collected comments.
+// Newlines do not take comments; a blank line is collected as a newline token among the comments instead.
+func (p *Parser) takeComments(tok token.Token) (comments []token.Token) {
+	if tok.Type == token.NEWLINE {
+		// carriage returns and semicolons do not start a new line on their own
+		if tok.Literal == "\n" {
+			p.newlines++
+			if p.newlines == 2 {
+				p.comments = append(p.comments, tok)
+			}
+		}
+		return nil
 	}
+	comments = p.comments
+	p.comments = nil
+	p.newlines = 0
+	return comments
+}
+
+// takeTrailingComments returns the comments that follow other tokens on the given line and removes them.
+func (p *Parser) takeTrailingComments(line int) (comments []token.Token) {
+	comments = p.trailingComments[line]
+	delete(p.trailingComments, line)
+	return comments
 }
 
 // currentTokenIs checks if the Parser's currentToken is any of the given token types
@@ -200,14 +254,14 @@ func (p *Parser) peekTokenIs(tokens ...token.Type) bool {
 	return false
 }
 
-// Error returns an error if there are any errors in the Parser's errors slice
+// Error returns the errors in the Parser's errors slice as ast.Errors, or nil if there are none
 func (p *Parser) Error() error {
 	// if there are no errors, return nil
 	if len(p.errors) == 0 {
 		return nil
 	}
-	// if there are errors, return the first error message in the errors slice as an error type
-	return errors.New(p.errors[0])
+	// the errors are found in the order of the input, so the first error is the first problem of the input
+	return ast.Errors(p.errors)
 }
 
 // Parse reads and parses the input string and returns an AST representation of the schema, along with any errors encountered during parsing
@@ -216,37 +270,119 @@ func (p *Parser) Parse() (*ast.Schema, error) {
 	schema := &ast.Schema{}
 	schema.Statements = []ast.Statement{}
 
+	// parse the statements of the input string, the errors of all statements are returned together
+	p.parseStatements(schema)
+	if err := p.Error(); err != nil {
+		return nil, err
+	}
+
+	// the comments after the last statement are the leading comments of the EOF token
+	schema.EndComments = p.currentComments
+
+	// set the schema's references fields to the corresponding maps in the Parser
+	p.setReferences(schema)
+
+	// return the parsed schema object and nil to indicate that there were no errors
+	return schema, nil
+}
+
+// ParseFiles parses a set of named source files, keyed by their names, into a single AST representation of the schema.
+// The files share their references, so an entity can only be defined once across all files. The positions of the
+// tokens carry the name of the file they are read from, which qualifies the positions of errors.
+func ParseFiles(files map[string]string) (*ast.Schema, error) {
+	// parse the files in the order of their names, so that errors do not depend on map iteration order
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	schema := &ast.Schema{}
+	schema.Statements = []ast.Statement{}
+
+	p := NewParser("")
+	for _, name := range names {
+		// reset the lexer and the tokens for the next file
+		p.l = lexer.NewFileLexer(name, files[name])
+		p.currentToken = token.Token{}
+		p.peekToken = token.Token{}
+		p.currentComments, p.peekComments, p.comments, p.newlines = nil, nil, nil, 0
+		p.trailingComments = map[int][]token.Token{}
+
+		p.parseStatements(schema)
+	}
+
+	// the errors of all files are returned together
+	if err := p.Error(); err != nil {
+		return nil, err
+	}
+
+	p.setReferences(schema)
+	schema.SetFiles(names)
+
+	return schema, nil
+}
+
+// parseStatements parses the statements of the Parser's input until the end is reached and adds them to the schema.
+// If a statement cannot be parsed, its error is recorded and parsing continues from the next top level statement.
+func (p *Parser) parseStatements(schema *ast.Schema) {
 	// loop through the input string until the end is reached
 	for !p.currentTokenIs(token.EOF) {
+		start := p.currentToken
 		// parse the next statement in the input string
 		stmt, err := p.parseStatement()
 		if err != nil {
-			// if there was an error parsing the statement, return the error message
-			return nil, p.Error()
+			// skip the rest of the broken statement
+			p.synchronize(start, token.ENTITY, token.IMPORT)
+			continue
 		}
 		if stmt != nil {
 			// add the parsed statement to the schema's Statements field if it is not nil
 			schema.Statements = append(schema.Statements, stmt)
 		}
 
+		// an entity that is not closed ends at the start of the next statement, which must not be skipped
+		if p.currentTokenIs(token.ENTITY) || p.currentTokenIs(token.IMPORT) || p.currentTokenIs(token.EOF) {
+			continue
+		}
+
 		// move to the next token in the input string
 		p.next()
 	}
+}
 
-	// set the schema's references fields to the corresponding maps in the Parser
+// synchronize skips the rest of a statement that could not be parsed, until the current token is one of the given
+// types or the end of the input is reached. The statement may have failed at its first token, which is skipped so that
+// parsing makes progress.
+func (p *Parser) synchronize(start token.Token, types ...token.Type) {
+	if p.currentToken.PositionInfo == start.PositionInfo {
+		p.next()
+	}
+	for !p.currentTokenIs(token.EOF) {
+		for _, t := range types {
+			if p.currentTokenIs(t) {
+				return
+			}
+		}
+		p.next()
+	}
+}
+
+// setReferences sets the schema's references fields to the corresponding maps in the Parser
+func (p *Parser) setReferences(schema *ast.Schema) {
 	schema.SetEntityReferences(p.entityReferences)
 	schema.SetRelationReferences(p.relationReferences)
 	schema.SetPermissionReferences(p.actionReferences)
 	schema.SetRelationalReferences(p.relationalReferences)
-
-	// return the parsed schema object and nil to indicate that there were no errors
-	return schema, nil
 }
 
 // parseStatement method parses the current statement based on its defined token types
 func (p *Parser) parseStatement() (ast.Statement, error) {
 	// switch on the currentToken's type to determine which type of statement to parse
 	switch p.currentToken.Type {
+	case token.IMPORT:
+		// if the currentToken is IMPORT, parse an ImportStatement
+		return p.parseImportStatement()
 	case token.ENTITY:
 		// if the currentToken is ENTITY, parse an EntityStatement
 		return p.parseEntityStatement()
@@ -256,10 +392,23 @@ func (p *Parser) parseStatement() (ast.Statement, error) {
 	}
 }
 
+// parseImportStatement method parses an IMPORT statement and returns an ImportStatement AST node
+func (p *Parser) parseImportStatement() (*ast.ImportStatement, error) {
+	// create a new ImportStatement object and set its Import field to the currentToken
+	stmt := &ast.ImportStatement{Comments: p.currentComments, Import: p.currentToken}
+	// expect the next token to be a string token, and set the ImportStatement's Path field to it
+	if !p.expectAndNext(token.STRING) {
+		return nil, p.Error()
+	}
+	stmt.Path = p.currentToken
+	stmt.TrailingComments = p.takeTrailingComments(stmt.Import.PositionInfo.LinePosition)
+	return stmt, nil
+}
+
 // parseEntityStatement method parses an ENTITY statement and returns an EntityStatement AST node
 func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 	// create a new EntityStatement object and set its Entity field to the currentToken
-	stmt := &ast.EntityStatement{Entity: p.currentToken}
+	stmt := &ast.EntityStatement{Comments: p.currentComments, Entity: p.currentToken}
 	// expect the next token to be an identifier token, and set the EntityStatement's Name field to the identifier's value
 	if !p.expectAndNext(token.IDENT) {
 		return nil, p.Error()
@@ -267,10 +416,7 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 	stmt.Name = p.currentToken
 
 	// add the entity reference to the Parser's entityReferences map
-	err := p.setEntityReference(stmt.Name.Literal)
-	if err != nil {
-		return nil, err
-	}
+	p.setEntityReference(stmt.Name)
 
 	// expect the next token to be a left brace token, indicating the start of the entity's body
 	if !p.expectAndNext(token.LBRACE) {
@@ -279,37 +425,48 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 
 	// loop through the entity's body until a right brace token is encountered
 	for !p.currentTokenIs(token.RBRACE) {
-		// if the currentToken is EOF, raise an error and return nil for both the statement and error values
-		if p.currentTokenIs(token.EOF) {
+		// if the currentToken is EOF or the start of the next entity, the entity is not closed. The error is recorded
+		// and the entity ends here, so that the next entity is still parsed
+		if p.currentTokenIs(token.EOF) || p.currentTokenIs(token.ENTITY) {
 			p.currentError(token.RBRACE)
-			return nil, p.Error()
+			return stmt, nil
 		}
+		start := p.currentToken
 		// based on the currentToken's type, parse a RelationStatement or PermissionStatement and add it to the EntityStatement's corresponding field
 		switch p.currentToken.Type {
 		case token.RELATION:
 			relation, err := p.parseRelationStatement(stmt.Name.Literal)
 			if err != nil {
-				return nil, p.Error()
+				// skip the rest of the broken relation and continue with the next statement of the entity
+				p.synchronize(start, token.RELATION, token.PERMISSION, token.RBRACE, token.ENTITY)
+				continue
 			}
 			stmt.RelationStatements = append(stmt.RelationStatements, relation)
 		case token.PERMISSION:
 			action, err := p.parsePermissionStatement(stmt.Name.Literal)
 			if err != nil {
-				return nil, p.Error()
+				// skip the rest of the broken permission and continue with the next statement of the entity
+				p.synchronize(start, token.RELATION, token.PERMISSION, token.RBRACE, token.ENTITY)
+				continue
 			}
 			stmt.PermissionStatements = append(stmt.PermissionStatements, action)
 		default:
 			// if the currentToken is not recognized, check if it is a newline, left brace, or right brace token, and skip it if it is
 			if !p.currentTokenIs(token.NEWLINE) && !p.currentTokenIs(token.LBRACE) && !p.currentTokenIs(token.RBRACE) {
-				// if the currentToken is not recognized and not a newline, left brace, or right brace token, raise an error and return nil for both the statement and error values
+				// if the currentToken is not recognized and not a newline, left brace, or right brace token, record an error and skip to the next statement of the entity
 				p.currentError(token.RELATION, token.PERMISSION)
-				return nil, p.Error()
+				p.synchronize(start, token.RELATION, token.PERMISSION, token.RBRACE, token.ENTITY)
+				continue
 			}
 		}
 		// move to the next token in the input string
 		p.next()
 	}
 
+	// the comments before the closing brace are the leading comments of the right brace token
+	stmt.EndComments = p.currentComments
+	stmt.TrailingComments = p.takeTrailingComments(stmt.Name.PositionInfo.LinePosition)
+
 	// return the parsed EntityStatement and nil for the error value
 	return stmt, nil
 }
@@ -317,7 +474,7 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 // parseRelationStatement method parses a RELATION statement and returns a RelationStatement AST node
 func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStatement, error) {
 	// create a new RelationStatement object and set its Relation field to the currentToken
-	stmt := &ast.RelationStatement{Relation: p.currentToken}
+	stmt := &ast.RelationStatement{Comments: p.currentComments, Relation: p.currentToken}
 
 	// expect the next token to be an identifier token, and set the RelationStatement's Name field to the identifier's value
 	if !p.expectAndNext(token.IDENT) {
@@ -342,10 +499,9 @@ func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStateme
 	}
 
 	// add the relation reference to the Parser's relationReferences and relationalReferences maps
-	err := p.setRelationReference(utils.Key(entityName, relationName), stmt.RelationTypes)
-	if err != nil {
-		return nil, err
-	}
+	p.setRelationReference(utils.Key(entityName, relationName), stmt.Name, stmt.RelationTypes)
+
+	stmt.TrailingComments = p.takeTrailingComments(stmt.Name.PositionInfo.LinePosition)
 
 	// return the parsed RelationStatement and nil for the error value
 	return stmt, nil
@@ -382,7 +538,7 @@ func (p *Parser) parseRelationTypeStatement() (*ast.RelationTypeStatement, error
 // parsePermissionStatement method parses an PERMISSION statement and returns an PermissionStatement AST node
 func (p *Parser) parsePermissionStatement(entityName string) (ast.Statement, error) {
 	// create a new PermissionStatement object and set its Permission field to the currentToken
-	stmt := &ast.PermissionStatement{Permission: p.currentToken}
+	stmt := &ast.PermissionStatement{Comments: p.currentComments, Permission: p.currentToken}
 
 	// expect the next token to be an identifier token, and set the PermissionStatement's Name field to the identifier's value
 	if !p.expectAndNext(token.IDENT) {
@@ -391,10 +547,7 @@ func (p *Parser) parsePermissionStatement(entityName string) (ast.Statement, err
 	stmt.Name = p.currentToken
 
 	// add the action reference to the Parser's actionReferences and relationalReferences maps
-	err := p.setPermissionReference(utils.Key(entityName, stmt.Name.Literal))
-	if err != nil {
-		return nil, err
-	}
+	p.setPermissionReference(utils.Key(entityName, stmt.Name.Literal), stmt.Name)
 
 	// expect the next token to be an ASSIGN token, indicating the start of the expression to be assigned to the action
 	if !p.expectAndNext(token.ASSIGN) {
@@ -409,6 +562,7 @@ func (p *Parser) parsePermissionStatement(entityName string) (ast.Statement, err
 		return nil, p.Error()
 	}
 	stmt.ExpressionStatement = ex
+	stmt.TrailingComments = p.takeTrailingComments(stmt.Name.PositionInfo.LinePosition)
 
 	// return the parsed PermissionStatement and nil for the error value
 	return stmt, nil
@@ -592,6 +746,33 @@ func (p *Parser) parseInfixExpression(left ast.Expression) (ast.Expression, erro
 	return expression, nil
 }
 
+// parseExclusionExpression parses an exclusion expression, such as "editor but not (suspended or banned)".
+// It takes the left operand as an argument, expects the BUT token to be followed by a NOT token, and parses
+// the right operand with the exclusion precedence, so the excluded operand extends over logical operators
+// and consecutive exclusions are grouped from the left.
+// It returns the resulting InfixExpression and any error encountered.
+func (p *Parser) parseExclusionExpression(left ast.Expression) (ast.Expression, error) {
+	// Create a new InfixExpression with the left operand and the BUT token as its operator token.
+	expression := &ast.InfixExpression{
+		Op:       p.currentToken,
+		Left:     left,
+		Operator: ast.EXCLUSION,
+	}
+	// Get the precedence of the BUT token and consume the BUT and NOT tokens.
+	precedence := p.currentPrecedence()
+	if !p.expectAndNext(token.NOT) {
+		return nil, p.Error()
+	}
+	p.next()
+	// Parse the excluded operand.
+	ex, err := p.parseExpression(precedence)
+	if err != nil {
+		return nil, p.Error()
+	}
+	expression.Right = ex
+	return expression, nil
+}
+
 // peekPrecedence returns the precedence of the next token in the input, if it is a known
 // operator, or the lowest precedence otherwise.
 func (p *Parser) peekPrecedence() int {
@@ -641,33 +822,32 @@ func (p *Parser) registerInfix(tokenType token.Type, fn infixParseFn) {
 	p.infixParseFunc[tokenType] = fn
 }
 
-// duplicationError adds an error message to the parser's error list indicating that a duplication was found.
-// It takes a key string as an argument that is used to identify the source of the duplication in the input.
-func (p *Parser) duplicationError(key string) {
-	msg := fmt.Sprintf("%v:%v:duplication found for %s", p.l.GetLinePosition(), p.l.GetColumnPosition(), key)
-	p.errors = append(p.errors, msg)
+// duplicationError adds an error to the parser's error list indicating that a duplication was found.
+// It takes the token of the duplicated name and the error code of the kind of the duplicated reference.
+func (p *Parser) duplicationError(t token.Token, code base.ErrorCode) {
+	p.errors = append(p.errors, ast.NewError(t, code, fmt.Sprintf("duplication found for %s", t.Literal)))
 }
 
 // noPrefixParseFnError adds an error message to the parser's error list indicating that no prefix parsing
 // function was found for a given token type.
 // It takes a token type as an argument that indicates the type of the token for which a parsing function is missing.
 func (p *Parser) noPrefixParseFnError(t token.Type) {
-	msg := fmt.Sprintf("%v:%v:no prefix parse function for %s found", p.l.GetLinePosition(), p.l.GetColumnPosition(), t)
-	p.errors = append(p.errors, msg)
+	msg := fmt.Sprintf("no prefix parse function for %s found", t)
+	p.errors = append(p.errors, ast.NewError(p.currentToken, base.ErrorCode_ERROR_CODE_SCHEMA_PARSE, msg))
 }
 
 // peekError adds an error message to the parser's error list indicating that the next token in the input
 // did not match the expected type(s).
 // It takes one or more token types as arguments that indicate the expected types.
 func (p *Parser) peekError(t ...token.Type) {
-	msg := fmt.Sprintf("%v:%v:expected next token to be %s, got %s instead", p.l.GetLinePosition(), p.l.GetColumnPosition(), t, p.peekToken.Type)
-	p.errors = append(p.errors, msg)
+	msg := fmt.Sprintf("expected next token to be %s, got %s instead", t, p.peekToken.Type)
+	p.errors = append(p.errors, ast.NewError(p.peekToken, base.ErrorCode_ERROR_CODE_SCHEMA_PARSE, msg))
 }
 
 // currentError adds an error message to the parser's error list indicating that the current token in the input
 // did not match the expected type(s).
 // It takes one or more token types as arguments that indicate the expected types.
 func (p *Parser) currentError(t ...token.Type) {
-	msg := fmt.Sprintf("%v:%v:expected token to be %s, got %s instead", p.l.GetLinePosition(), p.l.GetColumnPosition(), t, p.currentToken.Type)
-	p.errors = append(p.errors, msg)
+	msg := fmt.Sprintf("expected token to be %s, got %s instead", t, p.currentToken.Type)
+	p.errors = append(p.errors, ast.NewError(p.currentToken, base.ErrorCode_ERROR_CODE_SCHEMA_PARSE, msg))
 }
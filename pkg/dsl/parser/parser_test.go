@@ -1,12 +1,14 @@
 package parser
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"permify/pkg/dsl/ast"
+	base "permify/pkg/pb/base/v1"
 )
 
 // TestParser -
@@ -290,5 +292,191 @@ var _ = Describe("parser", func() {
 			Expect(res2.Expression.(*ast.InfixExpression).Left.(*ast.Identifier).String()).Should(Equal("owner"))
 			Expect(res2.Expression.(*ast.InfixExpression).Right.(*ast.Identifier).String()).Should(Equal("parent.create_repository"))
 		})
+
+		It("Case 7", func() {
+			schema, err := ParseFiles(map[string]string{
+				"main.perm": `
+				import "teams/org.perm"
+
+				entity repository {
+					relation parent @organization
+					permission read = parent.member
+				}`,
+				"teams/org.perm": `
+				import "../user.perm"
+
+				entity organization {
+					relation member @user
+				}`,
+				"user.perm": `entity user {}`,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(schema.GetFiles()).Should(Equal([]string{"main.perm", "teams/org.perm", "user.perm"}))
+			Expect(schema.Statements).Should(HaveLen(5))
+
+			imp := schema.Statements[0].(*ast.ImportStatement)
+			Expect(imp.Path.Literal).Should(Equal("teams/org.perm"))
+			Expect(imp.Path.PositionInfo.File).Should(Equal("main.perm"))
+
+			organizationSt := schema.Statements[3].(*ast.EntityStatement)
+			Expect(organizationSt.Name.Literal).Should(Equal("organization"))
+			Expect(organizationSt.Name.PositionInfo.String()).Should(Equal("teams/org.perm:4:13"))
+
+			Expect(schema.IsEntityReferenceExist("user")).Should(BeTrue())
+			Expect(schema.IsRelationReferenceExist("organization#member")).Should(BeTrue())
+		})
+
+		It("Case 8", func() {
+			_, err := ParseFiles(map[string]string{
+				"a.perm": `entity user {}`,
+				"b.perm": `
+entity user {}`,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("b.perm:2:9: duplication found for user"))
+
+			_, err = ParseFiles(map[string]string{
+				"a.perm": `import user.perm`,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("a.perm:1:9: expected next token to be [STRING], got IDENT instead"))
+		})
+
+		It("Case 9", func() {
+			pr := NewParser(`
+			// A person that can sign in.
+			entity user {}
+
+			// detached comment
+
+			/**
+			 * A group of users.
+			 * Organizations own repositories.
+			 */
+			entity organization {
+				// members of the organization
+				relation member @user // trailing comment
+				relation admin @user
+
+				// Anyone who can
+				//
+				// view the organization.
+				permission view = member or admin
+			}`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			userSt := schema.Statements[0].(*ast.EntityStatement)
+			Expect(userSt.Description()).Should(Equal("A person that can sign in."))
+
+			organizationSt := schema.Statements[1].(*ast.EntityStatement)
+			Expect(organizationSt.Description()).Should(Equal("A group of users.\nOrganizations own repositories."))
+
+			Expect(organizationSt.RelationStatements[0].(*ast.RelationStatement).Description()).Should(Equal("members of the organization"))
+			Expect(organizationSt.RelationStatements[1].(*ast.RelationStatement).Description()).Should(Equal(""))
+			Expect(organizationSt.PermissionStatements[0].(*ast.PermissionStatement).Description()).Should(Equal("Anyone who can\n\nview the organization."))
+
+			// the descriptions survive a round trip through the string representation
+			pr = NewParser(organizationSt.String())
+			schema, err = pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			organizationSt = schema.Statements[0].(*ast.EntityStatement)
+			Expect(organizationSt.Description()).Should(Equal("A group of users.\nOrganizations own repositories."))
+			Expect(organizationSt.RelationStatements[0].(*ast.RelationStatement).Description()).Should(Equal("members of the organization"))
+			Expect(organizationSt.PermissionStatements[0].(*ast.PermissionStatement).Description()).Should(Equal("Anyone who can\n\nview the organization."))
+		})
+
+		It("Case 10", func() {
+			pr := NewParser(`entity user {}
+
+entity organization {
+    relation member user
+    relation admin @user
+    relation admin @user
+    permission view = member or
+    permission edit = admin
+}
+
+entity repository
+    relation parent @organization
+}
+
+entity team {
+    relation member @user
+`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+
+			var errs ast.Errors
+			Expect(errors.As(err, &errs)).Should(BeTrue())
+
+			messages := make([]string, 0, len(errs))
+			for _, e := range errs {
+				messages = append(messages, e.Error())
+			}
+			Expect(messages).Should(Equal([]string{
+				"4:22: expected next token to be [SIGN], got IDENT instead",
+				"6:15: duplication found for admin",
+				"8:1: no prefix parse function for NEWLINE found",
+				"12:1: expected next token to be [LBRACE], got NEWLINE instead",
+				"17:2: expected token to be [RBRACE], got EOF instead",
+			}))
+
+			Expect(errs[1].Code).Should(Equal(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE))
+			Expect(errs[1].End.ColumnPosition).Should(Equal(errs[1].Start.ColumnPosition + len("admin")))
+		})
+
+		It("Case 11", func() {
+			pr := NewParser(`
+			entity repository {
+				relation owner @user
+				relation editor @user
+				relation suspended @user
+				relation banned @user
+
+				permission a = owner or editor but not suspended and banned
+				permission b = editor but not suspended but not banned
+				permission c = editor but not (suspended or banned)
+			}`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+
+			// but not binds looser than and/or, so both of its sides are grouped
+			a := st.PermissionStatements[0].(*ast.PermissionStatement).ExpressionStatement.(*ast.ExpressionStatement).Expression.(*ast.InfixExpression)
+			Expect(a.Operator).Should(Equal(ast.EXCLUSION))
+			Expect(a.Left.(*ast.InfixExpression).Operator).Should(Equal(ast.OR))
+			Expect(a.Right.(*ast.InfixExpression).Operator).Should(Equal(ast.AND))
+
+			// but not is left-associative
+			b := st.PermissionStatements[1].(*ast.PermissionStatement).ExpressionStatement.(*ast.ExpressionStatement).Expression.(*ast.InfixExpression)
+			Expect(b.Operator).Should(Equal(ast.EXCLUSION))
+			Expect(b.Left.(*ast.InfixExpression).Operator).Should(Equal(ast.EXCLUSION))
+			Expect(b.Left.(*ast.InfixExpression).Left.String()).Should(Equal("editor"))
+			Expect(b.Right.String()).Should(Equal("banned"))
+
+			c := st.PermissionStatements[2].(*ast.PermissionStatement).ExpressionStatement.(*ast.ExpressionStatement).Expression.(*ast.InfixExpression)
+			Expect(c.Operator).Should(Equal(ast.EXCLUSION))
+			Expect(c.Left.String()).Should(Equal("editor"))
+			Expect(c.Right.(*ast.InfixExpression).Operator).Should(Equal(ast.OR))
+		})
+
+		It("Case 12", func() {
+			pr := NewParser(`
+			entity repository {
+				relation editor @user
+				relation banned @user
+
+				permission edit = editor but banned
+			}`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected next token to be [NOT], got IDENT instead"))
+		})
 	})
 })
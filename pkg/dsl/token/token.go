@@ -1,13 +1,27 @@
 package token
 
+import (
+	"fmt"
+)
+
 // PositionInfo - represents the current position in the input source code.
 type PositionInfo struct {
+	// The name of the source file, empty for a single schema.
+	File string
 	// The current line position in the input source code.
 	LinePosition int
 	// The current column position in the input source code.
 	ColumnPosition int
 }
 
+// String - returns the position as "line:column", qualified with the file name if there is one.
+func (p PositionInfo) String() string {
+	if p.File != "" {
+		return fmt.Sprintf("%s:%d:%d", p.File, p.LinePosition, p.ColumnPosition)
+	}
+	return fmt.Sprintf("%d:%d", p.LinePosition, p.ColumnPosition)
+}
+
 // Type - defines a custom type for tokens.
 type Type string
 
@@ -39,6 +53,7 @@ func New(positionInfo PositionInfo, typ Type, ch byte) Token {
 
 // keywords - maps string keywords to their corresponding Type.
 var keywords = map[string]Type{
+	"import":     IMPORT,
 	"entity":     ENTITY,
 	"relation":   RELATION,
 	"action":     PERMISSION,
@@ -46,6 +61,7 @@ var keywords = map[string]Type{
 	"and":        AND,
 	"or":         OR,
 	"not":        NOT,
+	"but":        BUT,
 }
 
 // ignores - maps ignored token types to an empty struct.
@@ -67,7 +83,8 @@ const (
 	/*
 		Identifiers & Literals
 	*/
-	IDENT = "IDENT"
+	IDENT  = "IDENT"
+	STRING = "STRING"
 
 	/*
 		Delimiters
@@ -86,6 +103,7 @@ const (
 	/*
 		Keywords
 	*/
+	IMPORT     = "IMPORT"
 	ENTITY     = "ENTITY"
 	RELATION   = "RELATION"
 	PERMISSION = "PERMISSION"
@@ -101,6 +119,11 @@ const (
 	AND = "AND"
 	OR  = "OR"
 
+	/*
+		Exclusion, "but not"
+	*/
+	BUT = "BUT"
+
 	/*
 		Comments
 	*/
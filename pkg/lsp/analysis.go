@@ -0,0 +1,581 @@
+package lsp
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"permify/pkg/dsl/ast"
+	"permify/pkg/dsl/compiler"
+	"permify/pkg/dsl/formatter"
+	"permify/pkg/dsl/parser"
+	"permify/pkg/dsl/token"
+)
+
+// errorPosition matches the position prefix of parser, validation and compile errors, e.g. "main.perm:4:21: message"
+var errorPosition = regexp.MustCompile(`^(?:(.+?):)?(\d+):(\d+):\s?(.*)$`)
+
+// definition - an entity, relation or permission defined in a schema
+type definition struct {
+	// location of the name of the definition
+	location Location
+	// kind of the definition
+	kind SymbolKind
+	// source of the definition, shown on hover
+	source string
+	// description taken from the doc comment of the definition
+	description string
+}
+
+// reference - a name in a document that refers to definitions
+type reference struct {
+	rng Range
+	// keys of the referenced definitions, "entity" or "entity#relation"
+	keys []string
+}
+
+// document - an open text document and the result of its last analysis
+type document struct {
+	uri   string
+	text  string
+	lines []string
+	// name of the document among the files of its schema
+	name string
+
+	diagnostics []Diagnostic
+
+	// the schema of the last successful parse, kept so that an edited document still has symbols
+	schema *ast.Schema
+	// uris of the files of the schema by their names
+	uris map[string]string
+	// definitions by their keys
+	definitions map[string]definition
+	// references of the document
+	references []reference
+}
+
+// newDocument - creates a document and analyzes it, imported files are taken from the open documents or read from disk
+func newDocument(uri, text string, previous *document, open map[string]*document) *document {
+	d := &document{
+		uri:   uri,
+		text:  text,
+		lines: strings.Split(text, "\n"),
+		name:  documentName(uri),
+	}
+	if previous != nil {
+		d.schema, d.uris, d.definitions, d.references = previous.schema, previous.uris, previous.definitions, previous.references
+	}
+	d.analyze(open)
+	return d
+}
+
+// documentName - returns the file name of a document uri
+func documentName(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Path == "" {
+		return "schema.perm"
+	}
+	return path.Base(u.Path)
+}
+
+// analyze - parses, validates and compiles the document and the files it imports, and indexes its symbols
+func (d *document) analyze(open map[string]*document) {
+	d.diagnostics = []Diagnostic{}
+
+	files := map[string]string{d.name: d.text}
+	uris := map[string]string{d.name: d.uri}
+
+	sch, err := parser.ParseFiles(files)
+	if err != nil {
+		// the positions of the references do not match the edited text anymore
+		d.references = nil
+		d.addError(err)
+		return
+	}
+
+	// load the imported files transitively, imports that cannot be loaded are reported by the validation
+	if dir, ok := documentDir(d.uri); ok {
+		pending := imports(sch, d.name)
+		for len(pending) > 0 {
+			name := pending[0]
+			pending = pending[1:]
+			if _, ok := files[name]; ok {
+				continue
+			}
+			fileURI := (&url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(dir, filepath.FromSlash(name)))}).String()
+			var source string
+			if od, ok := open[fileURI]; ok {
+				source = od.text
+			} else {
+				b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+				if err != nil {
+					continue
+				}
+				source = string(b)
+			}
+			files[name] = source
+			uris[name] = fileURI
+
+			imported, err := parser.ParseFiles(map[string]string{name: source})
+			if err != nil {
+				continue
+			}
+			pending = append(pending, imports(imported, name)...)
+		}
+		if len(files) > 1 {
+			sch, err = parser.ParseFiles(files)
+			if err != nil {
+				d.references = nil
+				d.addError(err)
+				return
+			}
+		}
+	}
+
+	d.schema = sch
+	d.uris = uris
+	d.index()
+
+	if _, err = compiler.NewCompiler(false, sch).Compile(); err != nil {
+		d.addError(err)
+	}
+}
+
+// documentDir - returns the directory of a file uri
+func documentDir(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	return filepath.Dir(filepath.FromSlash(u.Path)), true
+}
+
+// imports - returns the names of the files imported by the given file, relative to the directory of the document
+func imports(sch *ast.Schema, file string) (names []string) {
+	for _, st := range sch.Statements {
+		if is, ok := st.(*ast.ImportStatement); ok && is.Import.PositionInfo.File == file {
+			names = append(names, path.Clean(path.Join(path.Dir(file), is.Path.Literal)))
+		}
+	}
+	return names
+}
+
+// addError - adds the diagnostics for the errors of the parser, the validation or the compiler
+func (d *document) addError(err error) {
+	var errs ast.Errors
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			d.addSchemaError(e)
+		}
+		return
+	}
+
+	matches := errorPosition.FindStringSubmatch(err.Error())
+	if matches == nil {
+		d.diagnostics = append(d.diagnostics, Diagnostic{Severity: SeverityError, Source: "permify", Message: err.Error()})
+		return
+	}
+
+	// errors of imported files are reported at the start of the document
+	if matches[1] != "" && matches[1] != d.name {
+		d.diagnostics = append(d.diagnostics, Diagnostic{Severity: SeverityError, Source: "permify", Message: err.Error()})
+		return
+	}
+
+	line, _ := strconv.Atoi(matches[2])
+	column, _ := strconv.Atoi(matches[3])
+	d.diagnostics = append(d.diagnostics, Diagnostic{
+		Range:    d.wordRange(line-1, column-2),
+		Severity: SeverityError,
+		Source:   "permify",
+		Message:  matches[4],
+	})
+}
+
+// addSchemaError - adds a diagnostic for an error with the range of the source it is about
+func (d *document) addSchemaError(e *ast.Error) {
+	// errors of imported files are reported at the start of the document
+	if e.Start.File != "" && e.Start.File != d.name {
+		d.diagnostics = append(d.diagnostics, Diagnostic{Severity: SeverityError, Source: "permify", Message: e.Error()})
+		return
+	}
+
+	rng := Range{
+		Start: Position{Line: e.Start.LinePosition - 1, Character: e.Start.ColumnPosition - 2},
+		End:   Position{Line: e.End.LinePosition - 1, Character: e.End.ColumnPosition - 2},
+	}
+	// errors without a source, such as a missing user entity, underline the word at their position
+	if rng.Start.Character < 0 || rng.End == rng.Start {
+		rng = d.wordRange(rng.Start.Line, rng.Start.Character)
+	}
+	d.diagnostics = append(d.diagnostics, Diagnostic{
+		Range:    rng,
+		Severity: SeverityError,
+		Source:   "permify",
+		Message:  e.Message,
+	})
+}
+
+// wordRange - returns the range of the word at or right before the given position, used to underline errors
+func (d *document) wordRange(line, character int) Range {
+	if line < 0 || line >= len(d.lines) {
+		line = 0
+	}
+	text := d.lines[line]
+	if character < 0 {
+		character = 0
+	}
+	if character > len(text) {
+		character = len(text)
+	}
+
+	start, end := character, character
+	// errors of the parser point behind the token they are about
+	if (end == len(text) || !isIdentChar(text[end])) && start > 0 && isIdentChar(text[start-1]) {
+		for start > 0 && isIdentChar(text[start-1]) {
+			start--
+		}
+	} else {
+		for end < len(text) && isIdentChar(text[end]) {
+			end++
+		}
+		if end == start && end < len(text) {
+			end++
+		}
+	}
+	return Range{Start: Position{Line: line, Character: start}, End: Position{Line: line, Character: end}}
+}
+
+// isIdentChar - checks if the character can be a part of a name
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// tokenRange - returns the range of a token, the lexer counts lines from 1 and columns from 2
+func tokenRange(t token.Token) Range {
+	line := t.PositionInfo.LinePosition - 1
+	character := t.PositionInfo.ColumnPosition - 2
+	if character < 0 {
+		character = 0
+	}
+	return Range{
+		Start: Position{Line: line, Character: character},
+		End:   Position{Line: line, Character: character + len(t.Literal)},
+	}
+}
+
+// index - indexes the definitions of the schema and the references of the document
+func (d *document) index() {
+	d.definitions = map[string]definition{}
+	d.references = nil
+
+	entities := d.entities()
+	for _, es := range entities {
+		d.definitions[es.Name.Literal] = definition{
+			location:    Location{URI: d.uris[es.Name.PositionInfo.File], Range: tokenRange(es.Name)},
+			kind:        SymbolKindClass,
+			source:      "entity " + es.Name.Literal,
+			description: es.Description(),
+		}
+		for _, st := range es.RelationStatements {
+			rs := st.(*ast.RelationStatement)
+			d.definitions[es.Name.Literal+"#"+rs.Name.Literal] = definition{
+				location:    Location{URI: d.uris[rs.Name.PositionInfo.File], Range: tokenRange(rs.Name)},
+				kind:        SymbolKindField,
+				source:      relationSource(rs),
+				description: rs.Description(),
+			}
+		}
+		for _, st := range es.PermissionStatements {
+			ps := st.(*ast.PermissionStatement)
+			d.definitions[es.Name.Literal+"#"+ps.Name.Literal] = definition{
+				location:    Location{URI: d.uris[ps.Name.PositionInfo.File], Range: tokenRange(ps.Name)},
+				kind:        SymbolKindFunction,
+				source:      permissionSource(ps),
+				description: ps.Description(),
+			}
+		}
+	}
+
+	for _, es := range entities {
+		if es.Name.PositionInfo.File != d.name {
+			continue
+		}
+		entity := es.Name.Literal
+		d.addReference(es.Name, entity)
+		for _, st := range es.RelationStatements {
+			rs := st.(*ast.RelationStatement)
+			d.addReference(rs.Name, entity+"#"+rs.Name.Literal)
+			for _, rt := range rs.RelationTypes {
+				d.addReference(rt.Type, rt.Type.Literal)
+				if rt.Relation.Literal != "" {
+					d.addReference(rt.Relation, rt.Type.Literal+"#"+rt.Relation.Literal)
+				}
+			}
+		}
+		for _, st := range es.PermissionStatements {
+			ps := st.(*ast.PermissionStatement)
+			d.addReference(ps.Name, entity+"#"+ps.Name.Literal)
+			if es, ok := ps.ExpressionStatement.(*ast.ExpressionStatement); ok {
+				d.addExpressionReferences(entity, es.Expression)
+			}
+		}
+	}
+}
+
+// entities - returns the entity statements of the schema
+func (d *document) entities() (entities []*ast.EntityStatement) {
+	if d.schema == nil {
+		return nil
+	}
+	for _, st := range d.schema.Statements {
+		if es, ok := st.(*ast.EntityStatement); ok {
+			entities = append(entities, es)
+		}
+	}
+	return entities
+}
+
+// addReference - adds a reference to the definitions with the given keys
+func (d *document) addReference(t token.Token, keys ...string) {
+	d.references = append(d.references, reference{rng: tokenRange(t), keys: keys})
+}
+
+// addExpressionReferences - adds the references of the identifiers of a permission expression
+func (d *document) addExpressionReferences(entity string, ex ast.Expression) {
+	switch ex := ex.(type) {
+	case *ast.InfixExpression:
+		d.addExpressionReferences(entity, ex.Left)
+		d.addExpressionReferences(entity, ex.Right)
+	case *ast.Identifier:
+		if len(ex.Idents) == 0 {
+			return
+		}
+		d.addReference(ex.Idents[0], entity+"#"+ex.Idents[0].Literal)
+		// every next name is looked up in the entities the previous one relates to
+		entities := []string{entity}
+		for i := 1; i < len(ex.Idents); i++ {
+			var types, keys []string
+			for _, e := range entities {
+				types = append(types, d.relationTypes(e, ex.Idents[i-1].Literal)...)
+			}
+			for _, t := range types {
+				keys = append(keys, t+"#"+ex.Idents[i].Literal)
+			}
+			d.addReference(ex.Idents[i], keys...)
+			entities = types
+		}
+	}
+}
+
+// relationTypes - returns the entity types of a relation
+func (d *document) relationTypes(entity, relation string) (types []string) {
+	if d.schema == nil {
+		return nil
+	}
+	statements, _ := d.schema.GetRelationReferenceIfExist(entity + "#" + relation)
+	for _, rt := range statements {
+		types = append(types, rt.Type.Literal)
+	}
+	return types
+}
+
+// relationSource - returns the canonical source of a relation
+func relationSource(rs *ast.RelationStatement) string {
+	var sb strings.Builder
+	sb.WriteString("relation ")
+	sb.WriteString(rs.Name.Literal)
+	for _, rt := range rs.RelationTypes {
+		sb.WriteString(" ")
+		sb.WriteString(rt.String())
+	}
+	return sb.String()
+}
+
+// permissionSource - returns the canonical source of a permission
+func permissionSource(ps *ast.PermissionStatement) string {
+	source := "permission " + ps.Name.Literal + " = "
+	if es, ok := ps.ExpressionStatement.(*ast.ExpressionStatement); ok {
+		source += formatter.Expression(es.Expression)
+	}
+	return source
+}
+
+// referenceAt - returns the reference at the given position
+func (d *document) referenceAt(p Position) (reference, bool) {
+	for _, ref := range d.references {
+		if ref.rng.contains(p) {
+			return ref, true
+		}
+	}
+	return reference{}, false
+}
+
+// definitionsOf - returns the known definitions of a reference
+func (d *document) definitionsOf(ref reference) (definitions []definition) {
+	for _, key := range ref.keys {
+		if def, ok := d.definitions[key]; ok {
+			definitions = append(definitions, def)
+		}
+	}
+	return definitions
+}
+
+// symbols - returns the entities of the document with their relations and permissions
+func (d *document) symbols() []DocumentSymbol {
+	symbols := []DocumentSymbol{}
+	for _, es := range d.entities() {
+		if es.Name.PositionInfo.File != d.name {
+			continue
+		}
+		entity := DocumentSymbol{
+			Name:           es.Name.Literal,
+			Detail:         es.Description(),
+			Kind:           SymbolKindClass,
+			SelectionRange: tokenRange(es.Name),
+		}
+		entity.Range = Range{Start: tokenRange(es.Entity).Start, End: entity.SelectionRange.End}
+
+		for _, st := range es.RelationStatements {
+			rs := st.(*ast.RelationStatement)
+			entity.Children = append(entity.Children, d.statementSymbol(rs.Relation, rs.Name, SymbolKindField, relationSource(rs)))
+		}
+		for _, st := range es.PermissionStatements {
+			ps := st.(*ast.PermissionStatement)
+			entity.Children = append(entity.Children, d.statementSymbol(ps.Permission, ps.Name, SymbolKindFunction, permissionSource(ps)))
+		}
+
+		// the entity spans up to the end of its last statement
+		for _, child := range entity.Children {
+			if child.Range.End.Line > entity.Range.End.Line {
+				entity.Range.End = child.Range.End
+			}
+		}
+		symbols = append(symbols, entity)
+	}
+	return symbols
+}
+
+// statementSymbol - returns the symbol of a relation or permission statement, which spans the rest of its line
+func (d *document) statementSymbol(keyword, name token.Token, kind SymbolKind, source string) DocumentSymbol {
+	rng := Range{Start: tokenRange(keyword).Start, End: tokenRange(name).End}
+	if rng.Start.Line < len(d.lines) {
+		rng.End = Position{Line: rng.Start.Line, Character: len(strings.TrimRight(d.lines[rng.Start.Line], " \t\r"))}
+	}
+	return DocumentSymbol{
+		Name:           name.Literal,
+		Detail:         source,
+		Kind:           kind,
+		Range:          rng,
+		SelectionRange: tokenRange(name),
+	}
+}
+
+// completions - returns the completion proposals at the given position
+func (d *document) completions(p Position) []CompletionItem {
+	items := []CompletionItem{}
+	if p.Line >= len(d.lines) {
+		return items
+	}
+	line := d.lines[p.Line]
+	if p.Character > len(line) {
+		p.Character = len(line)
+	}
+	before := line[:p.Character]
+
+	// the partial name under the cursor is replaced by the proposal
+	start := len(before)
+	for start > 0 && isIdentChar(before[start-1]) {
+		start--
+	}
+	trigger := byte(0)
+	if start > 0 {
+		trigger = before[start-1]
+	}
+
+	switch trigger {
+	case '@':
+		// entity types of a relation
+		for _, es := range d.entities() {
+			items = append(items, CompletionItem{Label: es.Name.Literal, Kind: CompletionKindClass, Detail: es.Description()})
+		}
+	case '#':
+		// relations of the entity type before the hash
+		typeEnd := start - 1
+		typeStart := typeEnd
+		for typeStart > 0 && isIdentChar(before[typeStart-1]) {
+			typeStart--
+		}
+		items = append(items, d.memberCompletions(before[typeStart:typeEnd], false)...)
+	case '.':
+		// relations and permissions of the entity types of the relation before the dot
+		relationEnd := start - 1
+		relationStart := relationEnd
+		for relationStart > 0 && isIdentChar(before[relationStart-1]) {
+			relationStart--
+		}
+		seen := map[string]struct{}{}
+		for _, t := range d.relationTypes(d.enclosingEntity(p.Line), before[relationStart:relationEnd]) {
+			for _, item := range d.memberCompletions(t, true) {
+				if _, ok := seen[item.Label]; !ok {
+					seen[item.Label] = struct{}{}
+					items = append(items, item)
+				}
+			}
+		}
+	default:
+		if strings.Contains(before, "=") {
+			// the operands of a permission expression
+			items = append(items, d.memberCompletions(d.enclosingEntity(p.Line), true)...)
+			for _, k := range []string{"and", "or", "but", "not"} {
+				items = append(items, CompletionItem{Label: k, Kind: CompletionKindKeyword})
+			}
+		} else if strings.TrimSpace(before[:start]) == "" {
+			for _, k := range []string{"entity", "relation", "permission", "import"} {
+				items = append(items, CompletionItem{Label: k, Kind: CompletionKindKeyword})
+			}
+		}
+	}
+	return items
+}
+
+// memberCompletions - returns the relations, and optionally the permissions, of an entity as completion proposals
+func (d *document) memberCompletions(entity string, withPermissions bool) (items []CompletionItem) {
+	for _, es := range d.entities() {
+		if es.Name.Literal != entity {
+			continue
+		}
+		for _, st := range es.RelationStatements {
+			rs := st.(*ast.RelationStatement)
+			items = append(items, CompletionItem{Label: rs.Name.Literal, Kind: CompletionKindField, Detail: relationSource(rs)})
+		}
+		if withPermissions {
+			for _, st := range es.PermissionStatements {
+				ps := st.(*ast.PermissionStatement)
+				items = append(items, CompletionItem{Label: ps.Name.Literal, Kind: CompletionKindFunction, Detail: permissionSource(ps)})
+			}
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// entityDeclaration matches the line that opens an entity
+var entityDeclaration = regexp.MustCompile(`^\s*entity\s+([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// enclosingEntity - returns the name of the entity the given line belongs to, found in the text so that it works
+// while the document does not parse
+func (d *document) enclosingEntity(line int) string {
+	for i := line; i >= 0 && i < len(d.lines); i-- {
+		if m := entityDeclaration.FindStringSubmatch(d.lines[i]); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
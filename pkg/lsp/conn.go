@@ -0,0 +1,110 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// conn - reads and writes JSON-RPC 2.0 messages framed with the base protocol of the Language Server Protocol,
+// a Content-Length header followed by an empty line and the content
+type conn struct {
+	r  *bufio.Reader
+	w  io.Writer
+	mu sync.Mutex
+}
+
+// newConn - creates a new connection over the reader and the writer
+func newConn(r io.Reader, w io.Writer) *conn {
+	return &conn{
+		r: bufio.NewReader(r),
+		w: w,
+	}
+}
+
+// read - reads the next message
+func (c *conn) read() (msg *message, err error) {
+	length := -1
+	for {
+		var line string
+		line, err = c.r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		// an empty line ends the header
+		if line == "" {
+			break
+		}
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid header: %s", line)
+		}
+		if strings.EqualFold(strings.TrimSpace(name), "Content-Length") {
+			length, err = strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid content length: %s", value)
+			}
+		}
+	}
+	if length < 0 {
+		return nil, errors.New("missing content length")
+	}
+
+	content := make([]byte, length)
+	if _, err = io.ReadFull(c.r, content); err != nil {
+		return nil, err
+	}
+
+	msg = &message{}
+	if err = json.Unmarshal(content, msg); err != nil {
+		return nil, &responseError{Code: codeParseError, Message: err.Error()}
+	}
+	return msg, nil
+}
+
+// write - writes a message
+func (c *conn) write(msg *message) error {
+	msg.JSONRPC = "2.0"
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err = fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(content)); err != nil {
+		return err
+	}
+	_, err = c.w.Write(content)
+	return err
+}
+
+// reply - writes the response to a request
+func (c *conn) reply(id *json.RawMessage, result interface{}, rErr *responseError) error {
+	msg := &message{ID: id}
+	if rErr != nil {
+		msg.Error = rErr
+		return c.write(msg)
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	msg.Result = b
+	return c.write(msg)
+}
+
+// notify - writes a notification
+func (c *conn) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(&message{Method: method, Params: b})
+}
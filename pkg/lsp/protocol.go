@@ -0,0 +1,223 @@
+package lsp
+
+import (
+	"encoding/json"
+)
+
+// The subset of the Language Server Protocol types used by the server.
+// See https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/
+
+// Position - zero-based line and character offset in a text document
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// Range - range in a text document, the end position is exclusive
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// contains - checks if the position is inside the range, the end position included
+func (r Range) contains(p Position) bool {
+	if p.Line < r.Start.Line || p.Line > r.End.Line {
+		return false
+	}
+	if p.Line == r.Start.Line && p.Character < r.Start.Character {
+		return false
+	}
+	if p.Line == r.End.Line && p.Character > r.End.Character {
+		return false
+	}
+	return true
+}
+
+// Location - range in a document identified by its uri
+type Location struct {
+	URI   string `json:"uri"`
+	Range Range  `json:"range"`
+}
+
+// DiagnosticSeverity - severity of a diagnostic
+type DiagnosticSeverity int
+
+const (
+	// SeverityError - reports an error
+	SeverityError DiagnosticSeverity = 1
+)
+
+// Diagnostic - a problem found in a document
+type Diagnostic struct {
+	Range    Range              `json:"range"`
+	Severity DiagnosticSeverity `json:"severity"`
+	Source   string             `json:"source"`
+	Message  string             `json:"message"`
+}
+
+// PublishDiagnosticsParams - params of the textDocument/publishDiagnostics notification
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// TextDocumentItem - an opened text document
+type TextDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+// TextDocumentIdentifier - identifies a text document by its uri
+type TextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+// DidOpenTextDocumentParams - params of the textDocument/didOpen notification
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+// TextDocumentContentChangeEvent - a change of a text document, the server only supports full document changes
+type TextDocumentContentChangeEvent struct {
+	Text string `json:"text"`
+}
+
+// DidChangeTextDocumentParams - params of the textDocument/didChange notification
+type DidChangeTextDocumentParams struct {
+	TextDocument   TextDocumentIdentifier           `json:"textDocument"`
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+// DidCloseTextDocumentParams - params of the textDocument/didClose notification
+type DidCloseTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// TextDocumentPositionParams - params of the requests about a position in a text document
+type TextDocumentPositionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+// DocumentSymbolParams - params of the textDocument/documentSymbol request
+type DocumentSymbolParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// MarkupContent - formatted content of a hover
+type MarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+// Hover - result of the textDocument/hover request
+type Hover struct {
+	Contents MarkupContent `json:"contents"`
+	Range    *Range        `json:"range,omitempty"`
+}
+
+// CompletionItemKind - kind of a completion item
+type CompletionItemKind int
+
+const (
+	// CompletionKindField - used for relations
+	CompletionKindField CompletionItemKind = 5
+	// CompletionKindClass - used for entities
+	CompletionKindClass CompletionItemKind = 7
+	// CompletionKindKeyword - used for keywords
+	CompletionKindKeyword CompletionItemKind = 14
+	// CompletionKindFunction - used for permissions
+	CompletionKindFunction CompletionItemKind = 3
+)
+
+// CompletionItem - a completion proposal
+type CompletionItem struct {
+	Label  string             `json:"label"`
+	Kind   CompletionItemKind `json:"kind"`
+	Detail string             `json:"detail,omitempty"`
+}
+
+// SymbolKind - kind of a document symbol
+type SymbolKind int
+
+const (
+	// SymbolKindClass - used for entities
+	SymbolKindClass SymbolKind = 5
+	// SymbolKindField - used for relations
+	SymbolKindField SymbolKind = 8
+	// SymbolKindFunction - used for permissions
+	SymbolKindFunction SymbolKind = 12
+)
+
+// DocumentSymbol - a symbol of a document, entities contain their relations and permissions
+type DocumentSymbol struct {
+	Name           string           `json:"name"`
+	Detail         string           `json:"detail,omitempty"`
+	Kind           SymbolKind       `json:"kind"`
+	Range          Range            `json:"range"`
+	SelectionRange Range            `json:"selectionRange"`
+	Children       []DocumentSymbol `json:"children,omitempty"`
+}
+
+// InitializeResult - result of the initialize request
+type InitializeResult struct {
+	Capabilities ServerCapabilities `json:"capabilities"`
+	ServerInfo   ServerInfo         `json:"serverInfo"`
+}
+
+// ServerInfo - name and version of the server
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// CompletionOptions - completion capabilities of the server
+type CompletionOptions struct {
+	TriggerCharacters []string `json:"triggerCharacters"`
+}
+
+// ServerCapabilities - capabilities of the server
+type ServerCapabilities struct {
+	TextDocumentSync       int               `json:"textDocumentSync"`
+	DefinitionProvider     bool              `json:"definitionProvider"`
+	HoverProvider          bool              `json:"hoverProvider"`
+	CompletionProvider     CompletionOptions `json:"completionProvider"`
+	DocumentSymbolProvider bool              `json:"documentSymbolProvider"`
+}
+
+// textDocumentSyncFull - documents are synced by sending their full content on every change
+const textDocumentSyncFull = 1
+
+// message - a JSON-RPC 2.0 request, notification or response
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *responseError   `json:"error,omitempty"`
+}
+
+// responseError - error of a JSON-RPC 2.0 response
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error - returns the message of the error
+func (e *responseError) Error() string {
+	return e.Message
+}
+
+const (
+	// codeParseError - the message is not valid JSON
+	codeParseError = -32700
+	// codeInvalidParams - the params of the request are invalid
+	codeInvalidParams = -32602
+	// codeMethodNotFound - the method is not supported by the server
+	codeMethodNotFound = -32601
+	// codeInvalidRequest - the request is received before initialize or after shutdown
+	codeInvalidRequest = -32600
+)
@@ -0,0 +1,244 @@
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"permify/internal"
+)
+
+// Server - a language server for the Permify DSL. It analyzes open documents with the parser, the validation and the
+// compiler of the language and serves diagnostics, definitions, hovers, completions and document symbols.
+type Server struct {
+	conn *conn
+	// open documents by their uris
+	documents map[string]*document
+
+	initialized bool
+	shutdown    bool
+}
+
+// NewServer - creates a new language server that reads requests from r and writes responses to w
+func NewServer(r io.Reader, w io.Writer) *Server {
+	return &Server{
+		conn:      newConn(r, w),
+		documents: map[string]*document{},
+	}
+}
+
+// ErrExitWithoutShutdown - the client sent exit without a shutdown request before
+var ErrExitWithoutShutdown = errors.New("exit without shutdown")
+
+// Serve - handles messages until the client sends exit or closes the connection
+func (s *Server) Serve() error {
+	for {
+		msg, err := s.conn.read()
+		if err != nil {
+			var rErr *responseError
+			if errors.As(err, &rErr) {
+				if err = s.conn.reply(nil, nil, rErr); err != nil {
+					return err
+				}
+				continue
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return ErrExitWithoutShutdown
+			}
+			return nil
+		}
+
+		result, rErr := s.handle(msg)
+
+		// notifications are not answered
+		if msg.ID == nil {
+			continue
+		}
+		if err = s.conn.reply(msg.ID, result, rErr); err != nil {
+			return err
+		}
+	}
+}
+
+// handle - dispatches a request or notification to its handler
+func (s *Server) handle(msg *message) (interface{}, *responseError) {
+	if !s.initialized && msg.Method != "initialize" {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "server is not initialized"}
+	}
+	if s.shutdown {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "server is shut down"}
+	}
+
+	switch msg.Method {
+	case "initialize":
+		s.initialized = true
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:       textDocumentSyncFull,
+				DefinitionProvider:     true,
+				HoverProvider:          true,
+				CompletionProvider:     CompletionOptions{TriggerCharacters: []string{"@", "#", "."}},
+				DocumentSymbolProvider: true,
+			},
+			ServerInfo: ServerInfo{Name: "permify", Version: internal.Version},
+		}, nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		s.update(params.TextDocument.URI, params.TextDocument.Text)
+		return nil, nil
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		// documents are synced in full, the last change holds the whole text
+		if len(params.ContentChanges) > 0 {
+			s.update(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		delete(s.documents, params.TextDocument.URI)
+		s.publish(params.TextDocument.URI, []Diagnostic{})
+		return nil, nil
+	case "textDocument/definition":
+		d, params, rErr := s.position(msg)
+		if rErr != nil {
+			return nil, rErr
+		}
+		return s.definition(d, params.Position), nil
+	case "textDocument/hover":
+		d, params, rErr := s.position(msg)
+		if rErr != nil {
+			return nil, rErr
+		}
+		return s.hover(d, params.Position), nil
+	case "textDocument/completion":
+		d, params, rErr := s.position(msg)
+		if rErr != nil {
+			return nil, rErr
+		}
+		return d.completions(params.Position), nil
+	case "textDocument/documentSymbol":
+		var params DocumentSymbolParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		d, ok := s.documents[params.TextDocument.URI]
+		if !ok {
+			return []DocumentSymbol{}, nil
+		}
+		return d.symbols(), nil
+	default:
+		return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+	}
+}
+
+// update - analyzes the new text of a document, and the open documents importing it, and publishes their diagnostics
+func (s *Server) update(uri, text string) {
+	d := newDocument(uri, text, s.documents[uri], s.documents)
+	s.documents[uri] = d
+	s.publish(uri, d.diagnostics)
+
+	// documents that import the changed one are analyzed again
+	for u, od := range s.documents {
+		if u == uri || !od.imports(uri) {
+			continue
+		}
+		od = newDocument(od.uri, od.text, od, s.documents)
+		s.documents[u] = od
+		s.publish(u, od.diagnostics)
+	}
+}
+
+// imports - checks if the schema of the document contains the file of the given uri
+func (d *document) imports(uri string) bool {
+	for _, u := range d.uris {
+		if u == uri {
+			return true
+		}
+	}
+	return false
+}
+
+// publish - sends the diagnostics of a document to the client
+func (s *Server) publish(uri string, diagnostics []Diagnostic) {
+	_ = s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: uri, Diagnostics: diagnostics})
+}
+
+// position - decodes the params of a request about a position and returns the document it refers to
+func (s *Server) position(msg *message) (*document, TextDocumentPositionParams, *responseError) {
+	var params TextDocumentPositionParams
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return nil, params, invalidParams(err)
+	}
+	d, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil, params, &responseError{Code: codeInvalidParams, Message: "document is not open: " + params.TextDocument.URI}
+	}
+	return d, params, nil
+}
+
+// definition - returns the locations of the definitions referenced at the position
+func (s *Server) definition(d *document, p Position) []Location {
+	locations := []Location{}
+	ref, ok := d.referenceAt(p)
+	if !ok {
+		return locations
+	}
+	for _, def := range d.definitionsOf(ref) {
+		locations = append(locations, def.location)
+	}
+	return locations
+}
+
+// hover - returns the source and the description of the definitions referenced at the position
+func (s *Server) hover(d *document, p Position) *Hover {
+	ref, ok := d.referenceAt(p)
+	if !ok {
+		return nil
+	}
+	definitions := d.definitionsOf(ref)
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	parts := make([]string, 0, len(definitions))
+	for _, def := range definitions {
+		part := "```perm\n" + def.source + "\n```"
+		if def.description != "" {
+			part += "\n\n" + def.description
+		}
+		parts = append(parts, part)
+	}
+
+	rng := ref.rng
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: strings.Join(parts, "\n\n---\n\n")},
+		Range:    &rng,
+	}
+}
+
+// invalidParams - returns the error of a request with params that cannot be decoded
+func invalidParams(err error) *responseError {
+	return &responseError{Code: codeInvalidParams, Message: err.Error()}
+}
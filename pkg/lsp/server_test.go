@@ -0,0 +1,271 @@
+package lsp
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestLSP -
+func TestLSP(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "lsp-suite")
+}
+
+// client - drives a server over pipes the way an editor does
+type client struct {
+	conn          *conn
+	nextID        int
+	notifications []*message
+}
+
+// call - sends a request and returns its response, collecting the notifications received before it
+func (c *client) call(method string, params interface{}) *message {
+	c.nextID++
+	id := json.RawMessage(strconv.Itoa(c.nextID))
+	b, err := json.Marshal(params)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(c.conn.write(&message{ID: &id, Method: method, Params: b})).Should(Succeed())
+
+	for {
+		msg, err := c.conn.read()
+		Expect(err).ShouldNot(HaveOccurred())
+		if msg.ID == nil {
+			c.notifications = append(c.notifications, msg)
+			continue
+		}
+		Expect(string(*msg.ID)).Should(Equal(string(id)))
+		return msg
+	}
+}
+
+// notify - sends a notification
+func (c *client) notify(method string, params interface{}) {
+	Expect(c.conn.notify(method, params)).Should(Succeed())
+}
+
+// diagnostics - reads notifications until the diagnostics of a document are published
+func (c *client) diagnostics(uri string) []Diagnostic {
+	for {
+		msg, err := c.conn.read()
+		Expect(err).ShouldNot(HaveOccurred())
+		if msg.Method != "textDocument/publishDiagnostics" {
+			continue
+		}
+		var params PublishDiagnosticsParams
+		Expect(json.Unmarshal(msg.Params, &params)).Should(Succeed())
+		if params.URI == uri {
+			return params.Diagnostics
+		}
+	}
+}
+
+const schema = `entity user {}
+
+// A group of users.
+entity organization {
+    relation member @user
+    relation admin @user
+
+    permission view = member or admin
+}
+
+entity repository {
+    relation parent @organization
+    permission read = parent.view
+}
+`
+
+var _ = Describe("lsp", func() {
+	var c *client
+	var done chan error
+
+	const uri = "file:///tmp/schema.perm"
+
+	BeforeEach(func() {
+		serverReader, clientWriter := io.Pipe()
+		clientReader, serverWriter := io.Pipe()
+
+		done = make(chan error, 1)
+		go func() {
+			done <- NewServer(serverReader, serverWriter).Serve()
+		}()
+
+		c = &client{conn: newConn(clientReader, clientWriter)}
+
+		res := c.call("initialize", map[string]interface{}{})
+		Expect(res.Error).Should(BeNil())
+		var result InitializeResult
+		Expect(json.Unmarshal(res.Result, &result)).Should(Succeed())
+		Expect(result.Capabilities.HoverProvider).Should(BeTrue())
+
+		c.notify("initialized", map[string]interface{}{})
+		c.notify("textDocument/didOpen", DidOpenTextDocumentParams{
+			TextDocument: TextDocumentItem{URI: uri, LanguageID: "perm", Version: 1, Text: schema},
+		})
+		Expect(c.diagnostics(uri)).Should(BeEmpty())
+	})
+
+	AfterEach(func() {
+		res := c.call("shutdown", nil)
+		Expect(res.Error).Should(BeNil())
+		c.notify("exit", nil)
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	Context("Diagnostics", func() {
+		It("Case 1", func() {
+			c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				ContentChanges: []TextDocumentContentChangeEvent{{Text: `entity user {}
+
+entity organization {
+    relation member @usr
+}`}},
+			})
+			diagnostics := c.diagnostics(uri)
+			Expect(diagnostics).Should(HaveLen(1))
+			Expect(diagnostics[0].Message).Should(Equal("relation reference not found in entity references"))
+			Expect(diagnostics[0].Range).Should(Equal(Range{Start: Position{Line: 3, Character: 21}, End: Position{Line: 3, Character: 24}}))
+
+			c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				ContentChanges: []TextDocumentContentChangeEvent{{Text: `entity user {}
+
+entity organization {
+    relation member user
+}`}},
+			})
+			diagnostics = c.diagnostics(uri)
+			Expect(diagnostics).Should(HaveLen(1))
+			Expect(diagnostics[0].Message).Should(Equal("expected next token to be [SIGN], got IDENT instead"))
+			Expect(diagnostics[0].Range.Start.Line).Should(Equal(3))
+		})
+
+		It("Case 2", func() {
+			c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				ContentChanges: []TextDocumentContentChangeEvent{{Text: `entity user {}
+
+entity organization {
+    relation member @usr
+    permission view = member or owner
+    permission edit = admin
+}`}},
+			})
+			diagnostics := c.diagnostics(uri)
+			Expect(diagnostics).Should(HaveLen(3))
+			Expect(diagnostics[0].Range).Should(Equal(Range{Start: Position{Line: 3, Character: 21}, End: Position{Line: 3, Character: 24}}))
+			Expect(diagnostics[1].Message).Should(Equal("undefined relation reference"))
+			Expect(diagnostics[1].Range).Should(Equal(Range{Start: Position{Line: 4, Character: 32}, End: Position{Line: 4, Character: 37}}))
+			Expect(diagnostics[2].Range).Should(Equal(Range{Start: Position{Line: 5, Character: 22}, End: Position{Line: 5, Character: 27}}))
+		})
+	})
+
+	Context("Definition", func() {
+		It("Case 1", func() {
+			// "view" in "parent.view"
+			res := c.call("textDocument/definition", TextDocumentPositionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     Position{Line: 12, Character: 30},
+			})
+			var locations []Location
+			Expect(json.Unmarshal(res.Result, &locations)).Should(Succeed())
+			Expect(locations).Should(Equal([]Location{{
+				URI:   uri,
+				Range: Range{Start: Position{Line: 7, Character: 15}, End: Position{Line: 7, Character: 19}},
+			}}))
+
+			// "organization" in "@organization"
+			res = c.call("textDocument/definition", TextDocumentPositionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     Position{Line: 11, Character: 22},
+			})
+			Expect(json.Unmarshal(res.Result, &locations)).Should(Succeed())
+			Expect(locations).Should(HaveLen(1))
+			Expect(locations[0].Range.Start).Should(Equal(Position{Line: 3, Character: 7}))
+		})
+	})
+
+	Context("Hover", func() {
+		It("Case 1", func() {
+			// "member" in "member or admin"
+			res := c.call("textDocument/hover", TextDocumentPositionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     Position{Line: 7, Character: 24},
+			})
+			var hover Hover
+			Expect(json.Unmarshal(res.Result, &hover)).Should(Succeed())
+			Expect(hover.Contents.Value).Should(Equal("```perm\nrelation member @user\n```"))
+
+			// "organization" in "@organization"
+			res = c.call("textDocument/hover", TextDocumentPositionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     Position{Line: 11, Character: 22},
+			})
+			Expect(json.Unmarshal(res.Result, &hover)).Should(Succeed())
+			Expect(hover.Contents.Value).Should(Equal("```perm\nentity organization\n```\n\nA group of users."))
+		})
+	})
+
+	Context("Completion", func() {
+		It("Case 1", func() {
+			// after "@"
+			res := c.call("textDocument/completion", TextDocumentPositionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     Position{Line: 11, Character: 21},
+			})
+			var items []CompletionItem
+			Expect(json.Unmarshal(res.Result, &items)).Should(Succeed())
+			Expect(labels(items)).Should(Equal([]string{"user", "organization", "repository"}))
+
+			// after "parent."
+			res = c.call("textDocument/completion", TextDocumentPositionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     Position{Line: 12, Character: 29},
+			})
+			Expect(json.Unmarshal(res.Result, &items)).Should(Succeed())
+			Expect(labels(items)).Should(Equal([]string{"admin", "member", "view"}))
+
+			// operands of a permission
+			res = c.call("textDocument/completion", TextDocumentPositionParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+				Position:     Position{Line: 7, Character: 22},
+			})
+			Expect(json.Unmarshal(res.Result, &items)).Should(Succeed())
+			Expect(labels(items)).Should(Equal([]string{"admin", "member", "view", "and", "or", "but", "not"}))
+		})
+	})
+
+	Context("DocumentSymbol", func() {
+		It("Case 1", func() {
+			res := c.call("textDocument/documentSymbol", DocumentSymbolParams{
+				TextDocument: TextDocumentIdentifier{URI: uri},
+			})
+			var symbols []DocumentSymbol
+			Expect(json.Unmarshal(res.Result, &symbols)).Should(Succeed())
+			Expect(symbols).Should(HaveLen(3))
+
+			Expect(symbols[1].Name).Should(Equal("organization"))
+			Expect(symbols[1].Kind).Should(Equal(SymbolKindClass))
+			Expect(symbols[1].Range).Should(Equal(Range{Start: Position{Line: 3, Character: 0}, End: Position{Line: 7, Character: 37}}))
+			Expect(symbols[1].Children).Should(HaveLen(3))
+			Expect(symbols[1].Children[2].Name).Should(Equal("view"))
+			Expect(symbols[1].Children[2].Kind).Should(Equal(SymbolKindFunction))
+			Expect(symbols[1].Children[2].Detail).Should(Equal("permission view = member or admin"))
+		})
+	})
+})
+
+// labels - returns the labels of completion items
+func labels(items []CompletionItem) []string {
+	l := make([]string, 0, len(items))
+	for _, item := range items {
+		l = append(l, item.Label)
+	}
+	return l
+}
@@ -847,6 +847,8 @@ func (m *EntityDefinition) validate(all bool) error {
 
 	// no validation rules for References
 
+	// no validation rules for Description
+
 	if len(errors) > 0 {
 		return EntityDefinitionMultiError(errors)
 	}
@@ -1005,6 +1007,8 @@ func (m *RelationDefinition) validate(all bool) error {
 
 	}
 
+	// no validation rules for Description
+
 	if len(errors) > 0 {
 		return RelationDefinitionMultiError(errors)
 	}
@@ -1160,6 +1164,8 @@ func (m *PermissionDefinition) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Description
+
 	if len(errors) > 0 {
 		return PermissionDefinitionMultiError(errors)
 	}
@@ -1719,6 +1725,35 @@ func (m *TupleToUserSet) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetNext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleToUserSetValidationError{
+					field:  "Next",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleToUserSetValidationError{
+					field:  "Next",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleToUserSetValidationError{
+				field:  "Next",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TupleToUserSetMultiError(errors)
 	}
@@ -2512,6 +2512,12 @@ func (m *SchemaWriteRequest) validate(all bool) error {
 
 	// no validation rules for Schema
 
+	// no validation rules for Force
+
+	// no validation rules for Files
+
+	// no validation rules for Format
+
 	if len(errors) > 0 {
 		return SchemaWriteRequestMultiError(errors)
 	}
@@ -2618,6 +2624,78 @@ func (m *SchemaWriteResponse) validate(all bool) error {
 
 	// no validation rules for SchemaVersion
 
+	for idx, item := range m.GetBreakingChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaWriteResponseValidationError{
+						field:  fmt.Sprintf("BreakingChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaWriteResponseValidationError{
+						field:  fmt.Sprintf("BreakingChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaWriteResponseValidationError{
+					field:  fmt.Sprintf("BreakingChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FormattedSchema
+
+	// no validation rules for FormattedFiles
+
+	for idx, item := range m.GetLintWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaWriteResponseValidationError{
+						field:  fmt.Sprintf("LintWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaWriteResponseValidationError{
+						field:  fmt.Sprintf("LintWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaWriteResponseValidationError{
+					field:  fmt.Sprintf("LintWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SchemaWriteResponseMultiError(errors)
 	}
@@ -2698,104 +2776,52 @@ var _ interface {
 	ErrorName() string
 } = SchemaWriteResponseValidationError{}
 
-// Validate checks the field values on SchemaReadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequest) Validate() error {
+// Validate checks the field values on SchemaBreakingChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaBreakingChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequest with the rules
+// ValidateAll checks the field values on SchemaBreakingChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMultiError, or nil if none found.
-func (m *SchemaReadRequest) ValidateAll() error {
+// SchemaBreakingChangeMultiError, or nil if none found.
+func (m *SchemaBreakingChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequest) validate(all bool) error {
+func (m *SchemaBreakingChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Kind
 
-	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Entity
 
-	if m.GetMetadata() == nil {
-		err := SchemaReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Relation
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SchemaReadRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Subject
+
+	// no validation rules for TupleCount
 
 	if len(errors) > 0 {
-		return SchemaReadRequestMultiError(errors)
+		return SchemaBreakingChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaReadRequestMultiError []error
+// SchemaBreakingChangeMultiError is an error wrapping multiple validation
+// errors returned by SchemaBreakingChange.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaBreakingChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMultiError) Error() string {
+func (m SchemaBreakingChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2804,11 +2830,11 @@ func (m SchemaReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
+func (m SchemaBreakingChangeMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestValidationError is the validation error returned by
-// SchemaReadRequest.Validate if the designated constraints aren't met.
-type SchemaReadRequestValidationError struct {
+// SchemaBreakingChangeValidationError is the validation error returned by
+// SchemaBreakingChange.Validate if the designated constraints aren't met.
+type SchemaBreakingChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2816,24 +2842,24 @@ type SchemaReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestValidationError) Field() string { return e.field }
+func (e SchemaBreakingChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
+func (e SchemaBreakingChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
+func (e SchemaBreakingChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestValidationError) Key() bool { return e.key }
+func (e SchemaBreakingChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestValidationError) ErrorName() string {
-	return "SchemaReadRequestValidationError"
+func (e SchemaBreakingChangeValidationError) ErrorName() string {
+	return "SchemaBreakingChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestValidationError) Error() string {
+func (e SchemaBreakingChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2845,14 +2871,14 @@ func (e SchemaReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequest.%s: %s%s",
+		"invalid %sSchemaBreakingChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestValidationError{}
+var _ error = SchemaBreakingChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -2860,48 +2886,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestValidationError{}
-
-var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+} = SchemaBreakingChangeValidationError{}
 
-// Validate checks the field values on SchemaReadRequestMetadata with the rules
+// Validate checks the field values on SchemaBreakingChanges with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequestMetadata) Validate() error {
+func (m *SchemaBreakingChanges) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaBreakingChanges with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMetadataMultiError, or nil if none found.
-func (m *SchemaReadRequestMetadata) ValidateAll() error {
+// SchemaBreakingChangesMultiError, or nil if none found.
+func (m *SchemaBreakingChanges) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequestMetadata) validate(all bool) error {
+func (m *SchemaBreakingChanges) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaBreakingChangesValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaBreakingChangesValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaBreakingChangesValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return SchemaReadRequestMetadataMultiError(errors)
+		return SchemaBreakingChangesMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
-// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
-// designated constraints aren't met.
-type SchemaReadRequestMetadataMultiError []error
+// SchemaBreakingChangesMultiError is an error wrapping multiple validation
+// errors returned by SchemaBreakingChanges.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaBreakingChangesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMetadataMultiError) Error() string {
+func (m SchemaBreakingChangesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2910,11 +2966,11 @@ func (m SchemaReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m SchemaBreakingChangesMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestMetadataValidationError is the validation error returned by
-// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
-type SchemaReadRequestMetadataValidationError struct {
+// SchemaBreakingChangesValidationError is the validation error returned by
+// SchemaBreakingChanges.Validate if the designated constraints aren't met.
+type SchemaBreakingChangesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2922,24 +2978,24 @@ type SchemaReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
+func (e SchemaBreakingChangesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e SchemaBreakingChangesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e SchemaBreakingChangesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e SchemaBreakingChangesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
-	return "SchemaReadRequestMetadataValidationError"
+func (e SchemaBreakingChangesValidationError) ErrorName() string {
+	return "SchemaBreakingChangesValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestMetadataValidationError) Error() string {
+func (e SchemaBreakingChangesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2951,14 +3007,14 @@ func (e SchemaReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
+		"invalid %sSchemaBreakingChanges.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestMetadataValidationError{}
+var _ error = SchemaBreakingChangesValidationError{}
 
 var _ interface {
 	Field() string
@@ -2966,53 +3022,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestMetadataValidationError{}
+} = SchemaBreakingChangesValidationError{}
 
-// Validate checks the field values on SchemaReadResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadResponse) Validate() error {
+// Validate checks the field values on SchemaError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SchemaReadResponseMultiError, or nil if none found.
-func (m *SchemaReadResponse) ValidateAll() error {
+// ValidateAll checks the field values on SchemaError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaErrorMultiError, or
+// nil if none found.
+func (m *SchemaError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadResponse) validate(all bool) error {
+func (m *SchemaError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for File
+
 	if all {
-		switch v := interface{}(m.GetSchema()).(type) {
+		switch v := interface{}(m.GetStart()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
+				errors = append(errors, SchemaErrorValidationError{
+					field:  "Start",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
+				errors = append(errors, SchemaErrorValidationError{
+					field:  "Start",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SchemaReadResponseValidationError{
-				field:  "Schema",
+			return SchemaErrorValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaErrorValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaErrorValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaErrorValidationError{
+				field:  "End",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3020,19 +3111,18 @@ func (m *SchemaReadResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SchemaReadResponseMultiError(errors)
+		return SchemaErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadResponseMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadResponse.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaReadResponseMultiError []error
+// SchemaErrorMultiError is an error wrapping multiple validation errors
+// returned by SchemaError.ValidateAll() if the designated constraints aren't met.
+type SchemaErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadResponseMultiError) Error() string {
+func (m SchemaErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3041,11 +3131,11 @@ func (m SchemaReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
+func (m SchemaErrorMultiError) AllErrors() []error { return m }
 
-// SchemaReadResponseValidationError is the validation error returned by
-// SchemaReadResponse.Validate if the designated constraints aren't met.
-type SchemaReadResponseValidationError struct {
+// SchemaErrorValidationError is the validation error returned by
+// SchemaError.Validate if the designated constraints aren't met.
+type SchemaErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3053,24 +3143,22 @@ type SchemaReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadResponseValidationError) Field() string { return e.field }
+func (e SchemaErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
+func (e SchemaErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
+func (e SchemaErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadResponseValidationError) Key() bool { return e.key }
+func (e SchemaErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadResponseValidationError) ErrorName() string {
-	return "SchemaReadResponseValidationError"
-}
+func (e SchemaErrorValidationError) ErrorName() string { return "SchemaErrorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadResponseValidationError) Error() string {
+func (e SchemaErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3082,14 +3170,14 @@ func (e SchemaReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadResponse.%s: %s%s",
+		"invalid %sSchemaError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadResponseValidationError{}
+var _ error = SchemaErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -3097,162 +3185,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadResponseValidationError{}
+} = SchemaErrorValidationError{}
 
-// Validate checks the field values on RelationshipWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteRequest) Validate() error {
+// Validate checks the field values on SourcePosition with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SourcePosition) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMultiError, or nil if none found.
-func (m *RelationshipWriteRequest) ValidateAll() error {
+// ValidateAll checks the field values on SourcePosition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SourcePositionMultiError,
+// or nil if none found.
+func (m *SourcePosition) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequest) validate(all bool) error {
+func (m *SourcePosition) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := RelationshipWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipWriteRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if l := len(m.GetTuples()); l < 1 || l > 100 {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Tuples",
-			reason: "value must contain between 1 and 100 items, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
-
-		if item == nil {
-			err := RelationshipWriteRequestValidationError{
-				field:  fmt.Sprintf("Tuples[%v]", idx),
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RelationshipWriteRequestValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Line
 
-	}
+	// no validation rules for Column
 
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMultiError(errors)
+		return SourcePositionMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMultiError []error
+// SourcePositionMultiError is an error wrapping multiple validation errors
+// returned by SourcePosition.ValidateAll() if the designated constraints
+// aren't met.
+type SourcePositionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMultiError) Error() string {
+func (m SourcePositionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3261,11 +3235,11 @@ func (m RelationshipWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+func (m SourcePositionMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestValidationError is the validation error returned by
-// RelationshipWriteRequest.Validate if the designated constraints aren't met.
-type RelationshipWriteRequestValidationError struct {
+// SourcePositionValidationError is the validation error returned by
+// SourcePosition.Validate if the designated constraints aren't met.
+type SourcePositionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3273,24 +3247,22 @@ type RelationshipWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+func (e SourcePositionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+func (e SourcePositionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+func (e SourcePositionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+func (e SourcePositionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestValidationError) ErrorName() string {
-	return "RelationshipWriteRequestValidationError"
-}
+func (e SourcePositionValidationError) ErrorName() string { return "SourcePositionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestValidationError) Error() string {
+func (e SourcePositionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3302,14 +3274,14 @@ func (e RelationshipWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		"invalid %sSourcePosition.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestValidationError{}
+var _ error = SourcePositionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3317,50 +3289,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestValidationError{}
-
-var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+} = SourcePositionValidationError{}
 
-// Validate checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RelationshipWriteRequestMetadata) Validate() error {
+// Validate checks the field values on SchemaLintWarning with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaLintWarning) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on SchemaLintWarning with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaLintWarningMultiError, or nil if none found.
+func (m *SchemaLintWarning) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+func (m *SchemaLintWarning) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	// no validation rules for Rule
+
+	// no validation rules for Message
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaLintWarningValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaLintWarningValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaLintWarningValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaLintWarningValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaLintWarningValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaLintWarningValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMetadataMultiError(errors)
+		return SchemaLintWarningMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by
-// RelationshipWriteRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMetadataMultiError []error
+// SchemaLintWarningMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintWarning.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintWarningMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+func (m SchemaLintWarningMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3369,12 +3399,11 @@ func (m RelationshipWriteRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+func (m SchemaLintWarningMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestMetadataValidationError is the validation error
-// returned by RelationshipWriteRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMetadataValidationError struct {
+// SchemaLintWarningValidationError is the validation error returned by
+// SchemaLintWarning.Validate if the designated constraints aren't met.
+type SchemaLintWarningValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3382,24 +3411,24 @@ type RelationshipWriteRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+func (e SchemaLintWarningValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+func (e SchemaLintWarningValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+func (e SchemaLintWarningValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+func (e SchemaLintWarningValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipWriteRequestMetadataValidationError"
+func (e SchemaLintWarningValidationError) ErrorName() string {
+	return "SchemaLintWarningValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+func (e SchemaLintWarningValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3411,14 +3440,14 @@ func (e RelationshipWriteRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		"invalid %sSchemaLintWarning.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestMetadataValidationError{}
+var _ error = SchemaLintWarningValidationError{}
 
 var _ interface {
 	Field() string
@@ -3426,46 +3455,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestMetadataValidationError{}
+} = SchemaLintWarningValidationError{}
 
-// Validate checks the field values on RelationshipWriteResponse with the rules
+// Validate checks the field values on SchemaLintWarnings with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteResponse) Validate() error {
+func (m *SchemaLintWarnings) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaLintWarnings with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipWriteResponseMultiError, or nil if none found.
-func (m *RelationshipWriteResponse) ValidateAll() error {
+// SchemaLintWarningsMultiError, or nil if none found.
+func (m *SchemaLintWarnings) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteResponse) validate(all bool) error {
+func (m *SchemaLintWarnings) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaLintWarningsValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaLintWarningsValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaLintWarningsValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RelationshipWriteResponseMultiError(errors)
+		return SchemaLintWarningsMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteResponse.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipWriteResponseMultiError []error
+// SchemaLintWarningsMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintWarnings.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintWarningsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteResponseMultiError) Error() string {
+func (m SchemaLintWarningsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3474,11 +3535,11 @@ func (m RelationshipWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+func (m SchemaLintWarningsMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteResponseValidationError is the validation error returned by
-// RelationshipWriteResponse.Validate if the designated constraints aren't met.
-type RelationshipWriteResponseValidationError struct {
+// SchemaLintWarningsValidationError is the validation error returned by
+// SchemaLintWarnings.Validate if the designated constraints aren't met.
+type SchemaLintWarningsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3486,24 +3547,24 @@ type RelationshipWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+func (e SchemaLintWarningsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+func (e SchemaLintWarningsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+func (e SchemaLintWarningsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+func (e SchemaLintWarningsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteResponseValidationError) ErrorName() string {
-	return "RelationshipWriteResponseValidationError"
+func (e SchemaLintWarningsValidationError) ErrorName() string {
+	return "SchemaLintWarningsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteResponseValidationError) Error() string {
+func (e SchemaLintWarningsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3515,14 +3576,14 @@ func (e RelationshipWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		"invalid %sSchemaLintWarnings.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteResponseValidationError{}
+var _ error = SchemaLintWarningsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3530,24 +3591,157 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteResponseValidationError{}
+} = SchemaLintWarningsValidationError{}
 
-// Validate checks the field values on RelationshipReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequest) Validate() error {
+// Validate checks the field values on SchemaErrors with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaErrors) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaErrors with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaErrorsMultiError, or
+// nil if none found.
+func (m *SchemaErrors) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaErrors) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaErrorsValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaErrorsValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaErrorsValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaErrorsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaErrorsMultiError is an error wrapping multiple validation errors
+// returned by SchemaErrors.ValidateAll() if the designated constraints aren't met.
+type SchemaErrorsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaErrorsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaErrorsMultiError) AllErrors() []error { return m }
+
+// SchemaErrorsValidationError is the validation error returned by
+// SchemaErrors.Validate if the designated constraints aren't met.
+type SchemaErrorsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaErrorsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaErrorsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaErrorsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaErrorsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaErrorsValidationError) ErrorName() string { return "SchemaErrorsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaErrorsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaErrors.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaErrorsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaErrorsValidationError{}
+
+// Validate checks the field values on SchemaReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMultiError, or nil if none found.
-func (m *RelationshipReadRequest) ValidateAll() error {
+// SchemaReadRequestMultiError, or nil if none found.
+func (m *SchemaReadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequest) validate(all bool) error {
+func (m *SchemaReadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3555,7 +3749,7 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 64 {
-		err := RelationshipReadRequestValidationError{
+		err := SchemaReadRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 64 bytes",
 		}
@@ -3565,8 +3759,8 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipReadRequestValidationError{
+	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaReadRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
 		}
@@ -3577,7 +3771,7 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 	}
 
 	if m.GetMetadata() == nil {
-		err := RelationshipReadRequestValidationError{
+		err := SchemaReadRequestValidationError{
 			field:  "Metadata",
 			reason: "value is required",
 		}
@@ -3591,7 +3785,7 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
+				errors = append(errors, SchemaReadRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3599,7 +3793,7 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
+				errors = append(errors, SchemaReadRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3608,7 +3802,7 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
+			return SchemaReadRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3616,68 +3810,20 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if val := m.GetPageSize(); val < 1 || val > 100 {
-			err := RelationshipReadRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be inside range [1, 100]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.GetContinuousToken() != "" {
-
-	}
-
 	if len(errors) > 0 {
-		return RelationshipReadRequestMultiError(errors)
+		return SchemaReadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipReadRequestMultiError []error
+// SchemaReadRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMultiError) Error() string {
+func (m SchemaReadRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3686,11 +3832,11 @@ func (m RelationshipReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipReadRequestValidationError is the validation error returned by
-// RelationshipReadRequest.Validate if the designated constraints aren't met.
-type RelationshipReadRequestValidationError struct {
+// SchemaReadRequestValidationError is the validation error returned by
+// SchemaReadRequest.Validate if the designated constraints aren't met.
+type SchemaReadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3698,24 +3844,3881 @@ type RelationshipReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+func (e SchemaReadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+func (e SchemaReadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestValidationError) ErrorName() string {
+func (e SchemaReadRequestValidationError) ErrorName() string {
+	return "SchemaReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadRequestValidationError{}
+
+var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on SchemaReadRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadRequestMetadataMultiError, or nil if none found.
+func (m *SchemaReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if _, ok := _SchemaReadRequestMetadata_Format_InLookup[m.GetFormat()]; !ok {
+		err := SchemaReadRequestMetadataValidationError{
+			field:  "Format",
+			reason: "value must be in list [ dsl]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// SchemaReadRequestMetadataValidationError is the validation error returned by
+// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
+type SchemaReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
+	return "SchemaReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadRequestMetadataValidationError{}
+
+var _SchemaReadRequestMetadata_Format_InLookup = map[string]struct{}{
+	"":    {},
+	"dsl": {},
+}
+
+// Validate checks the field values on SchemaReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadResponseMultiError, or nil if none found.
+func (m *SchemaReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Dsl
+
+	if len(errors) > 0 {
+		return SchemaReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
+
+// SchemaReadResponseValidationError is the validation error returned by
+// SchemaReadResponse.Validate if the designated constraints aren't met.
+type SchemaReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadResponseValidationError) ErrorName() string {
+	return "SchemaReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadResponseValidationError{}
+
+// Validate checks the field values on SchemaAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaAuditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaAuditRequestMultiError, or nil if none found.
+func (m *SchemaAuditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaAuditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaAuditRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaAuditRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaAuditRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := SchemaAuditRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaAuditRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaAuditRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaAuditRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Delete
+
+	if len(errors) > 0 {
+		return SchemaAuditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaAuditRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaAuditRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaAuditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaAuditRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaAuditRequestMultiError) AllErrors() []error { return m }
+
+// SchemaAuditRequestValidationError is the validation error returned by
+// SchemaAuditRequest.Validate if the designated constraints aren't met.
+type SchemaAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaAuditRequestValidationError) ErrorName() string {
+	return "SchemaAuditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaAuditRequestValidationError{}
+
+var _SchemaAuditRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on SchemaAuditRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaAuditRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaAuditRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaAuditRequestMetadataMultiError, or nil if none found.
+func (m *SchemaAuditRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaAuditRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return SchemaAuditRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaAuditRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by SchemaAuditRequestMetadata.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaAuditRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaAuditRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaAuditRequestMetadataMultiError) AllErrors() []error { return m }
+
+// SchemaAuditRequestMetadataValidationError is the validation error returned
+// by SchemaAuditRequestMetadata.Validate if the designated constraints aren't met.
+type SchemaAuditRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaAuditRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaAuditRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaAuditRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaAuditRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaAuditRequestMetadataValidationError) ErrorName() string {
+	return "SchemaAuditRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaAuditRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaAuditRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaAuditRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaAuditRequestMetadataValidationError{}
+
+// Validate checks the field values on SchemaAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaAuditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaAuditResponseMultiError, or nil if none found.
+func (m *SchemaAuditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaAuditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaAuditResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaAuditResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaAuditResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return SchemaAuditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaAuditResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaAuditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaAuditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaAuditResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaAuditResponseMultiError) AllErrors() []error { return m }
+
+// SchemaAuditResponseValidationError is the validation error returned by
+// SchemaAuditResponse.Validate if the designated constraints aren't met.
+type SchemaAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaAuditResponseValidationError) ErrorName() string {
+	return "SchemaAuditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaAuditResponseValidationError{}
+
+// Validate checks the field values on SchemaAuditViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaAuditViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaAuditViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaAuditViolationMultiError, or nil if none found.
+func (m *SchemaAuditViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaAuditViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTuple()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaAuditViolationValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaAuditViolationValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaAuditViolationValidationError{
+				field:  "Tuple",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SchemaAuditViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaAuditViolationMultiError is an error wrapping multiple validation
+// errors returned by SchemaAuditViolation.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaAuditViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaAuditViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaAuditViolationMultiError) AllErrors() []error { return m }
+
+// SchemaAuditViolationValidationError is the validation error returned by
+// SchemaAuditViolation.Validate if the designated constraints aren't met.
+type SchemaAuditViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaAuditViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaAuditViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaAuditViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaAuditViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaAuditViolationValidationError) ErrorName() string {
+	return "SchemaAuditViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaAuditViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaAuditViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaAuditViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaAuditViolationValidationError{}
+
+// Validate checks the field values on SchemaListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaListRequestMultiError, or nil if none found.
+func (m *SchemaListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaListRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaListRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaListRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := SchemaListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListRequestMultiError) AllErrors() []error { return m }
+
+// SchemaListRequestValidationError is the validation error returned by
+// SchemaListRequest.Validate if the designated constraints aren't met.
+type SchemaListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListRequestValidationError) ErrorName() string {
+	return "SchemaListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListRequestValidationError{}
+
+var _SchemaListRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on SchemaListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaListResponseMultiError, or nil if none found.
+func (m *SchemaListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Head
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaListResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaListResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaListResponseValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return SchemaListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListResponseMultiError) AllErrors() []error { return m }
+
+// SchemaListResponseValidationError is the validation error returned by
+// SchemaListResponse.Validate if the designated constraints aren't met.
+type SchemaListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListResponseValidationError) ErrorName() string {
+	return "SchemaListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListResponseValidationError{}
+
+// Validate checks the field values on SchemaList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaListMultiError, or
+// nil if none found.
+func (m *SchemaList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaListValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaListValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaListValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaListMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListMultiError is an error wrapping multiple validation errors
+// returned by SchemaList.ValidateAll() if the designated constraints aren't met.
+type SchemaListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListMultiError) AllErrors() []error { return m }
+
+// SchemaListValidationError is the validation error returned by
+// SchemaList.Validate if the designated constraints aren't met.
+type SchemaListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListValidationError) ErrorName() string { return "SchemaListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListValidationError{}
+
+// Validate checks the field values on SchemaDiffRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaDiffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaDiffRequestMultiError, or nil if none found.
+func (m *SchemaDiffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaDiffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaDiffRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaDiffRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaDiffRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := SchemaDiffRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaDiffRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaDiffRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaDiffRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaDiffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaDiffRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaDiffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaDiffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaDiffRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaDiffRequestMultiError) AllErrors() []error { return m }
+
+// SchemaDiffRequestValidationError is the validation error returned by
+// SchemaDiffRequest.Validate if the designated constraints aren't met.
+type SchemaDiffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaDiffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaDiffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaDiffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaDiffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaDiffRequestValidationError) ErrorName() string {
+	return "SchemaDiffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaDiffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaDiffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaDiffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaDiffRequestValidationError{}
+
+var _SchemaDiffRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on SchemaDiffRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaDiffRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaDiffRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaDiffRequestMetadataMultiError, or nil if none found.
+func (m *SchemaDiffRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaDiffRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFromVersion()) < 1 {
+		err := SchemaDiffRequestMetadataValidationError{
+			field:  "FromVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ToVersion
+
+	if len(errors) > 0 {
+		return SchemaDiffRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaDiffRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by SchemaDiffRequestMetadata.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaDiffRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaDiffRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaDiffRequestMetadataMultiError) AllErrors() []error { return m }
+
+// SchemaDiffRequestMetadataValidationError is the validation error returned by
+// SchemaDiffRequestMetadata.Validate if the designated constraints aren't met.
+type SchemaDiffRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaDiffRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaDiffRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaDiffRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaDiffRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaDiffRequestMetadataValidationError) ErrorName() string {
+	return "SchemaDiffRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaDiffRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaDiffRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaDiffRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaDiffRequestMetadataValidationError{}
+
+// Validate checks the field values on SchemaDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaDiffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaDiffResponseMultiError, or nil if none found.
+func (m *SchemaDiffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaDiffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromVersion
+
+	// no validation rules for ToVersion
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaDiffResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaDiffResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaDiffResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Summary
+
+	if len(errors) > 0 {
+		return SchemaDiffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaDiffResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaDiffResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaDiffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaDiffResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaDiffResponseMultiError) AllErrors() []error { return m }
+
+// SchemaDiffResponseValidationError is the validation error returned by
+// SchemaDiffResponse.Validate if the designated constraints aren't met.
+type SchemaDiffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaDiffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaDiffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaDiffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaDiffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaDiffResponseValidationError) ErrorName() string {
+	return "SchemaDiffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaDiffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaDiffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaDiffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaDiffResponseValidationError{}
+
+// Validate checks the field values on SchemaChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaChangeMultiError, or
+// nil if none found.
+func (m *SchemaChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Entity
+
+	// no validation rules for Name
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	if len(errors) > 0 {
+		return SchemaChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaChangeMultiError is an error wrapping multiple validation errors
+// returned by SchemaChange.ValidateAll() if the designated constraints aren't met.
+type SchemaChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaChangeMultiError) AllErrors() []error { return m }
+
+// SchemaChangeValidationError is the validation error returned by
+// SchemaChange.Validate if the designated constraints aren't met.
+type SchemaChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaChangeValidationError) ErrorName() string { return "SchemaChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaChangeValidationError{}
+
+// Validate checks the field values on SchemaGraphRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaGraphRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaGraphRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaGraphRequestMultiError, or nil if none found.
+func (m *SchemaGraphRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaGraphRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaGraphRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaGraphRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaGraphRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := SchemaGraphRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaGraphRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaGraphRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaGraphRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaGraphRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaGraphRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaGraphRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaGraphRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaGraphRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaGraphRequestMultiError) AllErrors() []error { return m }
+
+// SchemaGraphRequestValidationError is the validation error returned by
+// SchemaGraphRequest.Validate if the designated constraints aren't met.
+type SchemaGraphRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaGraphRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaGraphRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaGraphRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaGraphRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaGraphRequestValidationError) ErrorName() string {
+	return "SchemaGraphRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaGraphRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaGraphRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaGraphRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaGraphRequestValidationError{}
+
+var _SchemaGraphRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on SchemaGraphRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaGraphRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaGraphRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaGraphRequestMetadataMultiError, or nil if none found.
+func (m *SchemaGraphRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaGraphRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if _, ok := _SchemaGraphRequestMetadata_Format_InLookup[m.GetFormat()]; !ok {
+		err := SchemaGraphRequestMetadataValidationError{
+			field:  "Format",
+			reason: "value must be in list [ dot mermaid]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaGraphRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaGraphRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by SchemaGraphRequestMetadata.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaGraphRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaGraphRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaGraphRequestMetadataMultiError) AllErrors() []error { return m }
+
+// SchemaGraphRequestMetadataValidationError is the validation error returned
+// by SchemaGraphRequestMetadata.Validate if the designated constraints aren't met.
+type SchemaGraphRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaGraphRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaGraphRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaGraphRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaGraphRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaGraphRequestMetadataValidationError) ErrorName() string {
+	return "SchemaGraphRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaGraphRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaGraphRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaGraphRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaGraphRequestMetadataValidationError{}
+
+var _SchemaGraphRequestMetadata_Format_InLookup = map[string]struct{}{
+	"":        {},
+	"dot":     {},
+	"mermaid": {},
+}
+
+// Validate checks the field values on SchemaGraphResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaGraphResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaGraphResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaGraphResponseMultiError, or nil if none found.
+func (m *SchemaGraphResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaGraphResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaGraphResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEdges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaGraphResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Diagram
+
+	if len(errors) > 0 {
+		return SchemaGraphResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaGraphResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaGraphResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaGraphResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaGraphResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaGraphResponseMultiError) AllErrors() []error { return m }
+
+// SchemaGraphResponseValidationError is the validation error returned by
+// SchemaGraphResponse.Validate if the designated constraints aren't met.
+type SchemaGraphResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaGraphResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaGraphResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaGraphResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaGraphResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaGraphResponseValidationError) ErrorName() string {
+	return "SchemaGraphResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaGraphResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaGraphResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaGraphResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaGraphResponseValidationError{}
+
+// Validate checks the field values on SchemaGraphNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaGraphNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaGraphNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaGraphNodeMultiError, or nil if none found.
+func (m *SchemaGraphNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaGraphNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	// no validation rules for Label
+
+	if len(errors) > 0 {
+		return SchemaGraphNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaGraphNodeMultiError is an error wrapping multiple validation errors
+// returned by SchemaGraphNode.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaGraphNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaGraphNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaGraphNodeMultiError) AllErrors() []error { return m }
+
+// SchemaGraphNodeValidationError is the validation error returned by
+// SchemaGraphNode.Validate if the designated constraints aren't met.
+type SchemaGraphNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaGraphNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaGraphNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaGraphNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaGraphNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaGraphNodeValidationError) ErrorName() string { return "SchemaGraphNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaGraphNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaGraphNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaGraphNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaGraphNodeValidationError{}
+
+// Validate checks the field values on SchemaGraphEdge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaGraphEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaGraphEdge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaGraphEdgeMultiError, or nil if none found.
+func (m *SchemaGraphEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaGraphEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Label
+
+	// no validation rules for Exclusion
+
+	if len(errors) > 0 {
+		return SchemaGraphEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaGraphEdgeMultiError is an error wrapping multiple validation errors
+// returned by SchemaGraphEdge.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaGraphEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaGraphEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaGraphEdgeMultiError) AllErrors() []error { return m }
+
+// SchemaGraphEdgeValidationError is the validation error returned by
+// SchemaGraphEdge.Validate if the designated constraints aren't met.
+type SchemaGraphEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaGraphEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaGraphEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaGraphEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaGraphEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaGraphEdgeValidationError) ErrorName() string { return "SchemaGraphEdgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaGraphEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaGraphEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaGraphEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaGraphEdgeValidationError{}
+
+// Validate checks the field values on RelationshipWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMultiError, or nil if none found.
+func (m *RelationshipWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetTuples()); l < 1 || l > 100 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := RelationshipWriteRequestValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipWriteRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestValidationError is the validation error returned by
+// RelationshipWriteRequest.Validate if the designated constraints aren't met.
+type RelationshipWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestValidationError) ErrorName() string {
+	return "RelationshipWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestValidationError{}
+
+var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipWriteRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipWriteRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestMetadataValidationError is the validation error
+// returned by RelationshipWriteRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipWriteRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteResponseMultiError, or nil if none found.
+func (m *RelationshipWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteResponseValidationError is the validation error returned by
+// RelationshipWriteResponse.Validate if the designated constraints aren't met.
+type RelationshipWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteResponseValidationError) ErrorName() string {
+	return "RelationshipWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteResponseValidationError{}
+
+// Validate checks the field values on RelationshipReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMultiError, or nil if none found.
+func (m *RelationshipReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := RelationshipReadRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestValidationError is the validation error returned by
+// RelationshipReadRequest.Validate if the designated constraints aren't met.
+type RelationshipReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestValidationError) ErrorName() string {
 	return "RelationshipReadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestValidationError) Error() string {
+func (e RelationshipReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestValidationError{}
+
+var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on RelationshipReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestMetadataValidationError is the validation error
+// returned by RelationshipReadRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadResponseMultiError, or nil if none found.
+func (m *RelationshipReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadResponseValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return RelationshipReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipReadResponseValidationError is the validation error returned by
+// RelationshipReadResponse.Validate if the designated constraints aren't met.
+type RelationshipReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadResponseValidationError) ErrorName() string {
+	return "RelationshipReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadResponseValidationError{}
+
+// Validate checks the field values on RelationshipDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteRequestMultiError, or nil if none found.
+func (m *RelationshipDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipDeleteRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipDeleteRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteRequestValidationError is the validation error returned by
+// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
+type RelationshipDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteRequestValidationError) ErrorName() string {
+	return "RelationshipDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteRequestValidationError{}
+
+var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteResponseMultiError, or nil if none found.
+func (m *RelationshipDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteResponseValidationError is the validation error returned
+// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
+type RelationshipDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteResponseValidationError) ErrorName() string {
+	return "RelationshipDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteResponseValidationError{}
+
+// Validate checks the field values on RelationshipStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipStatsRequestMultiError, or nil if none found.
+func (m *RelationshipStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipStatsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipStatsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipStatsRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipStatsRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipStatsRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipStatsRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipStatsRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTop() != 0 {
+
+		if m.GetTop() > 100 {
+			err := RelationshipStatsRequestValidationError{
+				field:  "Top",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipStatsRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipStatsRequestValidationError is the validation error returned by
+// RelationshipStatsRequest.Validate if the designated constraints aren't met.
+type RelationshipStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipStatsRequestValidationError) ErrorName() string {
+	return "RelationshipStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipStatsRequestValidationError{}
+
+var _RelationshipStatsRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on RelationshipStatsRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipStatsRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipStatsRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipStatsRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipStatsRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipStatsRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipStatsRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipStatsRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipStatsRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipStatsRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipStatsRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipStatsRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipStatsRequestMetadataValidationError is the validation error
+// returned by RelationshipStatsRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipStatsRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipStatsRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipStatsRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipStatsRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipStatsRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipStatsRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipStatsRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipStatsRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3727,14 +7730,14 @@ func (e RelationshipReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequest.%s: %s%s",
+		"invalid %sRelationshipStatsRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestValidationError{}
+var _ error = RelationshipStatsRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -3742,48 +7745,361 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestValidationError{}
+} = RelationshipStatsRequestMetadataValidationError{}
 
-var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+// Validate checks the field values on RelationshipStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipStatsResponse) Validate() error {
+	return m.validate(false)
+}
 
-// Validate checks the field values on RelationshipReadRequestMetadata with the
+// ValidateAll checks the field values on RelationshipStatsResponse with the
 // rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipStatsResponseMultiError, or nil if none found.
+func (m *RelationshipStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipStatsResponseValidationError{
+						field:  fmt.Sprintf("Counts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipStatsResponseValidationError{
+						field:  fmt.Sprintf("Counts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipStatsResponseValidationError{
+					field:  fmt.Sprintf("Counts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipStatsResponseValidationError{
+						field:  fmt.Sprintf("TopEntities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipStatsResponseValidationError{
+						field:  fmt.Sprintf("TopEntities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipStatsResponseValidationError{
+					field:  fmt.Sprintf("TopEntities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipStatsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipStatsResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipStatsResponseValidationError is the validation error returned by
+// RelationshipStatsResponse.Validate if the designated constraints aren't met.
+type RelationshipStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipStatsResponseValidationError) ErrorName() string {
+	return "RelationshipStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipStatsResponseValidationError{}
+
+// Validate checks the field values on RelationshipCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipCountMultiError, or nil if none found.
+func (m *RelationshipCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for Relation
+
+	// no validation rules for SubjectType
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return RelationshipCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipCountMultiError is an error wrapping multiple validation errors
+// returned by RelationshipCount.ValidateAll() if the designated constraints
+// aren't met.
+type RelationshipCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipCountMultiError) AllErrors() []error { return m }
+
+// RelationshipCountValidationError is the validation error returned by
+// RelationshipCount.Validate if the designated constraints aren't met.
+type RelationshipCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipCountValidationError) ErrorName() string {
+	return "RelationshipCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipCountValidationError{}
+
+// Validate checks the field values on RelationshipFanOut with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequestMetadata) Validate() error {
+func (m *RelationshipFanOut) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on RelationshipFanOut with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipFanOutMultiError, or nil if none found.
+func (m *RelationshipFanOut) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+func (m *RelationshipFanOut) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipFanOutValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipFanOutValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipFanOutValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Relation
 
-	// no validation rules for SnapToken
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return RelationshipReadRequestMetadataMultiError(errors)
+		return RelationshipFanOutMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
-// if the designated constraints aren't met.
-type RelationshipReadRequestMetadataMultiError []error
+// RelationshipFanOutMultiError is an error wrapping multiple validation errors
+// returned by RelationshipFanOut.ValidateAll() if the designated constraints
+// aren't met.
+type RelationshipFanOutMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMetadataMultiError) Error() string {
+func (m RelationshipFanOutMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3792,12 +8108,11 @@ func (m RelationshipReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m RelationshipFanOutMultiError) AllErrors() []error { return m }
 
-// RelationshipReadRequestMetadataValidationError is the validation error
-// returned by RelationshipReadRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipReadRequestMetadataValidationError struct {
+// RelationshipFanOutValidationError is the validation error returned by
+// RelationshipFanOut.Validate if the designated constraints aren't met.
+type RelationshipFanOutValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3805,24 +8120,24 @@ type RelationshipReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+func (e RelationshipFanOutValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e RelationshipFanOutValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e RelationshipFanOutValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e RelationshipFanOutValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipReadRequestMetadataValidationError"
+func (e RelationshipFanOutValidationError) ErrorName() string {
+	return "RelationshipFanOutValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestMetadataValidationError) Error() string {
+func (e RelationshipFanOutValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3834,14 +8149,14 @@ func (e RelationshipReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		"invalid %sRelationshipFanOut.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestMetadataValidationError{}
+var _ error = RelationshipFanOutValidationError{}
 
 var _ interface {
 	Field() string
@@ -3849,80 +8164,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestMetadataValidationError{}
+} = RelationshipFanOutValidationError{}
 
-// Validate checks the field values on RelationshipReadResponse with the rules
+// Validate checks the field values on TenantCreateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadResponse) Validate() error {
+func (m *TenantCreateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipReadResponseMultiError, or nil if none found.
-func (m *RelationshipReadResponse) ValidateAll() error {
+// TenantCreateRequestMultiError, or nil if none found.
+func (m *TenantCreateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadResponse) validate(all bool) error {
+func (m *TenantCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RelationshipReadResponseValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if len(m.GetId()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 bytes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
+	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for ContinuousToken
+	if len(m.GetName()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RelationshipReadResponseMultiError(errors)
+		return TenantCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadResponse.ValidateAll() if the designated
+// TenantCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RelationshipReadResponseMultiError []error
+type TenantCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadResponseMultiError) Error() string {
+func (m TenantCreateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3931,11 +8243,11 @@ func (m RelationshipReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipReadResponseValidationError is the validation error returned by
-// RelationshipReadResponse.Validate if the designated constraints aren't met.
-type RelationshipReadResponseValidationError struct {
+// TenantCreateRequestValidationError is the validation error returned by
+// TenantCreateRequest.Validate if the designated constraints aren't met.
+type TenantCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3943,24 +8255,24 @@ type RelationshipReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+func (e TenantCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+func (e TenantCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadResponseValidationError) ErrorName() string {
-	return "RelationshipReadResponseValidationError"
+func (e TenantCreateRequestValidationError) ErrorName() string {
+	return "TenantCreateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadResponseValidationError) Error() string {
+func (e TenantCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3972,14 +8284,14 @@ func (e RelationshipReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadResponse.%s: %s%s",
+		"invalid %sTenantCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadResponseValidationError{}
+var _ error = TenantCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3987,75 +8299,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadResponseValidationError{}
+} = TenantCreateRequestValidationError{}
 
-// Validate checks the field values on RelationshipDeleteRequest with the rules
+var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on TenantCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteRequest) Validate() error {
+func (m *TenantCreateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TenantCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteRequestMultiError, or nil if none found.
-func (m *RelationshipDeleteRequest) ValidateAll() error {
+// TenantCreateResponseMultiError, or nil if none found.
+func (m *TenantCreateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteRequest) validate(all bool) error {
+func (m *TenantCreateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetTenant()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipDeleteRequestValidationError{
-				field:  "Filter",
+			return TenantCreateResponseValidationError{
+				field:  "Tenant",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4063,19 +8355,19 @@ func (m *RelationshipDeleteRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RelationshipDeleteRequestMultiError(errors)
+		return TenantCreateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipDeleteRequest.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipDeleteRequestMultiError []error
+// TenantCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteRequestMultiError) Error() string {
+func (m TenantCreateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4084,11 +8376,11 @@ func (m RelationshipDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteRequestValidationError is the validation error returned by
-// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
-type RelationshipDeleteRequestValidationError struct {
+// TenantCreateResponseValidationError is the validation error returned by
+// TenantCreateResponse.Validate if the designated constraints aren't met.
+type TenantCreateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4096,24 +8388,24 @@ type RelationshipDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+func (e TenantCreateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+func (e TenantCreateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteRequestValidationError) ErrorName() string {
-	return "RelationshipDeleteRequestValidationError"
+func (e TenantCreateResponseValidationError) ErrorName() string {
+	return "TenantCreateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteRequestValidationError) Error() string {
+func (e TenantCreateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4125,14 +8417,14 @@ func (e RelationshipDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		"invalid %sTenantCreateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteRequestValidationError{}
+var _ error = TenantCreateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4140,48 +8432,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteRequestValidationError{}
-
-var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+} = TenantCreateResponseValidationError{}
 
-// Validate checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TenantDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteResponse) Validate() error {
+func (m *TenantDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteResponseMultiError, or nil if none found.
-func (m *RelationshipDeleteResponse) ValidateAll() error {
+// ValidateAll checks the field values on TenantDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantDeleteRequestMultiError, or nil if none found.
+func (m *TenantDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteResponse) validate(all bool) error {
+func (m *TenantDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
-
 	if len(errors) > 0 {
-		return RelationshipDeleteResponseMultiError(errors)
+		return TenantDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteResponseMultiError is an error wrapping multiple
-// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RelationshipDeleteResponseMultiError []error
+// TenantDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteResponseMultiError) Error() string {
+func (m TenantDeleteRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4190,11 +8478,11 @@ func (m RelationshipDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteResponseValidationError is the validation error returned
-// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
-type RelationshipDeleteResponseValidationError struct {
+// TenantDeleteRequestValidationError is the validation error returned by
+// TenantDeleteRequest.Validate if the designated constraints aren't met.
+type TenantDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4202,24 +8490,24 @@ type RelationshipDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+func (e TenantDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteResponseValidationError) ErrorName() string {
-	return "RelationshipDeleteResponseValidationError"
+func (e TenantDeleteRequestValidationError) ErrorName() string {
+	return "TenantDeleteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteResponseValidationError) Error() string {
+func (e TenantDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4231,14 +8519,14 @@ func (e RelationshipDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		"invalid %sTenantDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteResponseValidationError{}
+var _ error = TenantDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4246,77 +8534,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteResponseValidationError{}
+} = TenantDeleteRequestValidationError{}
 
-// Validate checks the field values on TenantCreateRequest with the rules
+// Validate checks the field values on TenantDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateRequest) Validate() error {
+func (m *TenantDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateRequest with the rules
+// ValidateAll checks the field values on TenantDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateRequestMultiError, or nil if none found.
-func (m *TenantCreateRequest) ValidateAll() error {
+// TenantDeleteResponseMultiError, or nil if none found.
+func (m *TenantDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateRequest) validate(all bool) error {
+func (m *TenantDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetId()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at most 64 bytes",
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantDeleteResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return TenantCreateRequestMultiError(errors)
+		return TenantDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateRequest.ValidateAll() if the designated
+// TenantDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TenantCreateRequestMultiError []error
+type TenantDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateRequestMultiError) Error() string {
+func (m TenantDeleteResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4325,11 +8609,11 @@ func (m TenantCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
+func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
 
-// TenantCreateRequestValidationError is the validation error returned by
-// TenantCreateRequest.Validate if the designated constraints aren't met.
-type TenantCreateRequestValidationError struct {
+// TenantDeleteResponseValidationError is the validation error returned by
+// TenantDeleteResponse.Validate if the designated constraints aren't met.
+type TenantDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4337,24 +8621,24 @@ type TenantCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateRequestValidationError) Field() string { return e.field }
+func (e TenantDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
+func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
+func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateRequestValidationError) Key() bool { return e.key }
+func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateRequestValidationError) ErrorName() string {
-	return "TenantCreateRequestValidationError"
+func (e TenantDeleteResponseValidationError) ErrorName() string {
+	return "TenantDeleteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateRequestValidationError) Error() string {
+func (e TenantDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4366,14 +8650,14 @@ func (e TenantCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateRequest.%s: %s%s",
+		"invalid %sTenantDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateRequestValidationError{}
+var _ error = TenantDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4381,75 +8665,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateRequestValidationError{}
-
-var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+} = TenantDeleteResponseValidationError{}
 
-// Validate checks the field values on TenantCreateResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateResponse) Validate() error {
+// Validate checks the field values on TenantListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateResponse with the rules
+// ValidateAll checks the field values on TenantListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateResponseMultiError, or nil if none found.
-func (m *TenantCreateResponse) ValidateAll() error {
+// TenantListRequestMultiError, or nil if none found.
+func (m *TenantListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateResponse) validate(all bool) error {
+func (m *TenantListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTenant()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := TenantListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
 			}
-		}
-	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TenantCreateResponseValidationError{
-				field:  "Tenant",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
 	}
 
 	if len(errors) > 0 {
-		return TenantCreateResponseMultiError(errors)
+		return TenantListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateResponseMultiError []error
+// TenantListRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateResponseMultiError) Error() string {
+func (m TenantListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4458,11 +8730,11 @@ func (m TenantCreateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
+func (m TenantListRequestMultiError) AllErrors() []error { return m }
 
-// TenantCreateResponseValidationError is the validation error returned by
-// TenantCreateResponse.Validate if the designated constraints aren't met.
-type TenantCreateResponseValidationError struct {
+// TenantListRequestValidationError is the validation error returned by
+// TenantListRequest.Validate if the designated constraints aren't met.
+type TenantListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4470,24 +8742,24 @@ type TenantCreateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateResponseValidationError) Field() string { return e.field }
+func (e TenantListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
+func (e TenantListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
+func (e TenantListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateResponseValidationError) Key() bool { return e.key }
+func (e TenantListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateResponseValidationError) ErrorName() string {
-	return "TenantCreateResponseValidationError"
+func (e TenantListRequestValidationError) ErrorName() string {
+	return "TenantListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateResponseValidationError) Error() string {
+func (e TenantListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4499,14 +8771,14 @@ func (e TenantCreateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateResponse.%s: %s%s",
+		"invalid %sTenantListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateResponseValidationError{}
+var _ error = TenantListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4514,44 +8786,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateResponseValidationError{}
+} = TenantListRequestValidationError{}
 
-// Validate checks the field values on TenantDeleteRequest with the rules
+// Validate checks the field values on TenantListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteRequest) Validate() error {
+func (m *TenantListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteRequest with the rules
+// ValidateAll checks the field values on TenantListResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TenantDeleteRequestMultiError, or nil if none found.
-func (m *TenantDeleteRequest) ValidateAll() error {
+// violated, the result is a list of violation errors wrapped in
+// TenantListResponseMultiError, or nil if none found.
+func (m *TenantListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteRequest) validate(all bool) error {
+func (m *TenantListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantListResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
 	if len(errors) > 0 {
-		return TenantDeleteRequestMultiError(errors)
+		return TenantListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TenantDeleteRequestMultiError []error
+// TenantListResponseMultiError is an error wrapping multiple validation errors
+// returned by TenantListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteRequestMultiError) Error() string {
+func (m TenantListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4560,11 +8868,11 @@ func (m TenantDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
+func (m TenantListResponseMultiError) AllErrors() []error { return m }
 
-// TenantDeleteRequestValidationError is the validation error returned by
-// TenantDeleteRequest.Validate if the designated constraints aren't met.
-type TenantDeleteRequestValidationError struct {
+// TenantListResponseValidationError is the validation error returned by
+// TenantListResponse.Validate if the designated constraints aren't met.
+type TenantListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4572,24 +8880,24 @@ type TenantDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteRequestValidationError) Field() string { return e.field }
+func (e TenantListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
+func (e TenantListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
+func (e TenantListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
+func (e TenantListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteRequestValidationError) ErrorName() string {
-	return "TenantDeleteRequestValidationError"
+func (e TenantListResponseValidationError) ErrorName() string {
+	return "TenantListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteRequestValidationError) Error() string {
+func (e TenantListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4601,14 +8909,14 @@ func (e TenantDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteRequest.%s: %s%s",
+		"invalid %sTenantListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteRequestValidationError{}
+var _ error = TenantListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4616,73 +8924,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteRequestValidationError{}
+} = TenantListResponseValidationError{}
 
-// Validate checks the field values on TenantDeleteResponse with the rules
+// Validate checks the field values on GarbageCollectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteResponse) Validate() error {
+func (m *GarbageCollectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteResponse with the rules
+// ValidateAll checks the field values on GarbageCollectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantDeleteResponseMultiError, or nil if none found.
-func (m *TenantDeleteResponse) ValidateAll() error {
+// GarbageCollectRequestMultiError, or nil if none found.
+func (m *GarbageCollectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteResponse) validate(all bool) error {
+func (m *GarbageCollectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetTenantId() != "" {
+
+		if len(m.GetTenantId()) > 64 {
+			err := GarbageCollectRequestValidationError{
+				field:  "TenantId",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_GarbageCollectRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+			err := GarbageCollectRequestValidationError{
+				field:  "TenantId",
+				reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if all {
-		switch v := interface{}(m.GetTenant()).(type) {
+		switch v := interface{}(m.GetWindow()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
-					field:  "Tenant",
+				errors = append(errors, GarbageCollectRequestValidationError{
+					field:  "Window",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
-					field:  "Tenant",
+				errors = append(errors, GarbageCollectRequestValidationError{
+					field:  "Window",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TenantDeleteResponseValidationError{
-				field:  "Tenant",
+			return GarbageCollectRequestValidationError{
+				field:  "Window",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return TenantDeleteResponseMultiError(errors)
+		return GarbageCollectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteResponse.ValidateAll() if the designated
+// GarbageCollectRequestMultiError is an error wrapping multiple validation
+// errors returned by GarbageCollectRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TenantDeleteResponseMultiError []error
+type GarbageCollectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteResponseMultiError) Error() string {
+func (m GarbageCollectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4691,11 +9027,11 @@ func (m TenantDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
+func (m GarbageCollectRequestMultiError) AllErrors() []error { return m }
 
-// TenantDeleteResponseValidationError is the validation error returned by
-// TenantDeleteResponse.Validate if the designated constraints aren't met.
-type TenantDeleteResponseValidationError struct {
+// GarbageCollectRequestValidationError is the validation error returned by
+// GarbageCollectRequest.Validate if the designated constraints aren't met.
+type GarbageCollectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4703,24 +9039,24 @@ type TenantDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteResponseValidationError) Field() string { return e.field }
+func (e GarbageCollectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
+func (e GarbageCollectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
+func (e GarbageCollectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
+func (e GarbageCollectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteResponseValidationError) ErrorName() string {
-	return "TenantDeleteResponseValidationError"
+func (e GarbageCollectRequestValidationError) ErrorName() string {
+	return "GarbageCollectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteResponseValidationError) Error() string {
+func (e GarbageCollectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4732,14 +9068,14 @@ func (e TenantDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteResponse.%s: %s%s",
+		"invalid %sGarbageCollectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteResponseValidationError{}
+var _ error = GarbageCollectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4747,63 +9083,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteResponseValidationError{}
+} = GarbageCollectRequestValidationError{}
 
-// Validate checks the field values on TenantListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TenantListRequest) Validate() error {
+var _GarbageCollectRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on GarbageCollectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GarbageCollectResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListRequest with the rules
+// ValidateAll checks the field values on GarbageCollectResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantListRequestMultiError, or nil if none found.
-func (m *TenantListRequest) ValidateAll() error {
+// GarbageCollectResponseMultiError, or nil if none found.
+func (m *GarbageCollectResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListRequest) validate(all bool) error {
+func (m *GarbageCollectResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPageSize() != 0 {
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-		if val := m.GetPageSize(); val < 1 || val > 100 {
-			err := TenantListRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be inside range [1, 100]",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GarbageCollectResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GarbageCollectResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GarbageCollectResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
-	if m.GetContinuousToken() != "" {
-
-	}
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return TenantListRequestMultiError(errors)
+		return GarbageCollectResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListRequestMultiError is an error wrapping multiple validation errors
-// returned by TenantListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TenantListRequestMultiError []error
+// GarbageCollectResponseMultiError is an error wrapping multiple validation
+// errors returned by GarbageCollectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GarbageCollectResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListRequestMultiError) Error() string {
+func (m GarbageCollectResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4812,11 +9167,11 @@ func (m TenantListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListRequestMultiError) AllErrors() []error { return m }
+func (m GarbageCollectResponseMultiError) AllErrors() []error { return m }
 
-// TenantListRequestValidationError is the validation error returned by
-// TenantListRequest.Validate if the designated constraints aren't met.
-type TenantListRequestValidationError struct {
+// GarbageCollectResponseValidationError is the validation error returned by
+// GarbageCollectResponse.Validate if the designated constraints aren't met.
+type GarbageCollectResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4824,24 +9179,24 @@ type TenantListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListRequestValidationError) Field() string { return e.field }
+func (e GarbageCollectResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListRequestValidationError) Reason() string { return e.reason }
+func (e GarbageCollectResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListRequestValidationError) Cause() error { return e.cause }
+func (e GarbageCollectResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListRequestValidationError) Key() bool { return e.key }
+func (e GarbageCollectResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListRequestValidationError) ErrorName() string {
-	return "TenantListRequestValidationError"
+func (e GarbageCollectResponseValidationError) ErrorName() string {
+	return "GarbageCollectResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantListRequestValidationError) Error() string {
+func (e GarbageCollectResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4853,14 +9208,14 @@ func (e TenantListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListRequest.%s: %s%s",
+		"invalid %sGarbageCollectResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListRequestValidationError{}
+var _ error = GarbageCollectResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4868,80 +9223,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListRequestValidationError{}
+} = GarbageCollectResponseValidationError{}
 
-// Validate checks the field values on TenantListResponse with the rules
+// Validate checks the field values on GarbageCollectResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantListResponse) Validate() error {
+func (m *GarbageCollectResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListResponse with the rules
+// ValidateAll checks the field values on GarbageCollectResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantListResponseMultiError, or nil if none found.
-func (m *TenantListResponse) ValidateAll() error {
+// GarbageCollectResultMultiError, or nil if none found.
+func (m *GarbageCollectResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListResponse) validate(all bool) error {
+func (m *GarbageCollectResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTenants() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TenantListResponseValidationError{
-					field:  fmt.Sprintf("Tenants[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for TenantId
 
-	}
+	// no validation rules for RelationTuples
 
-	// no validation rules for ContinuousToken
+	// no validation rules for Transactions
 
 	if len(errors) > 0 {
-		return TenantListResponseMultiError(errors)
+		return GarbageCollectResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListResponseMultiError is an error wrapping multiple validation errors
-// returned by TenantListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TenantListResponseMultiError []error
+// GarbageCollectResultMultiError is an error wrapping multiple validation
+// errors returned by GarbageCollectResult.ValidateAll() if the designated
+// constraints aren't met.
+type GarbageCollectResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListResponseMultiError) Error() string {
+func (m GarbageCollectResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4950,11 +9275,11 @@ func (m TenantListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListResponseMultiError) AllErrors() []error { return m }
+func (m GarbageCollectResultMultiError) AllErrors() []error { return m }
 
-// TenantListResponseValidationError is the validation error returned by
-// TenantListResponse.Validate if the designated constraints aren't met.
-type TenantListResponseValidationError struct {
+// GarbageCollectResultValidationError is the validation error returned by
+// GarbageCollectResult.Validate if the designated constraints aren't met.
+type GarbageCollectResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4962,24 +9287,24 @@ type TenantListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListResponseValidationError) Field() string { return e.field }
+func (e GarbageCollectResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListResponseValidationError) Reason() string { return e.reason }
+func (e GarbageCollectResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListResponseValidationError) Cause() error { return e.cause }
+func (e GarbageCollectResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListResponseValidationError) Key() bool { return e.key }
+func (e GarbageCollectResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListResponseValidationError) ErrorName() string {
-	return "TenantListResponseValidationError"
+func (e GarbageCollectResultValidationError) ErrorName() string {
+	return "GarbageCollectResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantListResponseValidationError) Error() string {
+func (e GarbageCollectResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4991,14 +9316,14 @@ func (e TenantListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListResponse.%s: %s%s",
+		"invalid %sGarbageCollectResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListResponseValidationError{}
+var _ error = GarbageCollectResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -5006,7 +9331,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListResponseValidationError{}
+} = GarbageCollectResultValidationError{}
 
 // Validate checks the field values on WelcomeResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -283,6 +283,10 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 type SchemaClient interface {
 	Write(ctx context.Context, in *SchemaWriteRequest, opts ...grpc.CallOption) (*SchemaWriteResponse, error)
 	Read(ctx context.Context, in *SchemaReadRequest, opts ...grpc.CallOption) (*SchemaReadResponse, error)
+	Audit(ctx context.Context, in *SchemaAuditRequest, opts ...grpc.CallOption) (*SchemaAuditResponse, error)
+	List(ctx context.Context, in *SchemaListRequest, opts ...grpc.CallOption) (*SchemaListResponse, error)
+	Diff(ctx context.Context, in *SchemaDiffRequest, opts ...grpc.CallOption) (*SchemaDiffResponse, error)
+	Graph(ctx context.Context, in *SchemaGraphRequest, opts ...grpc.CallOption) (*SchemaGraphResponse, error)
 }
 
 type schemaClient struct {
@@ -311,12 +315,52 @@ func (c *schemaClient) Read(ctx context.Context, in *SchemaReadRequest, opts ...
 	return out, nil
 }
 
+func (c *schemaClient) Audit(ctx context.Context, in *SchemaAuditRequest, opts ...grpc.CallOption) (*SchemaAuditResponse, error) {
+	out := new(SchemaAuditResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/Audit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) List(ctx context.Context, in *SchemaListRequest, opts ...grpc.CallOption) (*SchemaListResponse, error) {
+	out := new(SchemaListResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Diff(ctx context.Context, in *SchemaDiffRequest, opts ...grpc.CallOption) (*SchemaDiffResponse, error) {
+	out := new(SchemaDiffResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Graph(ctx context.Context, in *SchemaGraphRequest, opts ...grpc.CallOption) (*SchemaGraphResponse, error) {
+	out := new(SchemaGraphResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/Graph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchemaServer is the server API for Schema service.
 // All implementations must embed UnimplementedSchemaServer
 // for forward compatibility
 type SchemaServer interface {
 	Write(context.Context, *SchemaWriteRequest) (*SchemaWriteResponse, error)
 	Read(context.Context, *SchemaReadRequest) (*SchemaReadResponse, error)
+	Audit(context.Context, *SchemaAuditRequest) (*SchemaAuditResponse, error)
+	List(context.Context, *SchemaListRequest) (*SchemaListResponse, error)
+	Diff(context.Context, *SchemaDiffRequest) (*SchemaDiffResponse, error)
+	Graph(context.Context, *SchemaGraphRequest) (*SchemaGraphResponse, error)
 	mustEmbedUnimplementedSchemaServer()
 }
 
@@ -330,6 +374,18 @@ func (UnimplementedSchemaServer) Write(context.Context, *SchemaWriteRequest) (*S
 func (UnimplementedSchemaServer) Read(context.Context, *SchemaReadRequest) (*SchemaReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedSchemaServer) Audit(context.Context, *SchemaAuditRequest) (*SchemaAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
+func (UnimplementedSchemaServer) List(context.Context, *SchemaListRequest) (*SchemaListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSchemaServer) Diff(context.Context, *SchemaDiffRequest) (*SchemaDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (UnimplementedSchemaServer) Graph(context.Context, *SchemaGraphRequest) (*SchemaGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Graph not implemented")
+}
 func (UnimplementedSchemaServer) mustEmbedUnimplementedSchemaServer() {}
 
 // UnsafeSchemaServer may be embedded to opt out of forward compatibility for this service.
@@ -379,6 +435,78 @@ func _Schema_Read_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Schema_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/Audit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Audit(ctx, req.(*SchemaAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).List(ctx, req.(*SchemaListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Diff(ctx, req.(*SchemaDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Graph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Graph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/Graph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Graph(ctx, req.(*SchemaGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Schema_ServiceDesc is the grpc.ServiceDesc for Schema service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -394,6 +522,22 @@ var Schema_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _Schema_Read_Handler,
 		},
+		{
+			MethodName: "Audit",
+			Handler:    _Schema_Audit_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Schema_List_Handler,
+		},
+		{
+			MethodName: "Diff",
+			Handler:    _Schema_Diff_Handler,
+		},
+		{
+			MethodName: "Graph",
+			Handler:    _Schema_Graph_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -406,6 +550,7 @@ type RelationshipClient interface {
 	Write(ctx context.Context, in *RelationshipWriteRequest, opts ...grpc.CallOption) (*RelationshipWriteResponse, error)
 	Read(ctx context.Context, in *RelationshipReadRequest, opts ...grpc.CallOption) (*RelationshipReadResponse, error)
 	Delete(ctx context.Context, in *RelationshipDeleteRequest, opts ...grpc.CallOption) (*RelationshipDeleteResponse, error)
+	Stats(ctx context.Context, in *RelationshipStatsRequest, opts ...grpc.CallOption) (*RelationshipStatsResponse, error)
 }
 
 type relationshipClient struct {
@@ -443,6 +588,15 @@ func (c *relationshipClient) Delete(ctx context.Context, in *RelationshipDeleteR
 	return out, nil
 }
 
+func (c *relationshipClient) Stats(ctx context.Context, in *RelationshipStatsRequest, opts ...grpc.CallOption) (*RelationshipStatsResponse, error) {
+	out := new(RelationshipStatsResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Relationship/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelationshipServer is the server API for Relationship service.
 // All implementations must embed UnimplementedRelationshipServer
 // for forward compatibility
@@ -450,6 +604,7 @@ type RelationshipServer interface {
 	Write(context.Context, *RelationshipWriteRequest) (*RelationshipWriteResponse, error)
 	Read(context.Context, *RelationshipReadRequest) (*RelationshipReadResponse, error)
 	Delete(context.Context, *RelationshipDeleteRequest) (*RelationshipDeleteResponse, error)
+	Stats(context.Context, *RelationshipStatsRequest) (*RelationshipStatsResponse, error)
 	mustEmbedUnimplementedRelationshipServer()
 }
 
@@ -466,6 +621,9 @@ func (UnimplementedRelationshipServer) Read(context.Context, *RelationshipReadRe
 func (UnimplementedRelationshipServer) Delete(context.Context, *RelationshipDeleteRequest) (*RelationshipDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedRelationshipServer) Stats(context.Context, *RelationshipStatsRequest) (*RelationshipStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
 func (UnimplementedRelationshipServer) mustEmbedUnimplementedRelationshipServer() {}
 
 // UnsafeRelationshipServer may be embedded to opt out of forward compatibility for this service.
@@ -533,6 +691,24 @@ func _Relationship_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Relationship_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Relationship/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).Stats(ctx, req.(*RelationshipStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Relationship_ServiceDesc is the grpc.ServiceDesc for Relationship service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -552,6 +728,10 @@ var Relationship_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Relationship_Delete_Handler,
 		},
+		{
+			MethodName: "Stats",
+			Handler:    _Relationship_Stats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -715,6 +895,92 @@ var Tenancy_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "base/v1/service.proto",
 }
 
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error) {
+	out := new(GarbageCollectResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/GarbageCollect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GarbageCollect not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GarbageCollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GarbageCollect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/GarbageCollect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GarbageCollect(ctx, req.(*GarbageCollectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GarbageCollect",
+			Handler:    _Admin_GarbageCollect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
 // WelcomeClient is the client API for Welcome service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -169,3 +169,15 @@ func Intersection(children ...*base.Child) *base.Child {
 		},
 	}
 }
+
+// Exclusion - Returns a child element that represents the first given child excluding the rest of the given children. This child element can be used in defining entity relations and actions.
+func Exclusion(children ...*base.Child) *base.Child {
+	return &base.Child{
+		Type: &base.Child_Rewrite{
+			Rewrite: &base.Rewrite{
+				RewriteOperation: base.Rewrite_OPERATION_EXCLUSION,
+				Children:         children,
+			},
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package token
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+const (
+	// envelopeVersion - version of the envelope layout, written as the first byte of the envelope
+	envelopeVersion byte = 1
+	// keyIDSize - size of the key id, the leading bytes of the sha256 of the signing key
+	keyIDSize = 4
+	// macSize - size of the truncated hmac-sha256 of the envelope
+	macSize = 16
+)
+
+// signingKey - Structure for a key of the envelope signatures
+type signingKey struct {
+	id  []byte
+	key []byte
+}
+
+// SigningKeys - Keys of the snap token signatures. The first key signs the tokens and every key verifies them, so a
+// key is rotated by putting the new key first and removing the old one once the tokens signed with it are no longer
+// in use.
+type SigningKeys struct {
+	keys []signingKey
+}
+
+// NewSigningKeys - Creates the keys of the snap token signatures, at least one key is required and keys can not be
+// empty, since tokens signed with an empty key could be forged
+func NewSigningKeys(keys ...string) (*SigningKeys, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one snap token signing key is required")
+	}
+	sk := make([]signingKey, 0, len(keys))
+	for _, key := range keys {
+		if key == "" {
+			return nil, errors.New("snap token signing keys can not be empty")
+		}
+		sum := sha256.Sum256([]byte(key))
+		sk = append(sk, signingKey{id: sum[:keyIDSize], key: []byte(key)})
+	}
+	return &SigningKeys{keys: sk}, nil
+}
+
+// GenerateSigningKey - Returns a random signing key, for the instances that are not configured with one. The tokens
+// signed with it are only valid in the process that generated it.
+func GenerateSigningKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Seal - Wraps the value of a snap token in an envelope of the tenant signed with the first signing key. The
+// envelope is the version, the key id, the length prefixed tenant id, the value and the signature of all of them.
+func (k *SigningKeys) Seal(tenantID string, value []byte) string {
+	key := k.keys[0]
+
+	var b bytes.Buffer
+	b.WriteByte(envelopeVersion)
+	b.Write(key.id)
+	b.Write(binary.AppendUvarint(nil, uint64(len(tenantID))))
+	b.WriteString(tenantID)
+	b.Write(value)
+	b.Write(sign(key.key, b.Bytes()))
+
+	return base64.StdEncoding.EncodeToString(b.Bytes())
+}
+
+// Open - Verifies the envelope of a snap token for the tenant and returns its value. Envelopes of other tenants,
+// signed with unknown keys or modified are rejected with ERROR_CODE_INVALID_SNAP_TOKEN.
+func (k *SigningKeys) Open(tenantID, encoded string) ([]byte, error) {
+	invalid := errors.New(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String())
+
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalid
+	}
+	if len(b) < 1+keyIDSize+1+macSize || b[0] != envelopeVersion {
+		return nil, invalid
+	}
+
+	signed, mac := b[:len(b)-macSize], b[len(b)-macSize:]
+
+	var key *signingKey
+	for i := range k.keys {
+		if bytes.Equal(k.keys[i].id, b[1:1+keyIDSize]) {
+			key = &k.keys[i]
+			break
+		}
+	}
+	if key == nil || !hmac.Equal(sign(key.key, signed), mac) {
+		return nil, invalid
+	}
+
+	rest := signed[1+keyIDSize:]
+	n, size := binary.Uvarint(rest)
+	if size <= 0 || uint64(len(rest)-size) < n {
+		return nil, invalid
+	}
+	if string(rest[size:size+int(n)]) != tenantID {
+		return nil, invalid
+	}
+
+	return rest[size+int(n):], nil
+}
+
+// sign - returns the truncated hmac-sha256 of the data
+func sign(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)[:macSize]
+}
@@ -0,0 +1,86 @@
+package token
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("envelope", func() {
+	value := []byte{4, 0, 0, 0, 0, 0, 0, 0}
+
+	keys := func(keys ...string) *SigningKeys {
+		k, err := NewSigningKeys(keys...)
+		Expect(err).ShouldNot(HaveOccurred())
+		return k
+	}
+
+	It("should open the envelopes of the tenant", func() {
+		k := keys("key-1")
+
+		b, err := k.Open("t1", k.Seal("t1", value))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(b).Should(Equal(value))
+	})
+
+	It("should reject the envelopes of other tenants", func() {
+		k := keys("key-1")
+
+		_, err := k.Open("t2", k.Seal("t1", value))
+		Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
+	})
+
+	It("should reject modified and malformed envelopes", func() {
+		k := keys("key-1")
+
+		b, err := base64.StdEncoding.DecodeString(k.Seal("t1", value))
+		Expect(err).ShouldNot(HaveOccurred())
+		// the first byte of the value
+		b[len(b)-16-8]++
+
+		for _, encoded := range []string{
+			base64.StdEncoding.EncodeToString(b),
+			base64.StdEncoding.EncodeToString(value),
+			"BAAAAAAAAAA=",
+			"not base64",
+			"",
+		} {
+			_, err = k.Open("t1", encoded)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
+		}
+	})
+
+	It("should verify the envelopes with every key", func() {
+		old := keys("key-1").Seal("t1", value)
+
+		// the new key signs, the old one still verifies
+		rotated := keys("key-2", "key-1")
+		b, err := rotated.Open("t1", old)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(b).Should(Equal(value))
+
+		current := rotated.Seal("t1", value)
+		Expect(current).ShouldNot(Equal(old))
+
+		// the old key is removed
+		removed := keys("key-2")
+		_, err = removed.Open("t1", old)
+		Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
+		_, err = removed.Open("t1", current)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should require a key that is not empty", func() {
+		_, err := NewSigningKeys()
+		Expect(err).Should(HaveOccurred())
+		_, err = NewSigningKeys("key-1", "")
+		Expect(err).Should(HaveOccurred())
+
+		key, err := GenerateSigningKey()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(key).ShouldNot(BeEmpty())
+	})
+})
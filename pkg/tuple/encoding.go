@@ -0,0 +1,287 @@
+package tuple
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+// Format - Serialization format of relation tuple streams
+type Format string
+
+const (
+	// TEXT - one `entity:id#relation@subject:id#relation` tuple per line
+	TEXT Format = "text"
+	// CSV - entity_type,entity_id,relation,subject_type,subject_id,subject_relation records
+	CSV Format = "csv"
+	// JSONL - one JSON encoded tuple per line
+	JSONL Format = "jsonl"
+)
+
+// csvHeader - header record written and skipped by the csv format
+var csvHeader = []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation"}
+
+// String - converts the Format to a string.
+func (f Format) String() string {
+	return string(f)
+}
+
+// ParseFormat - returns the format with the given name
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case TEXT, CSV, JSONL:
+		return Format(name), nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// FormatFromPath - guesses the format of a file from its extension, falling back to text
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return CSV
+	case ".jsonl", ".ndjson":
+		return JSONL
+	default:
+		return TEXT
+	}
+}
+
+// EscapeID - percent-encodes the characters of an id that are part of the text tuple syntax,
+// as well as whitespace and control characters.
+func EscapeID(id string) string {
+	var sb strings.Builder
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		switch {
+		case c == '%', c == ':', c == '#', c == '@', c <= ' ', c == 0x7f:
+			sb.WriteString(fmt.Sprintf("%%%02X", c))
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// UnescapeID - reverses EscapeID
+func UnescapeID(id string) (string, error) {
+	s, err := url.PathUnescape(id)
+	if err != nil {
+		return "", ErrInvalidTuple
+	}
+	return s, nil
+}
+
+// ToEscapedString - converts the tuple to its text form, escaping entity and subject ids
+func ToEscapedString(tup *base.Tuple) string {
+	return ToString(&base.Tuple{
+		Entity: &base.Entity{
+			Type: tup.GetEntity().GetType(),
+			Id:   EscapeID(tup.GetEntity().GetId()),
+		},
+		Relation: tup.GetRelation(),
+		Subject: &base.Subject{
+			Type:     tup.GetSubject().GetType(),
+			Id:       EscapeID(tup.GetSubject().GetId()),
+			Relation: tup.GetSubject().GetRelation(),
+		},
+	})
+}
+
+// FromEscapedString - parses a tuple in text form, unescaping entity and subject ids
+func FromEscapedString(s string) (tup *base.Tuple, err error) {
+	tup, err = Tuple(s)
+	if err != nil {
+		return nil, err
+	}
+	tup.Entity.Id, err = UnescapeID(tup.GetEntity().GetId())
+	if err != nil {
+		return nil, err
+	}
+	tup.Subject.Id, err = UnescapeID(tup.GetSubject().GetId())
+	if err != nil {
+		return nil, err
+	}
+	return tup, nil
+}
+
+// isComment - checks whether the line of a text or json lines stream is blank or a comment
+func isComment(line string) bool {
+	return line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//")
+}
+
+// Decoder - Reads relation tuples one by one from a stream
+type Decoder struct {
+	format  Format
+	scanner *bufio.Scanner
+	csv     *csv.Reader
+	line    int
+}
+
+// NewDecoder - Creates a new decoder reading tuples in the given format from r
+func NewDecoder(format Format, r io.Reader) (*Decoder, error) {
+	d := &Decoder{format: format}
+	switch format {
+	case TEXT, JSONL:
+		d.scanner = bufio.NewScanner(r)
+		d.scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	case CSV:
+		d.csv = csv.NewReader(r)
+		d.csv.Comment = '#'
+		d.csv.FieldsPerRecord = -1
+		d.csv.TrimLeadingSpace = true
+	default:
+		return nil, ErrUnknownFormat
+	}
+	return d, nil
+}
+
+// Line - returns the line of the last decoded tuple
+func (d *Decoder) Line() int {
+	return d.line
+}
+
+// Decode - returns the next tuple of the stream, or io.EOF when there are none left.
+// Blank lines and comments are skipped.
+func (d *Decoder) Decode() (*base.Tuple, error) {
+	if d.format == CSV {
+		return d.decodeCSV()
+	}
+
+	for d.scanner.Scan() {
+		d.line++
+		line := strings.TrimSpace(d.scanner.Text())
+		if isComment(line) {
+			continue
+		}
+
+		var tup *base.Tuple
+		var err error
+		if d.format == JSONL {
+			tup = &base.Tuple{}
+			err = protojson.Unmarshal([]byte(line), tup)
+			if err == nil && (tup.GetEntity() == nil || tup.GetSubject() == nil) {
+				err = ErrInvalidTuple
+			}
+		} else {
+			tup, err = FromEscapedString(line)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", d.line, err)
+		}
+		return tup, nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// decodeCSV - returns the next tuple of a csv stream, skipping the header record
+func (d *Decoder) decodeCSV() (*base.Tuple, error) {
+	for {
+		record, err := d.csv.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+		d.line, _ = d.csv.FieldPos(0)
+
+		if record[0] == csvHeader[0] {
+			continue
+		}
+
+		if len(record) != len(csvHeader) && len(record) != len(csvHeader)-1 {
+			return nil, fmt.Errorf("line %d: %w", d.line, ErrInvalidRecord)
+		}
+
+		tup := &base.Tuple{
+			Entity: &base.Entity{
+				Type: record[0],
+				Id:   record[1],
+			},
+			Relation: record[2],
+			Subject: &base.Subject{
+				Type: record[3],
+				Id:   record[4],
+			},
+		}
+		if len(record) == len(csvHeader) {
+			tup.Subject.Relation = record[5]
+		}
+		return tup, nil
+	}
+}
+
+// Encoder - Writes relation tuples one by one to a stream
+type Encoder struct {
+	format Format
+	w      *bufio.Writer
+	csv    *csv.Writer
+}
+
+// NewEncoder - Creates a new encoder writing tuples in the given format to w
+func NewEncoder(format Format, w io.Writer) (*Encoder, error) {
+	e := &Encoder{format: format}
+	switch format {
+	case TEXT, JSONL:
+		e.w = bufio.NewWriter(w)
+	case CSV:
+		e.csv = csv.NewWriter(w)
+		if err := e.csv.Write(csvHeader); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownFormat
+	}
+	return e, nil
+}
+
+// Encode - writes the tuple to the stream
+func (e *Encoder) Encode(tup *base.Tuple) error {
+	switch e.format {
+	case CSV:
+		return e.csv.Write([]string{
+			tup.GetEntity().GetType(),
+			tup.GetEntity().GetId(),
+			tup.GetRelation(),
+			tup.GetSubject().GetType(),
+			tup.GetSubject().GetId(),
+			tup.GetSubject().GetRelation(),
+		})
+	case JSONL:
+		b, err := protojson.Marshal(tup)
+		if err != nil {
+			return err
+		}
+		if _, err = e.w.Write(b); err != nil {
+			return err
+		}
+		return e.w.WriteByte('\n')
+	default:
+		_, err := e.w.WriteString(ToEscapedString(tup) + "\n")
+		return err
+	}
+}
+
+// Flush - writes any buffered data to the underlying stream
+func (e *Encoder) Flush() error {
+	if e.format == CSV {
+		e.csv.Flush()
+		return e.csv.Error()
+	}
+	return e.w.Flush()
+}
@@ -0,0 +1,142 @@
+package tuple
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	base "permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("encoding", func() {
+	tuples := []*base.Tuple{
+		{
+			Entity:   &base.Entity{Type: "repository", Id: "1"},
+			Relation: "owner",
+			Subject:  &base.Subject{Type: "user", Id: "jane@example.com"},
+		},
+		{
+			Entity:   &base.Entity{Type: "document", Id: "reports/2023 #1: q1"},
+			Relation: "viewer",
+			Subject:  &base.Subject{Type: "organization", Id: "100%", Relation: "member"},
+		},
+	}
+
+	Context("Escape", func() {
+		It("EscapeID", func() {
+			tests := []struct {
+				target   string
+				expected string
+			}{
+				{"1", "1"},
+				{"jane@example.com", "jane%40example.com"},
+				{"reports/2023 #1: q1", "reports/2023%20%231%3A%20q1"},
+				{"100%", "100%25"},
+			}
+
+			for _, tt := range tests {
+				Expect(EscapeID(tt.target)).Should(Equal(tt.expected))
+				id, err := UnescapeID(tt.expected)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id).Should(Equal(tt.target))
+			}
+		})
+
+		It("ToEscapedString", func() {
+			Expect(ToEscapedString(tuples[0])).Should(Equal("repository:1#owner@user:jane%40example.com"))
+			Expect(ToEscapedString(tuples[1])).Should(Equal("document:reports/2023%20%231%3A%20q1#viewer@organization:100%25#member"))
+		})
+	})
+
+	Context("Round Trip", func() {
+		It("Formats", func() {
+			for _, format := range []Format{TEXT, CSV, JSONL} {
+				var buf bytes.Buffer
+				encoder, err := NewEncoder(format, &buf)
+				Expect(err).ShouldNot(HaveOccurred())
+				for _, tup := range tuples {
+					Expect(encoder.Encode(tup)).ShouldNot(HaveOccurred())
+				}
+				Expect(encoder.Flush()).ShouldNot(HaveOccurred())
+
+				decoder, err := NewDecoder(format, &buf)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				var decoded []*base.Tuple
+				for {
+					var tup *base.Tuple
+					tup, err = decoder.Decode()
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					Expect(err).ShouldNot(HaveOccurred())
+					decoded = append(decoded, tup)
+				}
+
+				Expect(decoded).Should(HaveLen(len(tuples)))
+				for i := range tuples {
+					Expect(ToString(decoded[i])).Should(Equal(ToString(tuples[i])))
+				}
+			}
+		})
+	})
+
+	Context("Decode", func() {
+		It("Comments", func() {
+			decoder, err := NewDecoder(TEXT, strings.NewReader(`
+# organization members
+organization:1#member@user:1
+
+// repositories
+repository:1#parent@organization:1#...
+`))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup, err := decoder.Decode()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ToString(tup)).Should(Equal("organization:1#member@user:1"))
+			Expect(decoder.Line()).Should(Equal(3))
+
+			tup, err = decoder.Decode()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ToString(tup)).Should(Equal("repository:1#parent@organization:1#..."))
+			Expect(decoder.Line()).Should(Equal(6))
+
+			_, err = decoder.Decode()
+			Expect(err).Should(Equal(io.EOF))
+		})
+
+		It("CSV", func() {
+			decoder, err := NewDecoder(CSV, strings.NewReader(`entity_type,entity_id,relation,subject_type,subject_id,subject_relation
+# owners
+repository,"1,2",owner,user,1
+repository,1
+`))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup, err := decoder.Decode()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tup.GetEntity().GetId()).Should(Equal("1,2"))
+			Expect(tup.GetSubject().GetRelation()).Should(Equal(""))
+
+			_, err = decoder.Decode()
+			Expect(errors.Is(err, ErrInvalidRecord)).Should(BeTrue())
+			Expect(err.Error()).Should(Equal("line 4: invalid record"))
+		})
+
+		It("Invalid", func() {
+			decoder, err := NewDecoder(TEXT, strings.NewReader("repository:1#owner\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = decoder.Decode()
+			Expect(err.Error()).Should(Equal("line 1: invalid tuple"))
+
+			_, err = NewDecoder(Format("xml"), strings.NewReader(""))
+			Expect(err).Should(Equal(ErrUnknownFormat))
+		})
+	})
+})
@@ -55,6 +55,14 @@ func SubjectToString(subject *base.Subject) string {
 	return fmt.Sprintf("%s"+RELATION, fmt.Sprintf(ENTITY, subject.GetType(), subject.GetId()), subject.GetRelation())
 }
 
+// ToString -
+func ToString(tup *base.Tuple) string {
+	return EntityAndRelationToString(&base.EntityAndRelation{
+		Entity:   tup.GetEntity(),
+		Relation: tup.GetRelation(),
+	}) + "@" + SubjectToString(tup.GetSubject())
+}
+
 // IsEntityAndSubjectEquals -
 func IsEntityAndSubjectEquals(t *base.Tuple) bool {
 	return t.GetEntity().GetType() == t.GetSubject().GetType() && t.GetEntity().GetId() == t.GetSubject().GetId() && t.GetRelation() == t.GetSubject().GetRelation()
@@ -43,6 +43,41 @@ var _ = Describe("tuple", func() {
 				Expect(EntityAndRelationToString(tt.target)).Should(Equal(tt.expected))
 			}
 		})
+
+		It("ToString", func() {
+			tests := []struct {
+				target   *base.Tuple
+				expected string
+			}{
+				{&base.Tuple{
+					Entity: &base.Entity{
+						Type: "repository",
+						Id:   "1",
+					},
+					Relation: "admin",
+					Subject: &base.Subject{
+						Type: "user",
+						Id:   "1",
+					},
+				}, "repository:1#admin@user:1"},
+				{&base.Tuple{
+					Entity: &base.Entity{
+						Type: "doc",
+						Id:   "1",
+					},
+					Relation: "viewer",
+					Subject: &base.Subject{
+						Type:     "organization",
+						Id:       "2",
+						Relation: "member",
+					},
+				}, "doc:1#viewer@organization:2#member"},
+			}
+
+			for _, tt := range tests {
+				Expect(ToString(tt.target)).Should(Equal(tt.expected))
+			}
+		})
 	})
 
 	Context("StringToTuple", func() {